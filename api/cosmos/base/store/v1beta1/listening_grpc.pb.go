@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/store/v1beta1/listening.proto
+
+package storev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamingListenerClient is the client API for StreamingListener service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamingListenerClient interface {
+	// ListenBlocks receives the blocks executed by the node in order, and acknowledges each of them
+	// once it is processed.
+	ListenBlocks(ctx context.Context, opts ...grpc.CallOption) (StreamingListener_ListenBlocksClient, error)
+}
+
+type streamingListenerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamingListenerClient(cc grpc.ClientConnInterface) StreamingListenerClient {
+	return &streamingListenerClient{cc}
+}
+
+func (c *streamingListenerClient) ListenBlocks(ctx context.Context, opts ...grpc.CallOption) (StreamingListener_ListenBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StreamingListener_ServiceDesc.Streams[0], "/cosmos.base.store.v1beta1.StreamingListener/ListenBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingListenerListenBlocksClient{stream}
+	return x, nil
+}
+
+type StreamingListener_ListenBlocksClient interface {
+	Send(*ListenBlocksRequest) error
+	Recv() (*ListenBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type streamingListenerListenBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingListenerListenBlocksClient) Send(m *ListenBlocksRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamingListenerListenBlocksClient) Recv() (*ListenBlocksResponse, error) {
+	m := new(ListenBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingListenerServer is the server API for StreamingListener service.
+// All implementations must embed UnimplementedStreamingListenerServer
+// for forward compatibility
+type StreamingListenerServer interface {
+	// ListenBlocks receives the blocks executed by the node in order, and acknowledges each of them
+	// once it is processed.
+	ListenBlocks(StreamingListener_ListenBlocksServer) error
+	mustEmbedUnimplementedStreamingListenerServer()
+}
+
+// UnimplementedStreamingListenerServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamingListenerServer struct {
+}
+
+func (UnimplementedStreamingListenerServer) ListenBlocks(StreamingListener_ListenBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListenBlocks not implemented")
+}
+func (UnimplementedStreamingListenerServer) mustEmbedUnimplementedStreamingListenerServer() {}
+
+// UnsafeStreamingListenerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingListenerServer will
+// result in compilation errors.
+type UnsafeStreamingListenerServer interface {
+	mustEmbedUnimplementedStreamingListenerServer()
+}
+
+func RegisterStreamingListenerServer(s grpc.ServiceRegistrar, srv StreamingListenerServer) {
+	s.RegisterService(&StreamingListener_ServiceDesc, srv)
+}
+
+func _StreamingListener_ListenBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingListenerServer).ListenBlocks(&streamingListenerListenBlocksServer{stream})
+}
+
+type StreamingListener_ListenBlocksServer interface {
+	Send(*ListenBlocksResponse) error
+	Recv() (*ListenBlocksRequest, error)
+	grpc.ServerStream
+}
+
+type streamingListenerListenBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingListenerListenBlocksServer) Send(m *ListenBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamingListenerListenBlocksServer) Recv() (*ListenBlocksRequest, error) {
+	m := new(ListenBlocksRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingListener_ServiceDesc is the grpc.ServiceDesc for StreamingListener service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreamingListener_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.StreamingListener",
+	HandlerType: (*StreamingListenerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListenBlocks",
+			Handler:       _StreamingListener_ListenBlocks_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/store/v1beta1/listening.proto",
+}
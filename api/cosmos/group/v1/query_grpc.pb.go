@@ -52,6 +52,8 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of a group.
+	VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +181,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error) {
+	out := new(QueryVoteDelegationsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/VoteDelegationsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +224,8 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of a group.
+	VoteDelegationsByGroup(context.Context, *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -259,6 +272,9 @@ func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMe
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) VoteDelegationsByGroup(context.Context, *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegationsByGroup not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -506,6 +522,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegationsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/VoteDelegationsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, req.(*QueryVoteDelegationsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +599,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegationsByGroup",
+			Handler:    _Query_VoteDelegationsByGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -199,7 +200,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	// call the hooks with the BeginBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+			app.listeningHookFailed(streamingListener, "BeginBlock", req.Header.Height, err)
 		}
 	}
 
@@ -224,7 +225,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	// call the streaming service hooks with the EndBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+			app.listeningHookFailed(streamingListener, "EndBlock", req.Height, err)
 		}
 	}
 
@@ -271,7 +272,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
@@ -282,6 +283,15 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
+	// call the streaming service hooks with the DeliverTx messages
+	defer func() {
+		for _, streamingListener := range app.abciListeners {
+			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.listeningHookFailed(streamingListener, "DeliverTx", app.deliverState.ctx.BlockHeight(), err)
+			}
+		}
+	}()
+
 	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
@@ -312,6 +322,15 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	app.deliverState.ms.Write()
+
+	// call the streaming service hooks once the state changes of the block have been written to
+	// them, before they are committed
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenCommit(app.deliverState.ctx); err != nil {
+			app.listeningHookFailed(streamingListener, "Commit", header.Height, err)
+		}
+	}
+
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
@@ -350,6 +369,17 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 }
 
+// listeningHookFailed logs the error of a listening hook of a streaming service.
+// If the service halts the node on delivery errors, it panics to stop the node
+// before the block is committed, so that the block is executed, and listened to,
+// again when the node restarts.
+func (app *BaseApp) listeningHookFailed(listener ABCIListener, hook string, height int64, err error) {
+	app.logger.Error(fmt.Sprintf("%s listening hook failed", hook), "height", height, "err", err)
+	if listener.HaltAppOnDeliveryError() {
+		panic(fmt.Errorf("halting the node on the failure of the %s listening hook at height %d: %w", hook, height, err))
+	}
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -741,6 +771,9 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "commit_info":
+			return handleQueryCommitInfo(app, req)
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -753,6 +786,42 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 		), app.trace)
 }
 
+// handleQueryCommitInfo returns the protobuf encoded commit info of the multistore at the
+// request height, or at the latest height if the height is 0.
+func handleQueryCommitInfo(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	cms, ok := app.cms.(interface {
+		GetCommitInfo(version int64) (*storetypes.CommitInfo, error)
+	})
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support commit info queries"), app.trace)
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	if height <= 0 || height > app.LastBlockHeight() {
+		return sdkerrors.QueryResult(
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height %d must be between 1 and %d", height, app.LastBlockHeight()),
+			app.trace)
+	}
+
+	cInfo, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(err, "failed to load the commit info at height %d", height), app.trace)
+	}
+	bz, err := cInfo.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to encode the commit info"), app.trace)
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    height,
+		Value:     bz,
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(sdk.Queryable)
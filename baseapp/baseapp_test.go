@@ -2,8 +2,10 @@ package baseapp
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -15,17 +17,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,6 +57,7 @@ type setupConfig struct {
 	snapshotInterval   uint64
 	snapshotKeepRecent uint32
 	pruningOpts        pruningtypes.PruningOptions
+	multiStoreV2       bool
 }
 
 func (ps *paramStore) Set(_ sdk.Context, key []byte, value interface{}) {
@@ -140,7 +146,7 @@ func setupBaseAppWithSnapshots(t *testing.T, config *setupConfig) (*BaseApp, err
 	routerOpt := func(bapp *BaseApp) {
 		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 			kv := msg.(*msgKeyValue)
-			bapp.cms.GetCommitKVStore(capKey2).Set(kv.Key, kv.Value)
+			bapp.cms.GetKVStore(capKey2).Set(kv.Key, kv.Value)
 			return &sdk.Result{}, nil
 		}))
 	}
@@ -149,7 +155,12 @@ func setupBaseAppWithSnapshots(t *testing.T, config *setupConfig) (*BaseApp, err
 	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
 	require.NoError(t, err)
 
-	app := setupBaseApp(t, routerOpt, SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(config.snapshotInterval, uint32(config.snapshotKeepRecent))), SetPruning(config.pruningOpts))
+	var options []func(*BaseApp)
+	if config.multiStoreV2 {
+		options = append(options, SetMultiStoreV2(memdb.NewDB()))
+	}
+	options = append(options, routerOpt, SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(config.snapshotInterval, uint32(config.snapshotKeepRecent))), SetPruning(config.pruningOpts))
+	app := setupBaseApp(t, options...)
 
 	app.InitChain(abci.RequestInitChain{})
 
@@ -1013,6 +1024,105 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// mockStreamingService records the ABCI messages and the state changes it listens to
+type mockStreamingService struct {
+	beginBlocks, deliverTxs, endBlocks, commits int
+	changes                                     []*storetypes.StoreKVPair
+	changesAtCommit                             int
+	commitErr                                   error
+	haltOnError                                 bool
+}
+
+func (s *mockStreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+func (s *mockStreamingService) Close() error                    { return nil }
+
+func (s *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: {s}}
+}
+
+func (s *mockStreamingService) OnWrite(storeKey storetypes.StoreKey, key, value []byte, delete bool) error {
+	s.changes = append(s.changes, &storetypes.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (s *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	s.beginBlocks++
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	s.deliverTxs++
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	s.endBlocks++
+	return nil
+}
+
+func (s *mockStreamingService) ListenCommit(sdk.Context) error {
+	s.commits++
+	s.changesAtCommit = len(s.changes)
+	return s.commitErr
+}
+
+func (s *mockStreamingService) HaltAppOnDeliveryError() bool { return s.haltOnError }
+
+// Test that the streaming services listen to all the ABCI messages and state changes
+// of a block before it is committed, and that they can halt the node.
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	streamingService := &mockStreamingService{}
+	app.SetStreamingService(streamingService)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	deliverBlock := func(height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		for i := int64(0); i < 2; i++ {
+			counter := (height-1)*2 + i
+			txBytes, err := codec.Marshal(newTxCounter(counter, counter))
+			require.NoError(t, err)
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+	}
+
+	deliverBlock(1)
+	require.Equal(t, 2, streamingService.deliverTxs)
+	app.Commit()
+	require.Equal(t, 1, streamingService.beginBlocks)
+	require.Equal(t, 1, streamingService.endBlocks)
+	require.Equal(t, 1, streamingService.commits)
+	// all the state changes of the block are written out before it is committed
+	require.NotZero(t, streamingService.changesAtCommit)
+	require.Equal(t, len(streamingService.changes), streamingService.changesAtCommit)
+
+	// without halting, a failing hook does not prevent the block from being committed
+	streamingService.commitErr = errors.New("listener failure")
+	deliverBlock(2)
+	app.Commit()
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	// when halting, the block is not committed
+	streamingService.haltOnError = true
+	lastCommitID := app.LastCommitID()
+	deliverBlock(3)
+	require.Panics(t, func() { app.Commit() })
+	require.Equal(t, lastCommitID, app.LastCommitID())
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1771,6 +1881,92 @@ func TestP2PQuery(t *testing.T) {
 	require.Equal(t, uint32(4), res.Code)
 }
 
+func TestCommitInfoQuery(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	query := abci.RequestQuery{Path: "/app/commit_info"}
+	res := app.Query(query)
+	require.False(t, res.IsOK(), "no height is committed")
+
+	var commitIDs []storetypes.CommitID
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte("height"), []byte{byte(height)})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		commitIDs = append(commitIDs, app.LastCommitID())
+	}
+
+	for height, expected := range map[int64]storetypes.CommitID{0: commitIDs[1], 1: commitIDs[0], 2: commitIDs[1]} {
+		query.Height = height
+		res = app.Query(query)
+		require.True(t, res.IsOK(), res.Log)
+
+		var cInfo storetypes.CommitInfo
+		require.NoError(t, cInfo.Unmarshal(res.Value))
+		require.Equal(t, expected.Version, res.Height)
+		require.Equal(t, expected, cInfo.CommitID())
+	}
+
+	query.Height = 3
+	require.False(t, app.Query(query).IsOK())
+}
+
+func TestMultiStoreV2(t *testing.T) {
+	config := &setupConfig{
+		blocks:             10,
+		blockTxs:           2,
+		snapshotInterval:   5,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewCustomPruningOptions(2, 10),
+		multiStoreV2:       true,
+	}
+	source, err := setupBaseAppWithSnapshots(t, config)
+	require.NoError(t, err)
+	commitID := source.LastCommitID()
+	require.Equal(t, int64(10), commitID.Version)
+
+	// Store queries are proven against the app hash
+	res := source.Query(abci.RequestQuery{Path: "/store/key2/key", Data: []byte("0"), Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, commitID.Version, res.Height)
+	keyHash := sha256.Sum256([]byte("0"))
+	keyPath := merkle.KeyPath{}.AppendKey([]byte("key2"), merkle.KeyEncodingHex).AppendKey(keyHash[:], merkle.KeyEncodingHex)
+	require.NoError(t, multi.DefaultProofRuntime().VerifyValue(res.ProofOps, commitID.Hash, keyPath.String(), res.Value))
+
+	res = source.Query(abci.RequestQuery{Path: "/app/commit_info"})
+	require.True(t, res.IsOK(), res.Log)
+	var cInfo storetypes.CommitInfo
+	require.NoError(t, cInfo.Unmarshal(res.Value))
+	require.Equal(t, commitID, cInfo.CommitID())
+
+	// The pruned heights are not kept, unlike the snapshot ones until their snapshot is done
+	_, err = source.cms.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	// The snapshots restore the same state
+	config.blocks = 0
+	target, err := setupBaseAppWithSnapshots(t, config)
+	require.NoError(t, err)
+	snapshots := source.ListSnapshots(abci.RequestListSnapshots{}).Snapshots
+	require.Len(t, snapshots, 2)
+	snapshot := snapshots[0]
+	require.Equal(t, uint64(10), snapshot.Height)
+	respOffer := target.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: snapshot})
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, respOffer.Result)
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		respApply := target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{Index: index, Chunk: respChunk.Chunk})
+		require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, respApply.Result)
+	}
+	require.Equal(t, commitID, target.LastCommitID())
+}
+
 func TestGetMaximumBlockGas(t *testing.T) {
 	app := setupBaseApp(t)
 	app.InitChain(abci.RequestInitChain{})
@@ -17,6 +17,13 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
+}
+
+// CircuitBreaker defines a circuit breaker, which can disable the execution of
+// specific Msg type URLs.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) bool
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -107,6 +114,13 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			// The circuit breaker is checked here rather than in the ante handler
+			// only, so that it also applies to the messages dispatched by other
+			// messages, e.g. by authz or group.
+			if msr.circuitBreaker != nil && !msr.circuitBreaker.IsAllowed(ctx, requestTypeName) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables execution of this message: %s", requestTypeName)
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -129,6 +143,11 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetCircuit sets the circuit breaker checked before executing any message.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
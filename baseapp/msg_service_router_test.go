@@ -13,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -119,3 +121,44 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+// disabledMsgs is a circuit breaker disabling the Msg type URLs it contains.
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) IsAllowed(_ sdk.Context, typeURL string) bool { return !d[typeURL] }
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp("test", log.MustNewDefaultLogger("plain", "info", false), db, encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	require.NoError(t, app.LoadLatestVersion())
+	ctx := app.NewUncachedContext(false, tmproto.Header{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	// no circuit breaker set
+	_, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	circuitBreaker := disabledMsgs{}
+	app.MsgServiceRouter().SetCircuit(circuitBreaker)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	// the handlers already returned by the router are disabled as well
+	circuitBreaker[sdk.MsgTypeURL(msg)] = true
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	delete(circuitBreaker, sdk.MsgTypeURL(msg))
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+}
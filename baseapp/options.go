@@ -7,10 +7,12 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	dbv2 "github.com/cosmos/cosmos-sdk/db"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +24,12 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetMultiStoreV2 sets the store/v2alpha1 multistore backed by db as the multistore of the app,
+// in place of the IAVL one. It must precede the other options configuring the multistore.
+func SetMultiStoreV2(db dbv2.Connection) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetCMS(multi.NewV1Store(db)) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
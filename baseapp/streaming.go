@@ -18,6 +18,12 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the streaming service once all the state changes of the block have been
+	// written to its listeners, right before they are committed
+	ListenCommit(ctx types.Context) error
+	// HaltAppOnDeliveryError returns true if the node must halt when a listening hook fails, before
+	// the block is committed, so that the block is listened to again when the node restarts
+	HaltAppOnDeliveryError() bool
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
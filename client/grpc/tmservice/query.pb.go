@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/store/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -1146,6 +1147,106 @@ func (m *ProofOps) GetOps() []ProofOp {
 	return nil
 }
 
+// GetCommitInfoRequest is the request type for the Service/GetCommitInfo RPC method.
+type GetCommitInfoRequest struct {
+	// height is the height of the commit info, the latest height if 0.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetCommitInfoRequest) Reset()         { *m = GetCommitInfoRequest{} }
+func (m *GetCommitInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCommitInfoRequest) ProtoMessage()    {}
+func (*GetCommitInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{19}
+}
+func (m *GetCommitInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommitInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommitInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCommitInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitInfoRequest.Merge(m, src)
+}
+func (m *GetCommitInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCommitInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCommitInfoRequest proto.InternalMessageInfo
+
+func (m *GetCommitInfoRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetCommitInfoResponse is the response type for the Service/GetCommitInfo RPC method.
+type GetCommitInfoResponse struct {
+	// app_hash is the hash of the commit info, i.e. the app hash of the height.
+	AppHash    []byte             `protobuf:"bytes,1,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	CommitInfo *types2.CommitInfo `protobuf:"bytes,2,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
+}
+
+func (m *GetCommitInfoResponse) Reset()         { *m = GetCommitInfoResponse{} }
+func (m *GetCommitInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCommitInfoResponse) ProtoMessage()    {}
+func (*GetCommitInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{20}
+}
+func (m *GetCommitInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommitInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommitInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCommitInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitInfoResponse.Merge(m, src)
+}
+func (m *GetCommitInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCommitInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCommitInfoResponse proto.InternalMessageInfo
+
+func (m *GetCommitInfoResponse) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *GetCommitInfoResponse) GetCommitInfo() *types2.CommitInfo {
+	if m != nil {
+		return m.CommitInfo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetValidatorSetByHeightRequest)(nil), "cosmos.base.tendermint.v1beta1.GetValidatorSetByHeightRequest")
 	proto.RegisterType((*GetValidatorSetByHeightResponse)(nil), "cosmos.base.tendermint.v1beta1.GetValidatorSetByHeightResponse")
@@ -1166,6 +1267,8 @@ func init() {
 	proto.RegisterType((*ABCIQueryResponse)(nil), "cosmos.base.tendermint.v1beta1.ABCIQueryResponse")
 	proto.RegisterType((*ProofOp)(nil), "cosmos.base.tendermint.v1beta1.ProofOp")
 	proto.RegisterType((*ProofOps)(nil), "cosmos.base.tendermint.v1beta1.ProofOps")
+	proto.RegisterType((*GetCommitInfoRequest)(nil), "cosmos.base.tendermint.v1beta1.GetCommitInfoRequest")
+	proto.RegisterType((*GetCommitInfoResponse)(nil), "cosmos.base.tendermint.v1beta1.GetCommitInfoResponse")
 }
 
 func init() {
@@ -1173,91 +1276,97 @@ func init() {
 }
 
 var fileDescriptor_40c93fb3ef485c5d = []byte{
-	// 1343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xda, 0x21, 0xb6, 0x9f, 0xf9, 0x7e, 0x15, 0x86, 0x14, 0x8c, 0x15, 0x0c, 0xf5, 0x01,
-	0xc2, 0x8f, 0xec, 0xd6, 0xe6, 0xe7, 0x81, 0x82, 0x08, 0xd0, 0x90, 0x42, 0x69, 0xba, 0xa9, 0x7a,
-	0xa8, 0x2a, 0x59, 0x6b, 0xef, 0x64, 0xb3, 0x8a, 0xbd, 0x33, 0xec, 0x8c, 0xdd, 0x5a, 0x15, 0x6a,
-	0xd5, 0x3f, 0xa0, 0xaa, 0xd4, 0x7f, 0x81, 0x43, 0xdb, 0x4b, 0x2f, 0x55, 0x8f, 0x9c, 0x39, 0x22,
-	0x2a, 0x55, 0xa8, 0x87, 0xaa, 0x82, 0xfe, 0x21, 0xd5, 0xbc, 0x99, 0x5d, 0xaf, 0x81, 0x60, 0x27,
-	0x87, 0x4a, 0x3d, 0x79, 0xf6, 0xfd, 0xfc, 0x7c, 0xde, 0x9b, 0x79, 0x33, 0x86, 0xd3, 0x1d, 0x26,
-	0x7a, 0x4c, 0x38, 0x6d, 0x4f, 0x50, 0x47, 0xd2, 0xc8, 0xa7, 0x71, 0x2f, 0x8c, 0xa4, 0x33, 0x68,
-	0xb4, 0xa9, 0xf4, 0x1a, 0xce, 0xfd, 0x3e, 0x8d, 0x87, 0x36, 0x8f, 0x99, 0x64, 0xa4, 0xa6, 0x6d,
-	0x6d, 0x65, 0x6b, 0x8f, 0x6c, 0x6d, 0x63, 0x5b, 0x5d, 0x08, 0x58, 0xc0, 0xd0, 0xd4, 0x51, 0x2b,
-	0xed, 0x55, 0x3d, 0x12, 0x30, 0x16, 0x74, 0xa9, 0x83, 0x5f, 0xed, 0xfe, 0xa6, 0xe3, 0x45, 0x26,
-	0x60, 0x75, 0xd1, 0xa8, 0x3c, 0x1e, 0x3a, 0x5e, 0x14, 0x31, 0xe9, 0xc9, 0x90, 0x45, 0xc2, 0x68,
-	0xab, 0x19, 0x38, 0xbc, 0xc9, 0x1d, 0x39, 0xe4, 0x34, 0xd1, 0x2d, 0x66, 0x74, 0x28, 0x77, 0xda,
-	0x5d, 0xd6, 0xd9, 0xde, 0x51, 0x9b, 0xf5, 0x1d, 0xa3, 0x8c, 0xfc, 0x52, 0xb6, 0xdc, 0x0b, 0xc2,
-	0x08, 0x41, 0x24, 0xe0, 0xb5, 0x6d, 0x4b, 0xb3, 0x32, 0xfc, 0xf1, 0xa3, 0xfe, 0xb5, 0x05, 0xb5,
-	0x55, 0x2a, 0x3f, 0xf1, 0xba, 0xa1, 0xef, 0x49, 0x16, 0x6f, 0x50, 0xb9, 0x32, 0xbc, 0x4d, 0xc3,
-	0x60, 0x4b, 0xba, 0xf4, 0x7e, 0x9f, 0x0a, 0x49, 0x0e, 0xc1, 0xdc, 0x16, 0x0a, 0x2a, 0xd6, 0x71,
-	0x6b, 0x29, 0xef, 0x9a, 0x2f, 0xf2, 0x1e, 0xc0, 0x28, 0x53, 0x25, 0x77, 0xdc, 0x5a, 0x2a, 0x37,
-	0x4f, 0xd8, 0xd9, 0xea, 0xea, 0xb2, 0x1b, 0x58, 0xf6, 0xba, 0x17, 0x50, 0x13, 0xd3, 0xcd, 0x78,
-	0xd6, 0x9f, 0x59, 0x70, 0x6c, 0x47, 0x08, 0x82, 0xb3, 0x48, 0x50, 0xf2, 0x36, 0xec, 0xc7, 0xd2,
-	0xb4, 0xc6, 0x90, 0x94, 0x51, 0xa6, 0x4d, 0xc9, 0x1a, 0xc0, 0x20, 0x09, 0x21, 0x2a, 0xb9, 0xe3,
-	0xf9, 0xa5, 0x72, 0xf3, 0x94, 0xfd, 0xe6, 0x66, 0xdb, 0x69, 0x52, 0x37, 0xe3, 0x4c, 0x56, 0xc7,
-	0x98, 0xe5, 0x91, 0xd9, 0xc9, 0x89, 0xcc, 0x34, 0xd4, 0x31, 0x6a, 0x9b, 0xb0, 0xb8, 0x4a, 0xe5,
-	0x5d, 0x4f, 0x52, 0x31, 0xc6, 0x2f, 0x29, 0xed, 0x78, 0x09, 0xad, 0x3d, 0x97, 0xf0, 0x77, 0x0b,
-	0x8e, 0xee, 0x90, 0xe8, 0xbf, 0x5d, 0xc0, 0x47, 0x16, 0x94, 0xd2, 0x14, 0xa4, 0x09, 0x05, 0xcf,
-	0xf7, 0x63, 0x2a, 0x04, 0xe2, 0x2f, 0xad, 0x54, 0x9e, 0xfe, 0xb2, 0xbc, 0x60, 0xc2, 0x5e, 0xd7,
-	0x9a, 0x0d, 0x19, 0x87, 0x51, 0xe0, 0x26, 0x86, 0x64, 0x19, 0x0a, 0xbc, 0xdf, 0x6e, 0x6d, 0xd3,
-	0xa1, 0xd9, 0xa2, 0x0b, 0xb6, 0x3e, 0xaf, 0x76, 0x72, 0x94, 0xed, 0xeb, 0xd1, 0xd0, 0x9d, 0xe3,
-	0xfd, 0xf6, 0x1d, 0x3a, 0x54, 0x75, 0x1a, 0x30, 0x19, 0x46, 0x41, 0x8b, 0xb3, 0xcf, 0x69, 0x8c,
-	0xd8, 0xf3, 0x6e, 0x59, 0xcb, 0xd6, 0x95, 0x88, 0x9c, 0x81, 0x03, 0x3c, 0x66, 0x9c, 0x09, 0x1a,
-	0xb7, 0x78, 0x1c, 0xb2, 0x38, 0x94, 0xc3, 0xca, 0x2c, 0xda, 0xcd, 0x27, 0x8a, 0x75, 0x23, 0xaf,
-	0x37, 0xe0, 0xf0, 0x2a, 0x95, 0x2b, 0xaa, 0xcc, 0x53, 0x9e, 0xab, 0xfa, 0x57, 0x50, 0x79, 0xd5,
-	0xc5, 0xb4, 0xf1, 0x3c, 0x14, 0x75, 0x1b, 0x43, 0xdf, 0x6c, 0x97, 0x23, 0xd9, 0xae, 0xe8, 0x01,
-	0x81, 0xae, 0x6b, 0x37, 0xdd, 0x02, 0x9a, 0xae, 0xf9, 0x64, 0x19, 0xf6, 0xe1, 0xd2, 0x54, 0xe0,
-	0xf0, 0x0e, 0x2e, 0xae, 0xb6, 0xaa, 0x1f, 0x86, 0xb7, 0xd2, 0xcd, 0xa4, 0x15, 0x1a, 0x71, 0xfd,
-	0x01, 0x1c, 0x7a, 0x59, 0xf1, 0x6f, 0xe2, 0x3a, 0x08, 0x07, 0x56, 0xa9, 0xdc, 0x18, 0x46, 0x1d,
-	0xd5, 0x61, 0x83, 0xc9, 0x06, 0x92, 0x15, 0x1a, 0x3c, 0x15, 0x28, 0x08, 0x2d, 0x42, 0x38, 0x45,
-	0x37, 0xf9, 0xac, 0x2f, 0xa0, 0xfd, 0x3d, 0xe6, 0xd3, 0xb5, 0x68, 0x93, 0x25, 0x51, 0x7e, 0xb2,
-	0xe0, 0xe0, 0x98, 0xd8, 0xc4, 0xb9, 0x00, 0xa5, 0x88, 0xf9, 0xb4, 0x15, 0x46, 0x9b, 0xcc, 0x10,
-	0xab, 0x64, 0x51, 0xf2, 0x26, 0xb7, 0x53, 0xa7, 0x62, 0x64, 0x56, 0xe4, 0x33, 0x38, 0xe8, 0x71,
-	0xde, 0x0d, 0x3b, 0xb8, 0x8b, 0x5b, 0x03, 0x1a, 0x8b, 0xd1, 0x8c, 0x3c, 0x33, 0xf1, 0x4c, 0x69,
-	0x73, 0x8c, 0x49, 0x32, 0x71, 0x8c, 0xbc, 0xfe, 0x43, 0x0e, 0xca, 0x19, 0x1b, 0x42, 0x60, 0x36,
-	0xf2, 0x7a, 0x54, 0x9f, 0x09, 0x17, 0xd7, 0xe4, 0x08, 0x14, 0x3d, 0xce, 0x5b, 0x28, 0xcf, 0xa1,
-	0xbc, 0xe0, 0x71, 0x7e, 0x4f, 0xa9, 0x2a, 0x50, 0x48, 0x00, 0xe5, 0xb5, 0xc6, 0x7c, 0x92, 0xa3,
-	0x00, 0x41, 0x28, 0x5b, 0x1d, 0xd6, 0xeb, 0x85, 0x12, 0xb7, 0x74, 0xc9, 0x2d, 0x05, 0xa1, 0xbc,
-	0x81, 0x02, 0xa5, 0x6e, 0xf7, 0xc3, 0xae, 0xdf, 0x92, 0x5e, 0x20, 0x2a, 0xfb, 0xb4, 0x1a, 0x25,
-	0x1f, 0x7b, 0x81, 0x40, 0x6f, 0x96, 0x72, 0x9d, 0x33, 0xde, 0xcc, 0x20, 0x25, 0xb7, 0x12, 0x6f,
-	0x9f, 0x72, 0x51, 0x29, 0xe0, 0x78, 0x39, 0x31, 0xa9, 0x14, 0x1f, 0x30, 0xbf, 0xdf, 0xa5, 0x26,
-	0xcb, 0x4d, 0xca, 0x05, 0x39, 0x0b, 0xc4, 0xdc, 0x66, 0xc2, 0xdf, 0x4e, 0xb3, 0x15, 0x31, 0xdb,
-	0xbc, 0xd6, 0x6c, 0xf8, 0xdb, 0x49, 0xa9, 0x6e, 0xc3, 0x9c, 0x0e, 0xa1, 0x8a, 0xc4, 0x3d, 0xb9,
-	0x95, 0x14, 0x49, 0xad, 0xb3, 0x95, 0xc8, 0x8d, 0x57, 0x62, 0x1e, 0xf2, 0xa2, 0xdf, 0x33, 0xf5,
-	0x51, 0xcb, 0xfa, 0x16, 0xcc, 0x5f, 0x5f, 0xb9, 0xb1, 0xf6, 0x91, 0x9a, 0x5b, 0xc9, 0x09, 0x26,
-	0x30, 0xeb, 0x7b, 0xd2, 0xc3, 0x98, 0xfb, 0x5d, 0x5c, 0xa7, 0x79, 0x72, 0x99, 0x3c, 0xa3, 0x93,
-	0x9e, 0x1f, 0xbb, 0x41, 0x17, 0x60, 0x1f, 0x8f, 0xd9, 0x80, 0x62, 0xa9, 0x8b, 0xae, 0xfe, 0xa8,
-	0x7f, 0x9b, 0x83, 0x03, 0x99, 0x54, 0x66, 0x27, 0x12, 0x98, 0xed, 0x30, 0x5f, 0x37, 0xf9, 0x7f,
-	0x2e, 0xae, 0x15, 0xca, 0x2e, 0x0b, 0x12, 0x94, 0x5d, 0x16, 0x28, 0x2b, 0xdc, 0xaa, 0xba, 0x77,
-	0xb8, 0x56, 0x59, 0xc2, 0xc8, 0xa7, 0x5f, 0x60, 0xc7, 0xf2, 0xae, 0xfe, 0x50, 0xbe, 0x6a, 0x26,
-	0xce, 0x21, 0x74, 0xb5, 0x54, 0x76, 0x03, 0xaf, 0xdb, 0xa7, 0x95, 0x02, 0xca, 0xf4, 0x07, 0xb9,
-	0x05, 0x25, 0x1e, 0x33, 0xb6, 0xd9, 0x62, 0x5c, 0x60, 0x99, 0xcb, 0xcd, 0xa5, 0x49, 0x5d, 0x5b,
-	0x57, 0x0e, 0x1f, 0x72, 0xe1, 0x16, 0xb9, 0x59, 0x65, 0x4a, 0x50, 0x1a, 0x2b, 0xc1, 0x22, 0x94,
-	0x14, 0x15, 0xc1, 0xbd, 0x0e, 0xad, 0x80, 0xde, 0x33, 0xa9, 0xe0, 0xfd, 0xd9, 0x62, 0x6e, 0x3e,
-	0x5f, 0xbf, 0x01, 0x05, 0x13, 0x51, 0xf1, 0x53, 0x83, 0x21, 0xe9, 0xa2, 0x5a, 0x27, 0x4c, 0x72,
-	0x23, 0x26, 0x49, 0x5f, 0xf2, 0xa3, 0xbe, 0xd4, 0xef, 0x40, 0x31, 0x81, 0x45, 0xae, 0x41, 0x5e,
-	0xb1, 0xb1, 0x70, 0x0f, 0x9e, 0x9c, 0x92, 0xcd, 0xca, 0xec, 0xe3, 0x3f, 0x8f, 0xcd, 0xb8, 0xca,
-	0xb3, 0xf9, 0x33, 0x40, 0x61, 0x83, 0xc6, 0x83, 0xb0, 0x43, 0xc9, 0x8f, 0x16, 0x94, 0x33, 0xa3,
-	0x83, 0x34, 0x27, 0xc5, 0x7b, 0x75, 0xfc, 0x54, 0xcf, 0xed, 0xca, 0x47, 0xef, 0x88, 0x7a, 0xe3,
-	0x9b, 0xdf, 0xfe, 0xfe, 0x3e, 0x77, 0x86, 0x9c, 0x72, 0x26, 0xbc, 0x7e, 0xd3, 0x09, 0x46, 0x1e,
-	0x5a, 0x00, 0xa3, 0x69, 0x49, 0x1a, 0x53, 0xa4, 0x1d, 0x1f, 0xb7, 0xd5, 0xe6, 0x6e, 0x5c, 0x0c,
-	0x50, 0x07, 0x81, 0x9e, 0x22, 0x27, 0x27, 0x01, 0x35, 0x33, 0x9a, 0xfc, 0x6a, 0xc1, 0xff, 0xc7,
-	0x2f, 0x1a, 0x72, 0x61, 0x8a, 0xbc, 0xaf, 0xde, 0x58, 0xd5, 0x8b, 0xbb, 0x75, 0x33, 0x90, 0x2f,
-	0x20, 0x64, 0x87, 0x2c, 0x4f, 0x82, 0x8c, 0x37, 0x93, 0x70, 0xba, 0x18, 0x83, 0x3c, 0xb2, 0x60,
-	0xfe, 0xe5, 0xbb, 0x9b, 0x5c, 0x9a, 0x02, 0xc3, 0xeb, 0x1e, 0x08, 0xd5, 0xcb, 0xbb, 0x77, 0x34,
-	0xf0, 0x2f, 0x21, 0xfc, 0x06, 0x71, 0xa6, 0x84, 0xff, 0xa5, 0x3e, 0x8d, 0x0f, 0xc8, 0x53, 0x2b,
-	0x73, 0xf7, 0x67, 0x1f, 0x92, 0xe4, 0xca, 0xd4, 0x95, 0x7c, 0xcd, 0x43, 0xb7, 0xfa, 0xee, 0x1e,
-	0xbd, 0x0d, 0x9f, 0x2b, 0xc8, 0xe7, 0x22, 0x39, 0x3f, 0x89, 0xcf, 0xe8, 0x0d, 0x4a, 0x65, 0xda,
-	0x95, 0x3f, 0x2c, 0x7c, 0x84, 0xbd, 0xee, 0x0f, 0x06, 0xb9, 0x3a, 0x05, 0xb0, 0x37, 0xfc, 0x39,
-	0xaa, 0x5e, 0xdb, 0xb3, 0xbf, 0xa1, 0x76, 0x15, 0xa9, 0x5d, 0x26, 0x17, 0x77, 0x47, 0x2d, 0xed,
-	0xd8, 0x43, 0x0b, 0x4a, 0xe9, 0x6d, 0x41, 0xde, 0x99, 0x04, 0xe7, 0xe5, 0x3b, 0xac, 0xda, 0xd8,
-	0x85, 0x87, 0x81, 0xdc, 0x44, 0xc8, 0x67, 0xc9, 0xe9, 0x49, 0x90, 0xbd, 0x76, 0x27, 0x6c, 0xe1,
-	0x4b, 0x7f, 0xe5, 0xee, 0xe3, 0xe7, 0x35, 0xeb, 0xc9, 0xf3, 0x9a, 0xf5, 0xd7, 0xf3, 0x9a, 0xf5,
-	0xdd, 0x8b, 0xda, 0xcc, 0x93, 0x17, 0xb5, 0x99, 0x67, 0x2f, 0x6a, 0x33, 0x9f, 0x36, 0x83, 0x50,
-	0x6e, 0xf5, 0xdb, 0x76, 0x87, 0xf5, 0x92, 0x78, 0xfa, 0x67, 0x59, 0xf8, 0xdb, 0x4e, 0xa7, 0x1b,
-	0xd2, 0x48, 0x3a, 0x41, 0xcc, 0x3b, 0x8e, 0xec, 0x09, 0x3d, 0x73, 0xdb, 0x73, 0xf8, 0x76, 0x3f,
-	0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb1, 0xf9, 0xc8, 0xf8, 0x0f, 0x00, 0x00,
+	// 1438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xda, 0x21, 0xb6, 0x9f, 0xa1, 0x0a, 0x43, 0x00, 0x63, 0x05, 0x93, 0xae, 0xd4, 0x12,
+	0x08, 0xd9, 0x6d, 0x0c, 0x01, 0x0e, 0x14, 0x44, 0xf8, 0x08, 0x29, 0x94, 0xa6, 0x9b, 0xaa, 0x87,
+	0xaa, 0x92, 0xb5, 0xf6, 0x4e, 0xd6, 0xab, 0xd8, 0x3b, 0xc3, 0xce, 0x38, 0xad, 0x5b, 0xa1, 0x56,
+	0xfd, 0x03, 0xaa, 0x4a, 0xfd, 0x17, 0x38, 0xb4, 0x3d, 0x57, 0x3d, 0xf4, 0xc0, 0x99, 0x23, 0xa2,
+	0x52, 0x85, 0x7a, 0xa8, 0x2a, 0xe8, 0xb1, 0x7f, 0x44, 0x35, 0x1f, 0xbb, 0xde, 0xcd, 0x07, 0xb6,
+	0x39, 0x54, 0xea, 0x69, 0xdf, 0xbc, 0xaf, 0xf9, 0xbd, 0xf7, 0x66, 0xde, 0x9b, 0x85, 0xb3, 0x2d,
+	0xc2, 0xba, 0x84, 0xd9, 0x4d, 0x97, 0x61, 0x9b, 0xe3, 0xd0, 0xc3, 0x51, 0x37, 0x08, 0xb9, 0xbd,
+	0xbd, 0xd4, 0xc4, 0xdc, 0x5d, 0xb2, 0x1f, 0xf4, 0x70, 0xd4, 0xb7, 0x68, 0x44, 0x38, 0x41, 0x35,
+	0xa5, 0x6b, 0x09, 0x5d, 0x6b, 0xa0, 0x6b, 0x69, 0xdd, 0xea, 0x8c, 0x4f, 0x7c, 0x22, 0x55, 0x6d,
+	0x41, 0x29, 0xab, 0xea, 0x09, 0x9f, 0x10, 0xbf, 0x83, 0x6d, 0xb9, 0x6a, 0xf6, 0x36, 0x6d, 0x37,
+	0xd4, 0x0e, 0xab, 0xb3, 0x5a, 0xe4, 0xd2, 0xc0, 0x76, 0xc3, 0x90, 0x70, 0x97, 0x07, 0x24, 0x64,
+	0x5a, 0x5a, 0x4d, 0xc1, 0xa1, 0x75, 0x6a, 0xf3, 0x3e, 0xc5, 0xb1, 0x6c, 0x36, 0x25, 0x93, 0x7c,
+	0xbb, 0xd9, 0x21, 0xad, 0xad, 0x7d, 0xa5, 0x69, 0xdb, 0x4c, 0xc8, 0x32, 0xbe, 0x24, 0x5a, 0xea,
+	0xfa, 0x41, 0x28, 0x41, 0x68, 0xdd, 0x85, 0xb4, 0x2e, 0xe3, 0x24, 0xc2, 0x89, 0x6e, 0x8b, 0x74,
+	0xbb, 0x01, 0x6f, 0x04, 0xe1, 0x66, 0x12, 0xa9, 0x52, 0x6e, 0xa8, 0x14, 0xa8, 0x85, 0x12, 0x99,
+	0x5f, 0x1b, 0x50, 0x5b, 0xc5, 0xfc, 0x63, 0xb7, 0x13, 0x78, 0x2e, 0x27, 0xd1, 0x06, 0xe6, 0x2b,
+	0xfd, 0x3b, 0x38, 0xf0, 0xdb, 0xdc, 0xc1, 0x0f, 0x7a, 0x98, 0x71, 0x74, 0x0c, 0xa6, 0xda, 0x92,
+	0x51, 0x31, 0xe6, 0x8c, 0xf9, 0xbc, 0xa3, 0x57, 0xe8, 0x36, 0xc0, 0x00, 0x56, 0x25, 0x37, 0x67,
+	0xcc, 0x97, 0xeb, 0x6f, 0x5b, 0xe9, 0x52, 0xa8, 0x1a, 0x69, 0x5c, 0xd6, 0xba, 0xeb, 0x63, 0xed,
+	0xd3, 0x49, 0x59, 0x9a, 0xcf, 0x0d, 0x38, 0xb5, 0x2f, 0x04, 0x46, 0x49, 0xc8, 0x30, 0x7a, 0x13,
+	0x0e, 0xca, 0x3c, 0x36, 0x32, 0x48, 0xca, 0x92, 0xa7, 0x54, 0xd1, 0x1a, 0xc0, 0x76, 0xec, 0x82,
+	0x55, 0x72, 0x73, 0xf9, 0xf9, 0x72, 0xfd, 0x8c, 0xf5, 0xea, 0x93, 0x61, 0x25, 0x9b, 0x3a, 0x29,
+	0x63, 0xb4, 0x9a, 0x89, 0x2c, 0x2f, 0x23, 0x3b, 0x3d, 0x34, 0x32, 0x05, 0x35, 0x13, 0xda, 0x26,
+	0xcc, 0xae, 0x62, 0x7e, 0xcf, 0xe5, 0x98, 0x65, 0xe2, 0x8b, 0x53, 0x9b, 0x4d, 0xa1, 0xf1, 0xda,
+	0x29, 0xfc, 0xdd, 0x80, 0x93, 0xfb, 0x6c, 0xf4, 0xff, 0x4e, 0xe0, 0x63, 0x03, 0x4a, 0xc9, 0x16,
+	0xa8, 0x0e, 0x05, 0xd7, 0xf3, 0x22, 0xcc, 0x98, 0xc4, 0x5f, 0x5a, 0xa9, 0x3c, 0xfb, 0x79, 0x71,
+	0x46, 0xbb, 0xbd, 0xae, 0x24, 0x1b, 0x3c, 0x0a, 0x42, 0xdf, 0x89, 0x15, 0xd1, 0x22, 0x14, 0x68,
+	0xaf, 0xd9, 0xd8, 0xc2, 0x7d, 0x7d, 0x44, 0x67, 0x2c, 0x75, 0xb9, 0xad, 0xf8, 0xde, 0x5b, 0xd7,
+	0xc3, 0xbe, 0x33, 0x45, 0x7b, 0xcd, 0xbb, 0xb8, 0x2f, 0xf2, 0xb4, 0x4d, 0x78, 0x10, 0xfa, 0x0d,
+	0x4a, 0x3e, 0xc3, 0x91, 0xc4, 0x9e, 0x77, 0xca, 0x8a, 0xb7, 0x2e, 0x58, 0x68, 0x01, 0x0e, 0xd3,
+	0x88, 0x50, 0xc2, 0x70, 0xd4, 0xa0, 0x51, 0x40, 0xa2, 0x80, 0xf7, 0x2b, 0x93, 0x52, 0x6f, 0x3a,
+	0x16, 0xac, 0x6b, 0xbe, 0xb9, 0x04, 0xc7, 0x57, 0x31, 0x5f, 0x11, 0x69, 0x1e, 0xf1, 0x5e, 0x99,
+	0x5f, 0x41, 0x65, 0xb7, 0x89, 0x2e, 0xe3, 0x05, 0x28, 0xaa, 0x32, 0x06, 0x9e, 0x3e, 0x2e, 0x27,
+	0xd2, 0x55, 0x51, 0xdd, 0x44, 0x9a, 0xae, 0xdd, 0x74, 0x0a, 0x52, 0x75, 0xcd, 0x43, 0x8b, 0x70,
+	0x40, 0x92, 0x3a, 0x03, 0xc7, 0xf7, 0x31, 0x71, 0x94, 0x96, 0x79, 0x1c, 0x8e, 0x26, 0x87, 0x49,
+	0x09, 0x14, 0x62, 0xf3, 0x21, 0x1c, 0xdb, 0x29, 0xf8, 0x2f, 0x71, 0x1d, 0x81, 0xc3, 0xab, 0x98,
+	0x6f, 0xf4, 0xc3, 0x96, 0xa8, 0xb0, 0xc6, 0x64, 0x01, 0x4a, 0x33, 0x35, 0x9e, 0x0a, 0x14, 0x98,
+	0x62, 0x49, 0x38, 0x45, 0x27, 0x5e, 0x9a, 0x33, 0x52, 0xff, 0x3e, 0xf1, 0xf0, 0x5a, 0xb8, 0x49,
+	0x62, 0x2f, 0x3f, 0x19, 0x70, 0x24, 0xc3, 0xd6, 0x7e, 0x96, 0xa1, 0x14, 0x12, 0x0f, 0xcb, 0x66,
+	0xaa, 0x03, 0xab, 0xa4, 0x51, 0xd2, 0x3a, 0xb5, 0x12, 0xa3, 0x62, 0xa8, 0x29, 0xf4, 0x29, 0x1c,
+	0x71, 0x29, 0xed, 0x04, 0x2d, 0x79, 0x8a, 0x1b, 0xdb, 0x38, 0x62, 0x83, 0x1e, 0xb9, 0x30, 0xf4,
+	0x4e, 0x29, 0x75, 0xe9, 0x13, 0xa5, 0xfc, 0x68, 0xbe, 0xf9, 0x43, 0x0e, 0xca, 0x29, 0x1d, 0x84,
+	0x60, 0x32, 0x74, 0xbb, 0x58, 0xdd, 0x09, 0x47, 0xd2, 0xe8, 0x04, 0x14, 0x5d, 0x4a, 0x1b, 0x92,
+	0x9f, 0x93, 0xfc, 0x82, 0x4b, 0xe9, 0x7d, 0x21, 0xaa, 0x40, 0x21, 0x06, 0x94, 0x57, 0x12, 0xbd,
+	0x44, 0x27, 0x01, 0xfc, 0x80, 0x37, 0xd4, 0x00, 0x91, 0x47, 0xba, 0xe4, 0x94, 0xfc, 0x80, 0xdf,
+	0x90, 0x0c, 0x21, 0x6e, 0xf6, 0x82, 0x8e, 0xd7, 0xe0, 0xae, 0xcf, 0x2a, 0x07, 0x94, 0x58, 0x72,
+	0x3e, 0x72, 0x7d, 0x26, 0xad, 0x49, 0x12, 0xeb, 0x94, 0xb6, 0x26, 0x1a, 0x29, 0xba, 0x15, 0x5b,
+	0x7b, 0x98, 0xb2, 0x4a, 0x61, 0x2e, 0xbf, 0xab, 0xd7, 0xed, 0x91, 0x8a, 0xf7, 0x89, 0xd7, 0xeb,
+	0x60, 0xbd, 0xcb, 0x4d, 0x4c, 0x19, 0x3a, 0x07, 0x48, 0x4f, 0x33, 0xe6, 0x6d, 0x25, 0xbb, 0x15,
+	0xe5, 0x6e, 0xd3, 0x4a, 0xb2, 0xe1, 0x6d, 0xc5, 0xa9, 0xba, 0x03, 0x53, 0xca, 0x85, 0x48, 0x12,
+	0x75, 0x79, 0x3b, 0x4e, 0x92, 0xa0, 0xd3, 0x99, 0xc8, 0x65, 0x33, 0x31, 0x0d, 0x79, 0xd6, 0xeb,
+	0xea, 0xfc, 0x08, 0xd2, 0x6c, 0xc3, 0xf4, 0xf5, 0x95, 0x1b, 0x6b, 0x1f, 0x8a, 0xbe, 0x15, 0xdf,
+	0x60, 0x04, 0x93, 0x9e, 0xcb, 0x5d, 0xe9, 0xf3, 0xa0, 0x23, 0xe9, 0x64, 0x9f, 0x5c, 0x6a, 0x9f,
+	0xc1, 0x4d, 0xcf, 0x67, 0x26, 0xe8, 0x0c, 0x1c, 0xa0, 0x11, 0xd9, 0xc6, 0x32, 0xd5, 0x45, 0x47,
+	0x2d, 0xcc, 0x6f, 0x73, 0x70, 0x38, 0xb5, 0x95, 0x3e, 0x89, 0x08, 0x26, 0x5b, 0xc4, 0x53, 0x45,
+	0x3e, 0xe4, 0x48, 0x5a, 0xa0, 0xec, 0x10, 0x3f, 0x46, 0xd9, 0x21, 0xbe, 0xd0, 0x92, 0x47, 0x55,
+	0xd5, 0x4e, 0xd2, 0x62, 0x97, 0x20, 0xf4, 0xf0, 0xe7, 0xb2, 0x62, 0x79, 0x47, 0x2d, 0x84, 0xad,
+	0xe8, 0x89, 0x53, 0x12, 0xba, 0x20, 0x85, 0xde, 0xb6, 0xdb, 0xe9, 0xe1, 0x4a, 0x41, 0xf2, 0xd4,
+	0x02, 0xdd, 0x82, 0x12, 0x8d, 0x08, 0xd9, 0x6c, 0x10, 0xca, 0x64, 0x9a, 0xcb, 0xf5, 0xf9, 0x61,
+	0x55, 0x5b, 0x17, 0x06, 0x1f, 0x50, 0xe6, 0x14, 0xa9, 0xa6, 0x52, 0x29, 0x28, 0x65, 0x52, 0x30,
+	0x0b, 0x25, 0x11, 0x0a, 0xa3, 0x6e, 0x0b, 0x57, 0x40, 0x9d, 0x99, 0x84, 0xf1, 0xde, 0x64, 0x31,
+	0x37, 0x9d, 0x37, 0x6f, 0x40, 0x41, 0x7b, 0x14, 0xf1, 0x89, 0xc6, 0x10, 0x57, 0x51, 0xd0, 0x71,
+	0x24, 0xb9, 0x41, 0x24, 0x71, 0x5d, 0xf2, 0x83, 0xba, 0x98, 0x77, 0xa1, 0x18, 0xc3, 0x42, 0xd7,
+	0x20, 0x2f, 0xa2, 0x31, 0xe6, 0xf2, 0xbb, 0xe6, 0xd2, 0xfe, 0xd1, 0xac, 0x4c, 0x3e, 0xf9, 0xf3,
+	0xd4, 0x84, 0x23, 0x2c, 0x4d, 0x0b, 0x66, 0x56, 0xb1, 0xbe, 0x16, 0xa9, 0x36, 0xb2, 0x6f, 0x4b,
+	0xff, 0x02, 0x8e, 0xee, 0xd0, 0xd7, 0x55, 0xd5, 0xd7, 0xb4, 0xed, 0xb2, 0xb6, 0x3e, 0x45, 0xe2,
+	0x9a, 0xde, 0x71, 0x59, 0x1b, 0xdd, 0x86, 0x72, 0xea, 0x25, 0xa7, 0x7b, 0xc7, 0x5b, 0x19, 0xb0,
+	0xf2, 0xdd, 0x97, 0xe0, 0x4c, 0xb9, 0x87, 0x56, 0x42, 0xd7, 0xff, 0x29, 0x43, 0x61, 0x03, 0x47,
+	0xdb, 0x41, 0x0b, 0xa3, 0x1f, 0x0d, 0x28, 0xa7, 0xda, 0x1c, 0xaa, 0x0f, 0x8b, 0x7d, 0x77, 0xab,
+	0xac, 0x9e, 0x1f, 0xcb, 0x46, 0xc5, 0x69, 0x2e, 0x7d, 0xf3, 0xdb, 0xdf, 0xdf, 0xe7, 0x16, 0xd0,
+	0x19, 0x7b, 0xc8, 0xb3, 0x3e, 0xe9, 0xb6, 0xe8, 0x91, 0x01, 0x30, 0xe8, 0xec, 0x68, 0x69, 0x84,
+	0x6d, 0xb3, 0xa3, 0xa1, 0x5a, 0x1f, 0xc7, 0x44, 0x03, 0xb5, 0x25, 0xd0, 0x33, 0xe8, 0xf4, 0x30,
+	0xa0, 0x7a, 0x9e, 0xa0, 0x5f, 0x0c, 0x78, 0x23, 0x3b, 0x14, 0xd1, 0xf2, 0x08, 0xfb, 0xee, 0x9e,
+	0xae, 0xd5, 0x8b, 0xe3, 0x9a, 0x69, 0xc8, 0xcb, 0x12, 0xb2, 0x8d, 0x16, 0x87, 0x41, 0x96, 0x53,
+	0x94, 0xd9, 0x1d, 0xe9, 0x03, 0x3d, 0x36, 0x60, 0x7a, 0xe7, 0x3b, 0x03, 0x5d, 0x1a, 0x01, 0xc3,
+	0x5e, 0x8f, 0x99, 0xea, 0xe5, 0xf1, 0x0d, 0x35, 0xfc, 0x4b, 0x12, 0xfe, 0x12, 0xb2, 0x47, 0x84,
+	0xff, 0xa5, 0xba, 0x53, 0x0f, 0xd1, 0x33, 0x23, 0xf5, 0x4e, 0x49, 0x3f, 0x7a, 0xd1, 0x95, 0x91,
+	0x33, 0xb9, 0xc7, 0xa3, 0xbc, 0xfa, 0xee, 0x6b, 0x5a, 0xeb, 0x78, 0xae, 0xc8, 0x78, 0x2e, 0xa2,
+	0x0b, 0xc3, 0xe2, 0x19, 0xbc, 0x97, 0x31, 0x4f, 0xaa, 0xf2, 0x87, 0x21, 0x1f, 0x8c, 0x7b, 0xfd,
+	0x0c, 0xa1, 0xab, 0x23, 0x00, 0x7b, 0xc5, 0x8f, 0x5c, 0xf5, 0xda, 0x6b, 0xdb, 0xeb, 0xd0, 0xae,
+	0xca, 0xd0, 0x2e, 0xa3, 0x8b, 0xe3, 0x85, 0x96, 0x54, 0xec, 0x91, 0x01, 0xa5, 0x64, 0xb2, 0xa1,
+	0x77, 0x86, 0xc1, 0xd9, 0x39, 0x6f, 0xab, 0x4b, 0x63, 0x58, 0x68, 0xc8, 0x75, 0x09, 0xf9, 0x1c,
+	0x3a, 0x3b, 0x0c, 0xb2, 0xdb, 0x6c, 0x05, 0x0d, 0xf9, 0x57, 0x82, 0x7e, 0x35, 0xe0, 0x50, 0xa6,
+	0x5d, 0xa3, 0x0b, 0x23, 0x64, 0x6e, 0xd7, 0x34, 0xa8, 0x2e, 0x8f, 0x69, 0x35, 0xee, 0x01, 0x4a,
+	0x8d, 0x87, 0x24, 0xc7, 0x2b, 0xf7, 0x9e, 0xbc, 0xa8, 0x19, 0x4f, 0x5f, 0xd4, 0x8c, 0xbf, 0x5e,
+	0xd4, 0x8c, 0xef, 0x5e, 0xd6, 0x26, 0x9e, 0xbe, 0xac, 0x4d, 0x3c, 0x7f, 0x59, 0x9b, 0xf8, 0xa4,
+	0xee, 0x07, 0xbc, 0xdd, 0x6b, 0x5a, 0x2d, 0xd2, 0x8d, 0x3d, 0xab, 0xcf, 0x22, 0xf3, 0xb6, 0xec,
+	0x56, 0x27, 0xc0, 0x21, 0xb7, 0xfd, 0x88, 0xb6, 0x6c, 0xde, 0x65, 0x6a, 0x60, 0x34, 0xa7, 0xe4,
+	0x4f, 0xd2, 0xf9, 0x7f, 0x07, 0x00, 0xc3, 0x5e, 0x4b, 0xe4, 0x8e, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1290,6 +1399,10 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// GetCommitInfo queries the commit info of the application multistore at a
+	// given height, i.e. the commit hash of each of its stores, to find the stores
+	// which differ between nodes which disagree on the app hash.
+	GetCommitInfo(ctx context.Context, in *GetCommitInfoRequest, opts ...grpc.CallOption) (*GetCommitInfoResponse, error)
 }
 
 type serviceClient struct {
@@ -1363,6 +1476,15 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) GetCommitInfo(ctx context.Context, in *GetCommitInfoRequest, opts ...grpc.CallOption) (*GetCommitInfoResponse, error) {
+	out := new(GetCommitInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetCommitInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetNodeInfo queries the current node info.
@@ -1383,6 +1505,10 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// GetCommitInfo queries the commit info of the application multistore at a
+	// given height, i.e. the commit hash of each of its stores, to find the stores
+	// which differ between nodes which disagree on the app hash.
+	GetCommitInfo(context.Context, *GetCommitInfoRequest) (*GetCommitInfoResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1410,6 +1536,9 @@ func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context,
 func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (*UnimplementedServiceServer) GetCommitInfo(ctx context.Context, req *GetCommitInfoRequest) (*GetCommitInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitInfo not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1541,6 +1670,24 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetCommitInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/GetCommitInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetCommitInfo(ctx, req.(*GetCommitInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1573,6 +1720,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "GetCommitInfo",
+			Handler:    _Service_GetCommitInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
@@ -2432,6 +2583,76 @@ func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetCommitInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommitInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommitInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCommitInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommitInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommitInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommitInfo != nil {
+		{
+			size, err := m.CommitInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2806,6 +3027,35 @@ func (m *ProofOps) Size() (n int) {
 	return n
 }
 
+func (m *GetCommitInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetCommitInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CommitInfo != nil {
+		l = m.CommitInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5242,6 +5492,195 @@ func (m *ProofOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetCommitInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCommitInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCommitInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCommitInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCommitInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCommitInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitInfo == nil {
+				m.CommitInfo = &types2.CommitInfo{}
+			}
+			if err := m.CommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	qtypes "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -243,6 +244,34 @@ func (s queryServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABC
 	return FromABCIResponseQuery(res), nil
 }
 
+// GetCommitInfo implements ServiceServer.GetCommitInfo
+func (s queryServer) GetCommitInfo(_ context.Context, req *GetCommitInfoRequest) (*GetCommitInfoResponse, error) {
+	if s.queryFn == nil {
+		return nil, status.Error(codes.Internal, "ABCI Query handler undefined")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+
+	res := s.queryFn(abci.RequestQuery{Path: "/app/commit_info", Height: req.Height})
+	if !res.IsOK() {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to query the commit info: %s", res.Log)
+	}
+
+	cInfo := &storetypes.CommitInfo{}
+	if err := cInfo.Unmarshal(res.Value); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode the commit info: %s", err)
+	}
+
+	return &GetCommitInfoResponse{
+		AppHash:    cInfo.Hash(),
+		CommitInfo: cInfo,
+	}, nil
+}
+
 // RegisterTendermintService registers the tendermint queries on the gRPC router.
 func RegisterTendermintService(
 	clientCtx client.Context,
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StoreHashOutput is the commit info of a store in the output of the commit-info command.
+type StoreHashOutput struct {
+	Name    string `json:"name"`
+	Version int64  `json:"version"`
+	Hash    string `json:"hash"`
+}
+
+// CommitInfoOutput is the output of the commit-info command.
+type CommitInfoOutput struct {
+	Height  int64             `json:"height"`
+	AppHash string            `json:"app_hash"`
+	Stores  []StoreHashOutput `json:"stores"`
+}
+
+// CommitInfoCommand returns the commit hashes of the application stores at a given height.
+func CommitInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-info [height]",
+		Short: "Get the commit hash of each application store at given height",
+		Long: `Get the app hash and the commit hash of each application store at given height, or the
+latest height. Comparing the output of two nodes which disagree on the app hash of a height shows
+which stores differ.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if len(args) > 0 {
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/commit_info", Height: height})
+			if err != nil {
+				return err
+			}
+			if !res.IsOK() {
+				return fmt.Errorf("failed to query the commit info: %s", res.Log)
+			}
+
+			cInfo := &storetypes.CommitInfo{}
+			if err := cInfo.Unmarshal(res.Value); err != nil {
+				return err
+			}
+
+			out := CommitInfoOutput{
+				Height:  cInfo.Version,
+				AppHash: fmt.Sprintf("%X", cInfo.Hash()),
+				Stores:  make([]StoreHashOutput, len(cInfo.StoreInfos)),
+			}
+			for i, storeInfo := range cInfo.StoreInfos {
+				out.Stores[i] = StoreHashOutput{
+					Name:    storeInfo.Name,
+					Version: storeInfo.CommitId.Version,
+					Hash:    fmt.Sprintf("%X", storeInfo.CommitId.Hash),
+				}
+			}
+
+			bz, err := json.Marshal(out)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -39,8 +40,13 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	gasPriceEstimator  GasPriceEstimator
 }
 
+// GasPriceEstimator returns the gas price of a transaction which sets neither
+// fees nor gas prices, e.g. from the base fee of the chain.
+type GasPriceEstimator func(clientConn gogogrpc.ClientConn) (sdk.DecCoin, error)
+
 // NewFactoryCLI creates a new Factory.
 func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	signModeStr := clientCtx.SignModeStr
@@ -115,6 +121,10 @@ func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHe
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// GasPriceEstimator returns the estimator of the gas prices of the transactions
+// which set neither fees nor gas prices, if any
+func (f Factory) GasPriceEstimator() GasPriceEstimator { return f.gasPriceEstimator }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -212,6 +222,15 @@ func (f Factory) WithSimulateAndExecute(sim bool) Factory {
 	return f
 }
 
+// WithGasPriceEstimator returns a copy of the Factory with an updated gas price
+// estimator. A transaction which sets neither fees nor gas prices pays the
+// estimated gas price when it is broadcast. Without an estimator, it is sent
+// without fees.
+func (f Factory) WithGasPriceEstimator(estimator GasPriceEstimator) Factory {
+	f.gasPriceEstimator = estimator
+	return f
+}
+
 // SignMode returns the sign mode configured in the Factory
 func (f Factory) SignMode() signing.SignMode {
 	return f.signMode
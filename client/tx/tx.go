@@ -78,6 +78,16 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
+	if estimator := txf.GasPriceEstimator(); estimator != nil && txf.Fees().IsZero() && txf.GasPrices().IsZero() {
+		gasPrice, err := estimator(clientCtx)
+		if err != nil {
+			return fmt.Errorf("failed to estimate the gas price: %w", err)
+		}
+		if gasPrice.IsPositive() {
+			txf = txf.WithGasPrices(gasPrice.String())
+		}
+	}
+
 	if clientCtx.Simulate {
 		return nil
 	}
@@ -368,6 +368,7 @@ func (cfg Config) DetailString() string {
 		{"Upgrade Dir", cfg.BaseUpgradeDir()},
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
+		{"Upgrade Manifest", cfg.UpgradeManifestPath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
 	}
 
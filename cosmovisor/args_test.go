@@ -340,6 +340,7 @@ func (s *argsTestSuite) TestDetailString() {
 		fmt.Sprintf("Upgrade Dir: %s", home),
 		fmt.Sprintf("Genesis Bin: %s", home),
 		fmt.Sprintf("Monitored File: %s", home),
+		fmt.Sprintf("Upgrade Manifest: %s", home),
 		fmt.Sprintf("Data Backup Dir: %s", home),
 	}
 
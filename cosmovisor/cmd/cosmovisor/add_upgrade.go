@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// FlagUpgradeHeight defines the height at which an upgrade staged with
+	// add-upgrade is performed.
+	FlagUpgradeHeight = "upgrade-height"
+	// FlagForce allows add-upgrade to overwrite an existing upgrade.
+	FlagForce = "force"
+)
+
+func init() {
+	addUpgradeCmd.Flags().Int64(FlagUpgradeHeight, 0, "Write an upgrade-info.json for an upgrade at this height that was not scheduled by governance")
+	addUpgradeCmd.Flags().Bool(FlagForce, false, "Overwrite an existing upgrade executable and upgrade-info.json")
+	rootCmd.AddCommand(addUpgradeCmd)
+}
+
+var addUpgradeCmd = &cobra.Command{
+	Use:   "add-upgrade <upgrade-name> <path to executable>",
+	Short: "Adds an upgrade executable to cosmovisor.",
+	Long: fmt.Sprintf(`Copies the given executable to %[1]s/cosmovisor/upgrades/<upgrade-name>/bin/%[2]s
+so that cosmovisor switches to it when the upgrade happens.
+
+With --%[3]s, the %[1]s/data/upgrade-info.json file is also written, as x/upgrade
+does for an upgrade scheduled by governance. This is meant for emergency upgrades
+coordinated off-chain: cosmovisor performs the upgrade as soon as it detects the file,
+so it must only be used once the chain has halted at the given height.`,
+		cosmovisor.EnvHome, cosmovisor.EnvName, FlagUpgradeHeight),
+	Example: `$ cosmovisor add-upgrade v2 ./build/simd
+$ cosmovisor add-upgrade v2-hotfix ./build/simd --upgrade-height 1234567`,
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := cmd.Context().Value(cosmovisor.LoggerKey).(*zerolog.Logger)
+
+		height, err := cmd.Flags().GetInt64(FlagUpgradeHeight)
+		if err != nil {
+			return err
+		}
+		force, err := cmd.Flags().GetBool(FlagForce)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := cosmovisor.GetConfigFromEnv()
+		if err != nil {
+			return err
+		}
+
+		return AddUpgrade(logger, cfg, args[0], args[1], height, force)
+	},
+}
+
+// AddUpgrade installs the given executable as the binary of the named upgrade.
+// If height is positive, it also writes the upgrade-info.json file monitored
+// by cosmovisor for an upgrade at that height. Existing files are only
+// overwritten if force is true.
+func AddUpgrade(logger *zerolog.Logger, cfg *cosmovisor.Config, upgradeName, pathToExe string, height int64, force bool) error {
+	if strings.TrimSpace(upgradeName) == "" {
+		return fmt.Errorf("upgrade name cannot be empty")
+	}
+	if height < 0 {
+		return fmt.Errorf("--%s must be positive, got %d", FlagUpgradeHeight, height)
+	}
+	if err := ensureRegularFile(pathToExe); err != nil {
+		return err
+	}
+
+	// The upgrade name is normalized in the same way as when reading upgrade-info.json.
+	plan := upgradetypes.Plan{Name: strings.ToLower(upgradeName), Height: height}
+
+	upgradeBin := cfg.UpgradeBin(plan.Name)
+	if _, err := os.Stat(upgradeBin); err == nil && !force {
+		return fmt.Errorf("upgrade executable %q already exists, use --%s to overwrite it", upgradeBin, FlagForce)
+	}
+
+	infoFile := cfg.UpgradeInfoFilePath()
+	if height > 0 {
+		if _, err := os.Stat(infoFile); err == nil && !force {
+			return fmt.Errorf("%q already exists, use --%s to overwrite it", infoFile, FlagForce)
+		}
+	}
+
+	if err := copyExecutable(pathToExe, upgradeBin); err != nil {
+		return err
+	}
+	logger.Info().Str("upgrade", plan.Name).Str("path", upgradeBin).Msg("upgrade executable added")
+
+	if height == 0 {
+		return nil
+	}
+
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(infoFile), 0o755); err != nil {
+		return fmt.Errorf("could not create directory %q: %w", filepath.Dir(infoFile), err)
+	}
+	if err := os.WriteFile(infoFile, bz, 0o600); err != nil {
+		return fmt.Errorf("could not write %q: %w", infoFile, err)
+	}
+	logger.Info().Str("upgrade", plan.Name).Int64("height", height).Str("path", infoFile).Msg("upgrade-info.json written")
+
+	return nil
+}
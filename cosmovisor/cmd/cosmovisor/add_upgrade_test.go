@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestAddUpgrade(t *testing.T) {
+	logger := cosmovisor.NewLogger()
+	home := t.TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	require.NoError(t, os.MkdirAll(cfg.Root(), 0o755))
+	exe := newTestExecutable(t, "v2")
+
+	require.ErrorContains(t, AddUpgrade(logger, cfg, " ", exe, 0, false), "upgrade name cannot be empty")
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "v2", exe, -1, false), "must be positive")
+
+	require.NoError(t, AddUpgrade(logger, cfg, "V2", exe, 0, false))
+	require.NoError(t, cosmovisor.EnsureBinary(cfg.UpgradeBin("v2")))
+	require.NoFileExists(t, cfg.UpgradeInfoFilePath())
+
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "v2", exe, 0, false), "already exists")
+	require.NoError(t, AddUpgrade(logger, cfg, "v2", newTestExecutable(t, "v2-fixed"), 0, true))
+	bz, err := os.ReadFile(cfg.UpgradeBin("v2"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "v2-fixed")
+
+	// emergency upgrade at a given height
+	require.NoError(t, AddUpgrade(logger, cfg, "v3", exe, 1234, false))
+	bz, err = os.ReadFile(cfg.UpgradeInfoFilePath())
+	require.NoError(t, err)
+	var plan upgradetypes.Plan
+	require.NoError(t, json.Unmarshal(bz, &plan))
+	require.Equal(t, "v3", plan.Name)
+	require.Equal(t, int64(1234), plan.Height)
+
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "v4", exe, 2345, false), "already exists")
+	require.NoFileExists(t, cfg.UpgradeBin("v4"))
+	require.NoError(t, AddUpgrade(logger, cfg, "v4", exe, 2345, true))
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/otiai10/copy"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+	cverrors "github.com/cosmos/cosmos-sdk/cosmovisor/errors"
+)
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init <path to executable>",
+	Short: "Initializes a cosmovisor daemon home directory.",
+	Long: fmt.Sprintf(`Initializes the %[1]s/cosmovisor directory tree and copies the given executable
+to %[1]s/cosmovisor/genesis/bin/%[2]s. The current link is set to the genesis directory.
+Both %[1]s and %[2]s must be set.`, cosmovisor.EnvHome, cosmovisor.EnvName),
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := cmd.Context().Value(cosmovisor.LoggerKey).(*zerolog.Logger)
+		return InitializeCosmovisor(logger, args[0])
+	},
+}
+
+// InitializeCosmovisor initializes the cosmovisor directories of the daemon
+// home and installs the given executable as the genesis binary.
+func InitializeCosmovisor(logger *zerolog.Logger, pathToExe string) error {
+	if err := ensureRegularFile(pathToExe); err != nil {
+		return err
+	}
+
+	cfg, err := getConfigForInitCmd()
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Str("path", cfg.Root()).Msg("checking on the cosmovisor directory")
+	for _, dir := range []string{filepath.Dir(cfg.GenesisBin()), cfg.BaseUpgradeDir()} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("could not create directory %q: %w", dir, err)
+		}
+	}
+
+	genBin := cfg.GenesisBin()
+	if _, err := os.Stat(genBin); err == nil {
+		logger.Info().Str("path", genBin).Msg("the genesis executable already exists, leaving it in place")
+	} else {
+		logger.Info().Str("path", genBin).Msg("copying the executable to the genesis bin directory")
+		if err := copyExecutable(pathToExe, genBin); err != nil {
+			return err
+		}
+	}
+
+	// CurrentBin creates the current link to the genesis directory if it does not exist yet.
+	cur, err := cfg.CurrentBin()
+	if err != nil {
+		return fmt.Errorf("could not create the current link: %w", err)
+	}
+	if err := cosmovisor.EnsureBinary(cur); err != nil {
+		return fmt.Errorf("the current executable is invalid: %w", err)
+	}
+
+	logger.Info().Str("path", cur).Msg("cosmovisor initialized, current executable")
+	return nil
+}
+
+// getConfigForInitCmd gets just the configuration elements needed to initialize cosmovisor.
+// Unlike cosmovisor.GetConfigFromEnv, it does not require the cosmovisor directory to exist.
+func getConfigForInitCmd() (*cosmovisor.Config, error) {
+	var errs []error
+	cfg := &cosmovisor.Config{
+		Home: os.Getenv(cosmovisor.EnvHome),
+		Name: os.Getenv(cosmovisor.EnvName),
+	}
+
+	if cfg.Name == "" {
+		errs = append(errs, fmt.Errorf("%s is not set", cosmovisor.EnvName))
+	}
+	switch {
+	case cfg.Home == "":
+		errs = append(errs, fmt.Errorf("%s is not set", cosmovisor.EnvHome))
+	case !filepath.IsAbs(cfg.Home):
+		errs = append(errs, fmt.Errorf("%s must be an absolute path", cosmovisor.EnvHome))
+	}
+
+	if len(errs) > 0 {
+		return nil, cverrors.FlattenErrors(errs...)
+	}
+	return cfg, nil
+}
+
+// ensureRegularFile returns an error if the given path is not a regular file.
+func ensureRegularFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("executable %q not found: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%q is not a regular file", path)
+	}
+	return nil
+}
+
+// copyExecutable copies the file at src to dst, creating the parent directory
+// of dst if needed, and marks it as executable.
+func copyExecutable(src, dst string) error {
+	if err := copy.Copy(src, dst); err != nil {
+		return fmt.Errorf("could not copy %q to %q: %w", src, dst, err)
+	}
+	return cosmovisor.MarkExecutable(dst)
+}
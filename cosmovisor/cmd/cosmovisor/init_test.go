@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+)
+
+// newTestExecutable writes a dummy executable with the given content and returns its path.
+func newTestExecutable(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "dummyd")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho "+content+"\n"), 0o644))
+	return path
+}
+
+func TestInitializeCosmovisor(t *testing.T) {
+	logger := cosmovisor.NewLogger()
+	home := t.TempDir()
+	exe := newTestExecutable(t, "genesis")
+
+	t.Setenv(cosmovisor.EnvHome, "")
+	t.Setenv(cosmovisor.EnvName, "")
+	require.ErrorContains(t, InitializeCosmovisor(logger, exe), cosmovisor.EnvName+" is not set")
+
+	t.Setenv(cosmovisor.EnvName, "dummyd")
+	t.Setenv(cosmovisor.EnvHome, "relative/home")
+	require.ErrorContains(t, InitializeCosmovisor(logger, exe), "must be an absolute path")
+
+	t.Setenv(cosmovisor.EnvHome, home)
+	require.ErrorContains(t, InitializeCosmovisor(logger, filepath.Join(home, "missing")), "not found")
+	require.ErrorContains(t, InitializeCosmovisor(logger, home), "is not a regular file")
+
+	require.NoError(t, InitializeCosmovisor(logger, exe))
+
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	require.NoError(t, cosmovisor.EnsureBinary(cfg.GenesisBin()))
+	require.DirExists(t, cfg.BaseUpgradeDir())
+	cur, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.GenesisBin(), cur)
+
+	// running it again leaves the existing genesis executable in place
+	require.NoError(t, InitializeCosmovisor(logger, newTestExecutable(t, "other")))
+	bz, err := os.ReadFile(cfg.GenesisBin())
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "genesis")
+
+	// the resulting layout is a valid configuration
+	t.Setenv(cosmovisor.EnvSkipBackup, "true")
+	_, err = cosmovisor.GetConfigFromEnv()
+	require.NoError(t, err)
+}
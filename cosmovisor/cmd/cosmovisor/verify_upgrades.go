@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+)
+
+func init() {
+	rootCmd.AddCommand(verifyUpgradesCmd)
+}
+
+var verifyUpgradesCmd = &cobra.Command{
+	Use:   "verify-upgrades",
+	Short: "Verifies the staged upgrade binaries against the upgrade manifest.",
+	Long: `Checks that the binary of each upgrade listed in the cosmovisor/upgrades.json manifest
+is staged in cosmovisor/upgrades/<name>/bin and matches its checksum and version.
+Binaries that are downloaded at upgrade time are reported as missing.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := cosmovisor.GetConfigFromEnv()
+		if err != nil {
+			return err
+		}
+
+		m, err := cfg.LoadUpgradeManifest()
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			return fmt.Errorf("no upgrade manifest found at %s", cfg.UpgradeManifestPath())
+		}
+
+		failed := 0
+		out := cmd.OutOrStdout()
+		for _, u := range m.Upgrades {
+			if err := u.VerifyBinary(cfg.UpgradeBin(u.Name)); err != nil {
+				failed++
+				fmt.Fprintf(out, "%-6s %-12d %s: %v\n", "FAILED", u.Height, u.Name, err)
+				continue
+			}
+			fmt.Fprintf(out, "%-6s %-12d %s\n", "ok", u.Height, u.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d out of %d upgrades failed verification", failed, len(m.Upgrades))
+		}
+		return nil
+	},
+}
@@ -0,0 +1,233 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const manifestFilename = "upgrades.json"
+
+// UpgradeManifest lists the upgrades staged in the upgrades directory, keyed by
+// height, along with what their binaries are expected to be. It lets a node
+// replay through many upgrades without an operator checking each binary.
+type UpgradeManifest struct {
+	Upgrades []ManifestUpgrade `json:"upgrades"`
+}
+
+// ManifestUpgrade is an upgrade listed in the UpgradeManifest.
+type ManifestUpgrade struct {
+	// Name is the name of the upgrade plan, the binary is staged in upgrades/<name>/bin.
+	Name string `json:"name"`
+	// Height is the height of the upgrade plan.
+	Height int64 `json:"height"`
+	// Checksum is the checksum of the binary, in the <type>:<hex> format, e.g. sha256:3f4a...
+	// The sha256 and sha512 types are supported.
+	Checksum string `json:"checksum"`
+	// Version, if set, must be the exact output of the binary's `version` command,
+	// leading and trailing white spaces excluded.
+	Version string `json:"version,omitempty"`
+}
+
+// UpgradeManifestPath is the path to the optional manifest of the staged upgrades.
+func (cfg *Config) UpgradeManifestPath() string {
+	return filepath.Join(cfg.Root(), manifestFilename)
+}
+
+// LoadUpgradeManifest reads the manifest of the staged upgrades.
+// It returns nil, and no error, if there is no manifest.
+func (cfg *Config) LoadUpgradeManifest() (*UpgradeManifest, error) {
+	bz, err := os.ReadFile(cfg.UpgradeManifestPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var m UpgradeManifest
+	d := json.NewDecoder(bytes.NewReader(bz))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", cfg.UpgradeManifestPath(), err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", cfg.UpgradeManifestPath(), err)
+	}
+
+	// keep the upgrades sorted by height
+	sort.Slice(m.Upgrades, func(i, j int) bool { return m.Upgrades[i].Height < m.Upgrades[j].Height })
+	return &m, nil
+}
+
+// Validate returns an error if an upgrade is invalid, or if two upgrades share
+// a height or a name.
+func (m UpgradeManifest) Validate() error {
+	heights := make(map[int64]bool, len(m.Upgrades))
+	names := make(map[string]bool, len(m.Upgrades))
+	for _, u := range m.Upgrades {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+		name := strings.ToLower(u.Name)
+		if heights[u.Height] {
+			return fmt.Errorf("duplicate upgrade height %d", u.Height)
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate upgrade name %q", u.Name)
+		}
+		heights[u.Height] = true
+		names[name] = true
+	}
+	return nil
+}
+
+// Validate returns an error if the upgrade is invalid.
+func (u ManifestUpgrade) Validate() error {
+	if strings.TrimSpace(u.Name) == "" {
+		return errors.New("upgrade name cannot be empty")
+	}
+	if u.Height <= 0 {
+		return fmt.Errorf("upgrade %s: height must be positive, got %d", u.Name, u.Height)
+	}
+	if _, _, err := parseChecksum(u.Checksum); err != nil {
+		return fmt.Errorf("upgrade %s: %w", u.Name, err)
+	}
+	return nil
+}
+
+// Get returns the upgrade at the given height.
+func (m UpgradeManifest) Get(height int64) (ManifestUpgrade, bool) {
+	for _, u := range m.Upgrades {
+		if u.Height == height {
+			return u, true
+		}
+	}
+	return ManifestUpgrade{}, false
+}
+
+// Next returns the first upgrade above the given height whose height is not
+// in skipHeights.
+func (m UpgradeManifest) Next(height int64, skipHeights []int) (ManifestUpgrade, bool) {
+	skip := make(map[int64]bool, len(skipHeights))
+	for _, h := range skipHeights {
+		skip[int64(h)] = true
+	}
+
+	for _, u := range m.Upgrades {
+		if u.Height > height && !skip[u.Height] {
+			return u, true
+		}
+	}
+	return ManifestUpgrade{}, false
+}
+
+// Plan returns the upgrade plan the manifest upgrade is switched to.
+func (u ManifestUpgrade) Plan() upgradetypes.Plan {
+	return upgradetypes.Plan{Name: strings.ToLower(u.Name), Height: u.Height}
+}
+
+// VerifyUpgradeBinary checks the staged binary of the given upgrade against
+// the upgrade listed at the same height in the manifest, if any. It returns an
+// error if the name, the checksum or the version of the binary doesn't match.
+func (cfg *Config) VerifyUpgradeBinary(info upgradetypes.Plan) error {
+	m, err := cfg.LoadUpgradeManifest()
+	if err != nil || m == nil {
+		return err
+	}
+
+	u, ok := m.Get(info.Height)
+	if !ok {
+		return nil
+	}
+	if !strings.EqualFold(u.Name, info.Name) {
+		return fmt.Errorf("upgrade at height %d is %q in the upgrade manifest, got %q", info.Height, u.Name, info.Name)
+	}
+
+	return u.VerifyBinary(cfg.UpgradeBin(info.Name))
+}
+
+// VerifyBinary returns an error if the checksum, or the version if set, of the
+// binary at the given path doesn't match the upgrade.
+func (u ManifestUpgrade) VerifyBinary(bin string) error {
+	if err := EnsureBinary(bin); err != nil {
+		return err
+	}
+
+	checksum, err := fileChecksum(bin, u.Checksum)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(checksum, u.Checksum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", bin, u.Checksum, checksum)
+	}
+
+	if u.Version == "" {
+		return nil
+	}
+	out, err := exec.Command(bin, "version").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("running %s version: %w", bin, err)
+	}
+	if version := strings.TrimSpace(string(out)); version != u.Version {
+		return fmt.Errorf("version mismatch for %s: expected %q, got %q", bin, u.Version, version)
+	}
+
+	return nil
+}
+
+// parseChecksum splits a <type>:<hex> checksum and returns the matching hash.
+func parseChecksum(checksum string) (hash.Hash, string, error) {
+	typ, value, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return nil, "", fmt.Errorf("invalid checksum %q, expected <type>:<hex>", checksum)
+	}
+
+	var h hash.Hash
+	switch strings.ToLower(typ) {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum type %q, expected sha256 or sha512", typ)
+	}
+
+	if bz, err := hex.DecodeString(value); err != nil || len(bz) != h.Size() {
+		return nil, "", fmt.Errorf("invalid %s checksum %q", typ, value)
+	}
+	return h, strings.ToLower(typ), nil
+}
+
+// fileChecksum computes the checksum of the file, of the same type as the
+// given checksum, in the <type>:<hex> format.
+func fileChecksum(path, checksum string) (string, error) {
+	h, typ, err := parseChecksum(checksum)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+	return typ + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,126 @@
+//go:build linux
+// +build linux
+
+package cosmovisor_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// stageUpgradeBinary writes an upgrade binary printing the given version and returns its sha256 checksum.
+func stageUpgradeBinary(t *testing.T, cfg *cosmovisor.Config, name, version string) string {
+	t.Helper()
+	bz := []byte("#!/bin/sh\necho " + version + "\n")
+	bin := cfg.UpgradeBin(name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(bin), 0o755))
+	require.NoError(t, os.WriteFile(bin, bz, 0o755))
+	sum := sha256.Sum256(bz)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func writeManifest(t *testing.T, cfg *cosmovisor.Config, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(cfg.UpgradeManifestPath(), []byte(content), 0o644))
+}
+
+func TestLoadUpgradeManifest(t *testing.T) {
+	cfg := &cosmovisor.Config{Home: t.TempDir(), Name: "dummyd"}
+	require.NoError(t, os.MkdirAll(cfg.Root(), 0o755))
+
+	m, err := cfg.LoadUpgradeManifest()
+	require.NoError(t, err)
+	require.Nil(t, m)
+
+	checksum := "sha256:" + hex.EncodeToString(make([]byte, 32))
+	writeManifest(t, cfg, `{"upgrades": [
+		{"name": "v3", "height": 300, "checksum": "`+checksum+`"},
+		{"name": "v2", "height": 200, "checksum": "`+checksum+`", "version": "v2.0.0"}
+	]}`)
+	m, err = cfg.LoadUpgradeManifest()
+	require.NoError(t, err)
+	require.Equal(t, []cosmovisor.ManifestUpgrade{
+		{Name: "v2", Height: 200, Checksum: checksum, Version: "v2.0.0"},
+		{Name: "v3", Height: 300, Checksum: checksum},
+	}, m.Upgrades)
+	u, found := m.Get(300)
+	require.True(t, found)
+	require.Equal(t, "v3", u.Name)
+	_, found = m.Get(250)
+	require.False(t, found)
+
+	testCases := map[string]struct {
+		manifest string
+		expErr   string
+	}{
+		"unknown field": {`{"upgrades": [{"name": "v2", "height": 200, "sha": "abc"}]}`, "unknown field"},
+		"empty name":    {`{"upgrades": [{"name": "", "height": 200, "checksum": "` + checksum + `"}]}`, "upgrade name cannot be empty"},
+		"zero height":   {`{"upgrades": [{"name": "v2", "checksum": "` + checksum + `"}]}`, "height must be positive"},
+		"no checksum":   {`{"upgrades": [{"name": "v2", "height": 200}]}`, "expected <type>:<hex>"},
+		"md5 checksum":  {`{"upgrades": [{"name": "v2", "height": 200, "checksum": "md5:abcd"}]}`, "unsupported checksum type"},
+		"short sha256":  {`{"upgrades": [{"name": "v2", "height": 200, "checksum": "sha256:abcd"}]}`, "invalid sha256 checksum"},
+		"duplicate height": {`{"upgrades": [
+			{"name": "v2", "height": 200, "checksum": "` + checksum + `"},
+			{"name": "v3", "height": 200, "checksum": "` + checksum + `"}]}`, "duplicate upgrade height 200"},
+		"duplicate name": {`{"upgrades": [
+			{"name": "v2", "height": 200, "checksum": "` + checksum + `"},
+			{"name": "V2", "height": 300, "checksum": "` + checksum + `"}]}`, "duplicate upgrade name"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			writeManifest(t, cfg, tc.manifest)
+			_, err := cfg.LoadUpgradeManifest()
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestUpgradeBinaryWithManifest(t *testing.T) {
+	logger := cosmovisor.NewLogger()
+	cfg := &cosmovisor.Config{Home: t.TempDir(), Name: "dummyd"}
+	require.NoError(t, os.MkdirAll(cfg.Root(), 0o755))
+
+	checksumV2 := stageUpgradeBinary(t, cfg, "v2", "v2.0.0")
+	checksumV3 := stageUpgradeBinary(t, cfg, "v3", "v3.0.0-rc1")
+	stageUpgradeBinary(t, cfg, "v4", "v4.0.0")
+	writeManifest(t, cfg, `{"upgrades": [
+		{"name": "v2", "height": 200, "checksum": "`+checksumV2+`", "version": "v2.0.0"},
+		{"name": "v3", "height": 300, "checksum": "`+checksumV3+`", "version": "v3.0.0"},
+		{"name": "v4", "height": 400, "checksum": "`+checksumV2+`"}
+	]}`)
+
+	// matching checksum and version
+	require.NoError(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v2", Height: 200}))
+	cur, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("v2"), cur)
+
+	// version mismatch
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v3", Height: 300})
+	require.ErrorContains(t, err, `version mismatch`)
+	require.ErrorContains(t, err, `expected "v3.0.0", got "v3.0.0-rc1"`)
+
+	// checksum mismatch
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v4", Height: 400})
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	// name mismatch at a known height
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v4", Height: 200})
+	require.ErrorContains(t, err, `upgrade at height 200 is "v2" in the upgrade manifest, got "v4"`)
+
+	// the current binary is left untouched on failure
+	cur, err = cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("v2"), cur)
+
+	// upgrades which are not in the manifest are not verified
+	require.NoError(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v4", Height: 450}))
+}
@@ -1,6 +1,7 @@
 package cosmovisor
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -19,6 +21,14 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
+const (
+	haltHeightFlag = "halt-height"
+	haltTimeFlag   = "halt-time"
+
+	// haltLogMessage is logged by the app when it halts at its halt height.
+	haltLogMessage = "halting node per configuration"
+)
+
 type Launcher struct {
 	logger *zerolog.Logger
 	cfg    *Config
@@ -31,6 +41,15 @@ func NewLauncher(logger *zerolog.Logger, cfg *Config) (Launcher, error) {
 		return Launcher{}, err
 	}
 
+	// fail early on an invalid upgrade manifest rather than at the upgrade height
+	m, err := cfg.LoadUpgradeManifest()
+	if err != nil {
+		return Launcher{}, err
+	}
+	if m != nil {
+		logger.Info().Int("upgrades", len(m.Upgrades)).Str("path", cfg.UpgradeManifestPath()).Msg("loaded upgrade manifest")
+	}
+
 	return Launcher{logger: logger, cfg: cfg, fw: fw}, nil
 }
 
@@ -47,6 +66,24 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	next, found, err := l.nextManifestUpgrade(args)
+	if err != nil {
+		return false, err
+	}
+	halt := &haltDetector{}
+	if found {
+		if l.manifestUpgradeReached(next) {
+			// the node already halted before the upgrade, but the switch failed
+			return l.doManifestUpgrade(next)
+		}
+
+		// halt the node right after the block before the upgrade, the app then
+		// exits and the staged binary is switched to
+		l.logger.Info().Str("upgrade", next.Name).Int64("height", next.Height).Msg("halting the app at the next upgrade of the upgrade manifest")
+		args = append(args[:len(args):len(args)], fmt.Sprintf("--%s=%d", haltHeightFlag, next.Height-1))
+		stdout, stderr = io.MultiWriter(stdout, halt), io.MultiWriter(stderr, halt)
+	}
+
 	l.logger.Info().Str("path", bin).Strs("args", args).Msg("running app")
 	cmd := exec.Command(bin, args...)
 	cmd.Stdout = stdout
@@ -64,7 +101,15 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	if found && !needsUpdate && halt.Halted() {
+		l.logger.Info().Str("upgrade", next.Name).Int64("height", next.Height).Msg("app halted at the next upgrade of the upgrade manifest")
+		if err := l.recordManifestUpgrade(next); err != nil {
+			return false, err
+		}
+		return l.doManifestUpgrade(next)
+	}
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
@@ -89,6 +134,124 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	return false, nil
 }
 
+// nextManifestUpgrade returns the first upgrade of the upgrade manifest above
+// the height of the current upgrade, which is not skipped. Such an upgrade is
+// only switched to by height when the app is started, and the app halt is not
+// configured by the operator.
+func (l Launcher) nextManifestUpgrade(args []string) (ManifestUpgrade, bool, error) {
+	if len(args) == 0 || args[0] != "start" {
+		return ManifestUpgrade{}, false, nil
+	}
+
+	m, err := l.cfg.LoadUpgradeManifest()
+	if err != nil || m == nil {
+		return ManifestUpgrade{}, false, err
+	}
+
+	// the genesis binary has no upgrade info, i.e. a zero height
+	current, _ := l.cfg.UpgradeInfo()
+	next, found := m.Next(current.Height, UpgradeSkipHeights(args))
+	if !found || next.Height <= 1 {
+		return ManifestUpgrade{}, false, nil
+	}
+
+	for _, arg := range args {
+		if arg == "--"+haltHeightFlag || strings.HasPrefix(arg, "--"+haltHeightFlag+"=") ||
+			arg == "--"+haltTimeFlag || strings.HasPrefix(arg, "--"+haltTimeFlag+"=") {
+			l.logger.Warn().Str("upgrade", next.Name).Int64("height", next.Height).Msg("the app halt is configured, not switching to the next upgrade of the upgrade manifest by height")
+			return ManifestUpgrade{}, false, nil
+		}
+	}
+
+	return next, true, nil
+}
+
+// manifestUpgradeReached reports whether the upgrade info file records the
+// given manifest upgrade, i.e. the app already halted before it.
+func (l Launcher) manifestUpgradeReached(u ManifestUpgrade) bool {
+	info, err := parseUpgradeInfoFile(l.cfg.UpgradeInfoFilePath())
+	return err == nil && info.Height == u.Height && strings.EqualFold(info.Name, u.Name)
+}
+
+// recordManifestUpgrade writes the given manifest upgrade to the upgrade info
+// file, as x/upgrade does for the upgrades done by governance, so that the app
+// can load its store upgrades, and so that the upgrade is not missed if
+// switching to it fails.
+func (l Launcher) recordManifestUpgrade(u ManifestUpgrade) error {
+	bz, err := json.Marshal(u.Plan())
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.cfg.UpgradeInfoFilePath(), bz, 0o600); err != nil {
+		return fmt.Errorf("error while writing upgrade-info.json: %w", err)
+	}
+
+	// the file watcher must not trigger the upgrade again
+	stat, err := os.Stat(l.cfg.UpgradeInfoFilePath())
+	if err != nil {
+		return err
+	}
+	l.fw.currentInfo = u.Plan()
+	l.fw.lastModTime = stat.ModTime()
+	l.fw.initialized = true
+	return nil
+}
+
+// doManifestUpgrade switches to the staged binary of the given manifest upgrade.
+func (l Launcher) doManifestUpgrade(u ManifestUpgrade) (bool, error) {
+	if err := EnsureBinary(l.cfg.UpgradeBin(u.Plan().Name)); err != nil {
+		return false, fmt.Errorf("binary of the upgrade %s of the upgrade manifest is not staged: %w", u.Name, err)
+	}
+
+	l.cfg.WaitRestartDelay()
+
+	if err := l.doBackup(); err != nil {
+		return false, err
+	}
+
+	if err := UpgradeBinary(l.logger, l.cfg, u.Plan()); err != nil {
+		return false, err
+	}
+
+	if err := l.doPreUpgrade(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// haltDetector is an io.Writer watching the app logs for the app halting at
+// its halt height.
+type haltDetector struct {
+	mtx    sync.Mutex
+	tail   []byte
+	halted bool
+}
+
+// Write implements io.Writer. The end of the written bytes is kept, so that
+// the log message is detected when it is split between writes.
+func (d *haltDetector) Write(p []byte) (int, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if !d.halted {
+		bz := append(d.tail, p...)
+		d.halted = bytes.Contains(bz, []byte(haltLogMessage))
+		if n := len(haltLogMessage) - 1; len(bz) > n {
+			bz = bz[len(bz)-n:]
+		}
+		d.tail = append([]byte(nil), bz...)
+	}
+	return len(p), nil
+}
+
+// Halted reports whether the app logged that it halted.
+func (d *haltDetector) Halted() bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	return d.halted
+}
+
 // WaitForUpgradeOrExit checks upgrade plan file created by the app.
 // When it returns, the process (app) is finished.
 //
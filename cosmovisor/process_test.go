@@ -4,7 +4,11 @@
 package cosmovisor_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -164,6 +168,133 @@ func (s *processTestSuite) TestLaunchProcessWithDownloads() {
 	require.Equal(cfg.UpgradeBin("chain3"), currentBin)
 }
 
+// writeHaltingBinary writes a binary printing its name and args, which halts
+// like the app when a halt height is passed, and returns its sha256 checksum.
+func writeHaltingBinary(t *testing.T, bin, name string) string {
+	t.Helper()
+	bz := []byte(`#!/bin/sh
+echo ` + name + ` $@
+for arg in "$@"; do
+  case $arg in --halt-height=*) echo "INF halting node per configuration height=${arg#--halt-height=}" >&2; exit 130;; esac
+done
+`)
+	require.NoError(t, os.MkdirAll(filepath.Dir(bin), 0o755))
+	require.NoError(t, os.WriteFile(bin, bz, 0o755))
+	sum := sha256.Sum256(bz)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// TestLaunchProcessWithManifest checks that the app is halted and switched to
+// the staged binaries at the heights of the upgrade manifest, without any
+// upgrade-info.json written by the app
+func (s *processTestSuite) TestLaunchProcessWithManifest() {
+	require := s.Require()
+	home := s.T().TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, UnsafeSkipBackup: true}
+	logger := cosmovisor.NewLogger()
+	require.NoError(os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	writeHaltingBinary(s.T(), cfg.GenesisBin(), "genesis")
+	v2 := writeHaltingBinary(s.T(), filepath.Join(home, "v2"), "v2")
+	v3 := writeHaltingBinary(s.T(), cfg.UpgradeBin("v3"), "v3")
+	writeManifest(s.T(), cfg, `{"upgrades": [
+		{"name": "v2", "height": 100, "checksum": "`+v2+`"},
+		{"name": "v3", "height": 200, "checksum": "`+v3+`"}
+	]}`)
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	// only the node is halted
+	stdout, stderr := NewBuffer(), NewBuffer()
+	doUpgrade, err := launcher.Run([]string{"version"}, stdout, stderr)
+	require.NoError(err)
+	require.False(doUpgrade)
+	require.Equal("genesis version\n", stdout.String())
+
+	// the genesis binary halts before v2, which is not staged yet
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = launcher.Run([]string{"start", "--home", home}, stdout, stderr)
+	require.ErrorContains(err, "binary of the upgrade v2 of the upgrade manifest is not staged")
+	require.False(doUpgrade)
+	require.Equal("genesis start --home "+home+" --halt-height=99\n", stdout.String())
+	require.Contains(stderr.String(), "halting node per configuration height=99")
+
+	// once staged, v2 is switched to without running the genesis binary again
+	require.NoError(os.MkdirAll(filepath.Dir(cfg.UpgradeBin("v2")), 0o755))
+	require.NoError(os.Rename(filepath.Join(home, "v2"), cfg.UpgradeBin("v2")))
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = launcher.Run([]string{"start", "--home", home}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.Equal("", stdout.String())
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("v2"), currentBin)
+
+	// v2 halts before v3 and is switched to
+	doUpgrade, err = launcher.Run([]string{"start", "--home", home}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.Equal("v2 start --home "+home+" --halt-height=199\n", stdout.String())
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("v3"), currentBin)
+
+	bz, err := os.ReadFile(cfg.UpgradeInfoFilePath())
+	require.NoError(err)
+	require.JSONEq(`{"name":"v3","height":200,"time":"0001-01-01T00:00:00Z"}`, string(bz))
+
+	// v3 is the last upgrade of the manifest, it is not halted
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = launcher.Run([]string{"start", "--home", home}, stdout, stderr)
+	require.NoError(err)
+	require.False(doUpgrade)
+	require.Equal("v3 start --home "+home+"\n", stdout.String())
+}
+
+// TestLaunchProcessWithManifestHaltConfigured checks that the manifest upgrades
+// are not switched to by height when the operator configures the app halt, or
+// skips them
+func (s *processTestSuite) TestLaunchProcessWithManifestHaltConfigured() {
+	require := s.Require()
+	home := s.T().TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, UnsafeSkipBackup: true}
+	logger := cosmovisor.NewLogger()
+	require.NoError(os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	writeHaltingBinary(s.T(), cfg.GenesisBin(), "genesis")
+	v2 := writeHaltingBinary(s.T(), cfg.UpgradeBin("v2"), "v2")
+	v3 := writeHaltingBinary(s.T(), cfg.UpgradeBin("v3"), "v3")
+	writeManifest(s.T(), cfg, `{"upgrades": [
+		{"name": "v2", "height": 100, "checksum": "`+v2+`"},
+		{"name": "v3", "height": 200, "checksum": "`+v3+`"}
+	]}`)
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := NewBuffer(), NewBuffer()
+	doUpgrade, err := launcher.Run([]string{"start", "--halt-height=50"}, stdout, stderr)
+	require.Error(err)
+	require.False(doUpgrade)
+	require.Equal("genesis start --halt-height=50\n", stdout.String())
+
+	// v2 is skipped, the genesis binary halts before v3
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = launcher.Run([]string{"start", "--unsafe-skip-upgrades", "100"}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.Equal("genesis start --unsafe-skip-upgrades 100 --halt-height=199\n", stdout.String())
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("v3"), currentBin)
+}
+
 // TestSkipUpgrade tests heights that are identified to be skipped and return if upgrade height matches the skip heights
 func TestSkipUpgrade(t *testing.T) {
 	cases := []struct {
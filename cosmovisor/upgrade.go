@@ -23,7 +23,10 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, info upgradetypes.Plan)
 	// simplest case is to switch the link
 	err := EnsureBinary(cfg.UpgradeBin(info.Name))
 	if err == nil {
-		// we have the binary - do it
+		// we have the binary - check it against the upgrade manifest and do it
+		if err := cfg.VerifyUpgradeBinary(info); err != nil {
+			return fmt.Errorf("refusing to switch to the upgrade binary: %w", err)
+		}
 		return cfg.SetCurrentUpgrade(info)
 	}
 
@@ -48,6 +51,9 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, info upgradetypes.Plan)
 	if err := EnsureBinary(cfg.UpgradeBin(info.Name)); err != nil {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
+	if err := cfg.VerifyUpgradeBinary(info); err != nil {
+		return fmt.Errorf("refusing to switch to the downloaded binary: %w", err)
+	}
 
 	return cfg.SetCurrentUpgrade(info)
 }
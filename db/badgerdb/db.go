@@ -91,6 +91,12 @@ func NewDBWithOptions(opts badger.Options) (*BadgerDB, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The versions file is only rewritten when versions are saved or deleted, so commits made
+	// after the last saved version are missing from it if the DB was not closed cleanly.
+	// Account for them so that they are reverted like any other unsaved data.
+	if maxTs := d.MaxVersion(); maxTs > vmgr.lastTs {
+		vmgr.lastTs = maxTs
+	}
 	return &BadgerDB{
 		db:   d,
 		vmgr: vmgr,
@@ -138,12 +144,14 @@ func readVersionsFile(path string) (*versionManager, error) {
 	}, nil
 }
 
-// Write version metadata to CSV file
+// Write version metadata to CSV file. The file is replaced atomically, so that it is never
+// left partially written.
 func writeVersionsFile(vm *versionManager, path string) error {
-	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0o644)
+	file, err := os.CreateTemp(filepath.Dir(path), versionsFilename)
 	if err != nil {
 		return err
 	}
+	defer os.Remove(file.Name())
 	defer file.Close()
 	w := csv.NewWriter(file)
 	rows := [][]string{
@@ -160,7 +168,13 @@ func writeVersionsFile(vm *versionManager, path string) error {
 			strconv.FormatUint(ts, 10),
 		})
 	}
-	return w.WriteAll(rows)
+	if err = w.WriteAll(rows); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
 }
 
 func (b *BadgerDB) Reader() db.Reader {
@@ -196,10 +210,14 @@ func (b *BadgerDB) Writer() db.Writer {
 func (b *BadgerDB) Close() error {
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
-	writeVersionsFile(b.vmgr, filepath.Join(b.db.Opts().Dir, versionsFilename))
+	writeVersionsFile(b.vmgr, b.versionsPath())
 	return b.db.Close()
 }
 
+func (b *BadgerDB) versionsPath() string {
+	return filepath.Join(b.db.Opts().Dir, versionsFilename)
+}
+
 // Versions implements Connection.
 // Returns a VersionSet that is valid until the next call to SaveVersion or DeleteVersion.
 func (b *BadgerDB) Versions() (db.VersionSet, error) {
@@ -215,7 +233,12 @@ func (b *BadgerDB) save(target uint64) (uint64, error) {
 		return 0, db.ErrOpenTransactions
 	}
 	b.vmgr = b.vmgr.Copy()
-	return b.vmgr.Save(target)
+	id, err := b.vmgr.Save(target)
+	if err != nil {
+		return 0, err
+	}
+	// Persist the new version right away, so that it survives a crash
+	return id, writeVersionsFile(b.vmgr, b.versionsPath())
 }
 
 // SaveNextVersion implements Connection.
@@ -240,7 +263,11 @@ func (b *BadgerDB) DeleteVersion(target uint64) error {
 	}
 	b.vmgr = b.vmgr.Copy()
 	b.vmgr.Delete(target)
-	return nil
+	// Let compactions discard the data only visible to the versions older than the initial one
+	if ts, has := b.vmgr.versionTs(b.vmgr.Initial()); has {
+		b.db.SetDiscardTs(ts)
+	}
+	return writeVersionsFile(b.vmgr, b.versionsPath())
 }
 
 func (b *BadgerDB) Revert() error {
@@ -356,14 +383,31 @@ func (tx *badgerWriter) Set(key, value []byte) error {
 	if err := dbutil.ValidateKv(key, value); err != nil {
 		return err
 	}
-	return tx.txn.Set(key, value)
+	return tx.write(func() error { return tx.txn.Set(key, value) })
 }
 
 func (tx *badgerWriter) Delete(key []byte) error {
 	if len(key) == 0 {
 		return db.ErrKeyEmpty
 	}
-	return tx.txn.Delete(key)
+	return tx.write(func() error { return tx.txn.Delete(key) })
+}
+
+// Applies a write to the transaction. When the transaction is too big, its previous writes are
+// committed first and the write is applied to a new transaction reading them. They are then
+// visible to the readers of the current state before the transaction is committed, but not to
+// the readers of the saved versions, and are undone by Revert.
+func (tx *badgerWriter) write(fn func() error) error {
+	err := fn()
+	if err != badger.ErrTxnTooBig {
+		return err
+	}
+	ts := tx.db.nextCommitTs(tx.txn.ReadTs())
+	if err = tx.txn.CommitAt(ts, nil); err != nil {
+		return err
+	}
+	tx.txn = tx.db.db.NewTransactionAt(ts, true)
+	return fn()
 }
 
 func (tx *badgerWriter) Commit() (err error) {
@@ -371,15 +415,18 @@ func (tx *badgerWriter) Commit() (err error) {
 		return errors.New("transaction has been discarded")
 	}
 	defer func() { err = dbutil.CombineErrors(err, tx.Discard(), "Discard also failed") }()
-	// Commit to the current commit timestamp, after ensuring it is > ReadTs
-	tx.db.mtx.RLock()
-	tx.db.vmgr.updateCommitTs(tx.txn.ReadTs())
-	ts := tx.db.vmgr.lastTs
-	tx.db.mtx.RUnlock()
-	err = tx.txn.CommitAt(ts, nil)
+	err = tx.txn.CommitAt(tx.db.nextCommitTs(tx.txn.ReadTs()), nil)
 	return
 }
 
+// Returns the current commit timestamp, after ensuring it is > readTs
+func (b *BadgerDB) nextCommitTs(readTs uint64) uint64 {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	b.vmgr.updateCommitTs(readTs)
+	return b.vmgr.lastTs
+}
+
 func (tx *badgerTxn) Discard() error {
 	tx.txn.Discard()
 	return nil
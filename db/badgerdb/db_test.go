@@ -1,8 +1,10 @@
 package badgerdb
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/dgraph-io/badger/v3"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/db"
@@ -39,3 +41,66 @@ func TestRevert(t *testing.T) {
 func TestReloadDB(t *testing.T) {
 	dbtest.DoTestReloadDB(t, load)
 }
+
+func TestReloadWithoutClose(t *testing.T) {
+	dirname := t.TempDir()
+	d := load(t, dirname)
+
+	for i := 1; i <= 3; i++ {
+		txn := d.Writer()
+		require.NoError(t, txn.Set([]byte("key"), []byte{byte(i)}))
+		require.NoError(t, txn.Commit())
+		require.NoError(t, d.SaveVersion(uint64(i)))
+	}
+	require.NoError(t, d.DeleteVersion(1))
+	txn := d.Writer()
+	require.NoError(t, txn.Set([]byte("key"), []byte("unsaved")))
+	require.NoError(t, txn.Commit())
+
+	// Simulate a crash: close the underlying DB without writing the versions file
+	require.NoError(t, d.(*BadgerDB).db.Close())
+	d = load(t, dirname)
+	defer d.Close()
+
+	vset, err := d.Versions()
+	require.NoError(t, err)
+	require.True(t, vset.Exists(2))
+	require.Equal(t, uint64(3), vset.Last())
+	require.False(t, vset.Exists(1))
+
+	require.NoError(t, d.Revert())
+	view := d.Reader()
+	val, err := view.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, val)
+	require.NoError(t, view.Discard())
+}
+
+func TestBigTransaction(t *testing.T) {
+	opts := badger.DefaultOptions(t.TempDir())
+	opts.MemTableSize = 1 << 20
+	opts.ValueThreshold = 1 << 10
+	opts.Logger = nil
+	d, err := NewDBWithOptions(opts)
+	require.NoError(t, err)
+	defer d.Close()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key%06d", i)) }
+	txn := d.Writer()
+	for i := 0; i < 10000; i++ {
+		require.NoError(t, txn.Set(key(i), []byte("value")))
+	}
+	require.NoError(t, txn.Delete(key(0)))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, d.SaveVersion(1))
+
+	view, err := d.ReaderAt(1)
+	require.NoError(t, err)
+	defer view.Discard()
+	has, err := view.Has(key(0))
+	require.NoError(t, err)
+	require.False(t, has)
+	val, err := view.Get(key(9999))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), val)
+}
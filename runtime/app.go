@@ -156,6 +156,11 @@ func (a *App) Configurator() module.Configurator {
 	return a.configurator
 }
 
+// GetStoreKeys returns the StoreKeys registered by the modules built by the App.
+func (a *App) GetStoreKeys() []storetypes.StoreKey {
+	return a.storeKeys
+}
+
 // UnsafeFindStoreKey FindStoreKey fetches a registered StoreKey from the App in linear time.
 //
 // NOTE: This should only be used in testing.
@@ -9,6 +9,7 @@ import (
 
 	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -35,6 +36,15 @@ const (
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
 )
 
+const (
+	// StoreBackendIAVL keeps the application state in IAVL trees, in the application.db database.
+	StoreBackendIAVL = "iavl"
+
+	// StoreBackendSMT keeps the application state in the store/v2alpha1 multistore, which commits
+	// to it with sparse Merkle trees, in the application.smt.db badger database.
+	StoreBackendSMT = "smt"
+)
+
 // BaseConfig defines the server's basic configuration
 type BaseConfig struct {
 	// The minimum gas prices a validator is willing to accept for processing a
@@ -190,6 +200,23 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+
+	// SnapshotChunkSize sets the size in bytes of the uncompressed chunks of
+	// the snapshots taken in the zstd format.
+	SnapshotChunkSize uint64 `mapstructure:"snapshot-chunk-size"`
+}
+
+// StoreConfig defines the application multistore configuration.
+type StoreConfig struct {
+	// Backend sets the multistore of the application state: "iavl" or "smt".
+	Backend string `mapstructure:"backend"`
+
+	// Streamers lists the names of the streaming services which stream the ABCI messages and the
+	// state changes of the blocks, each configured in its own [streamers.<name>] section.
+	Streamers []string `mapstructure:"streamers"`
 }
 
 // Config defines the server's top level configuration
@@ -203,6 +230,7 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -282,6 +310,12 @@ func DefaultConfig() *Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
+			SnapshotFormat:     snapshottypes.CurrentFormat,
+			SnapshotChunkSize:  snapshottypes.DefaultChunkSize,
+		},
+		Store: StoreConfig{
+			Backend:   StoreBackendIAVL,
+			Streamers: []string{},
 		},
 	}
 }
@@ -355,6 +389,12 @@ func GetConfig(v *viper.Viper) Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotFormat:     v.GetUint32("state-sync.snapshot-format"),
+			SnapshotChunkSize:  v.GetUint64("state-sync.snapshot-chunk-size"),
+		},
+		Store: StoreConfig{
+			Backend:   v.GetString("store.backend"),
+			Streamers: v.GetStringSlice("store.streamers"),
 		},
 	}
 }
@@ -369,6 +409,24 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.StateSync.SnapshotFormat != 0 && !snapshottypes.IsFormatSupported(c.StateSync.SnapshotFormat) {
+		return sdkerrors.ErrAppConfig.Wrapf("unknown state sync snapshot format %d", c.StateSync.SnapshotFormat)
+	}
+	if c.StateSync.SnapshotChunkSize > snapshottypes.MaxChunkSize {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"state sync snapshot chunk size %d cannot exceed %d", c.StateSync.SnapshotChunkSize, snapshottypes.MaxChunkSize,
+		)
+	}
+	if c.StateSync.SnapshotChunkSize != 0 && c.StateSync.SnapshotChunkSize < snapshottypes.MinChunkSize {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"state sync snapshot chunk size %d cannot be below %d", c.StateSync.SnapshotChunkSize, snapshottypes.MinChunkSize,
+		)
+	}
+	switch c.Store.Backend {
+	case "", StoreBackendIAVL, StoreBackendSMT:
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unknown store backend %s", c.Store.Backend)
+	}
 
 	return nil
 }
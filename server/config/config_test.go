@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -128,3 +129,40 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestStoreBackend(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1)})
+	require.Equal(t, StoreBackendIAVL, cfg.Store.Backend)
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Store.Backend = StoreBackendSMT
+	require.NoError(t, cfg.ValidateBasic())
+	var buffer bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+	require.Contains(t, buffer.String(), "[store]\n")
+	require.Contains(t, buffer.String(), `backend = "smt"`)
+
+	cfg.Store.Backend = "rocksdb"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestSnapshotChunkSize(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1)})
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the default chunk size
+	cfg.StateSync.SnapshotChunkSize = 0
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotChunkSize = snapshottypes.MinChunkSize
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.StateSync.SnapshotChunkSize = snapshottypes.MinChunkSize - 1
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotChunkSize = snapshottypes.MaxChunkSize
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.StateSync.SnapshotChunkSize = snapshottypes.MaxChunkSize + 1
+	require.Error(t, cfg.ValidateBasic())
+}
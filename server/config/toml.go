@@ -232,6 +232,40 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-format specifies the format of the snapshots taken: 2 compresses the snapshot with
+# zlib as a single stream, 3 compresses each chunk with zstd on its own, in parallel.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
+# snapshot-chunk-size specifies the size in bytes of the uncompressed chunks of the snapshots
+# taken in format 3, between 1 MB and 15 MB. Peers only share the snapshots taken with the same
+# chunk size.
+snapshot-chunk-size = {{ .StateSync.SnapshotChunkSize }}
+
+###############################################################################
+###                           Store Configuration                           ###
+###############################################################################
+
+[store]
+
+# backend specifies the multistore of the application state: "iavl" keeps it in IAVL trees in the
+# data/application.db database, "smt" keeps it in the ADR-040 multistore, which separates the
+# state storage from the state commitments made with sparse Merkle trees, in the
+# data/application.smt.db badger database. The app hashes differ, so all the validators of a chain
+# must use the same backend. An existing state is moved to "smt" with the migrate-store command.
+backend = "{{ .Store.Backend }}"
+
+# streamers lists the streaming services which stream the ABCI messages and the state changes of
+# each block: "file", "grpc", "stdout" or the name of a plugin registered by the application. Each
+# service is configured in a [streamers.<name>] section, e.g.
+#
+# [streamers.grpc]
+# keys = ["*"]                    # the store keys to stream, "*" for all
+# address = "localhost:9091"      # the StreamingListener gRPC service to push the blocks to
+# buffer_size = 100               # the blocks buffered while the listener is busy
+# halt_on_error = true            # halt the node instead of missing a block
+# decode = true                   # annotate the state changes with their decoded values
+streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagFormat  = "format"
+	flagModules = "modules"
+	flagRaw     = "raw"
+
+	// ExportFormatNDJSON writes one JSON encoded record per line.
+	ExportFormatNDJSON = "ndjson"
+	// ExportFormatProtobuf writes varint length-delimited protobuf encoded records.
+	ExportFormatProtobuf = "protobuf"
+
+	// maxRecordSize is the maximum size of a protobuf encoded record read.
+	maxRecordSize = 64e6
+)
+
+// ExportStateCmd streams the state of the application modules at a height as records.
+func ExportStateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state",
+		Short: "Stream the state of the application modules at a height as records",
+		Long: `
+Stream the state of the modules at a height, one StoreKVPair record per key of their
+stores, as newline-delimited JSON or varint length-delimited protobuf. The stores are
+exported one key at a time, so memory use does not grow with the size of the state.
+
+Each record holds the key and the value as stored by its module, along with the type
+URL and the JSON encoding of the decoded value, in value_type_url and value_json, for
+the keys which the KV decoders registered by the modules of the application decode.
+The values which have no decoder, or fail to decode, are left raw; the failures are
+logged. With --raw, the values are not decoded and the application is not created.
+
+The height must not have been pruned, and defaults to the latest height. The modules
+can be filtered by store name with --modules.
+`,
+		Example: fmt.Sprintf("$ %s export-state --height 1000 --modules bank,staking --format ndjson --output-document state.ndjson", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			if err := requireIAVLStore(ctx.Viper); err != nil {
+				return err
+			}
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			modules, _ := cmd.Flags().GetStringSlice(flagModules)
+			raw, _ := cmd.Flags().GetBool(flagRaw)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			// the writer is reset to the output once the state is loaded
+			bufWriter := bufio.NewWriter(nil)
+			writeRecord, err := newRecordWriter(format, bufWriter)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if !raw {
+				// the application is created for the KV decoders of its modules only
+				app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+				decodingApp, ok := app.(interface {
+					KVDecoders() *storetypes.KVDecoderRegistry
+				})
+				if !ok || decodingApp.KVDecoders() == nil {
+					return fmt.Errorf("the application does not provide KV decoders, use --raw to export the raw state")
+				}
+				writeRecord = newDecodingRecordWriter(decodingApp.KVDecoders(), ctx.Logger, writeRecord)
+			}
+
+			// the stores are mounted from the commit info of the height, since stores may have
+			// been added or deleted since then
+			cms := rootmulti.NewStore(db, ctx.Logger)
+			if height == -1 {
+				err = cms.LoadLatestCommittedStores()
+				height = cms.LastCommitID().Version
+			} else {
+				err = cms.LoadCommittedStores(height)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load the application state: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			if outputDocument != "" {
+				file, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			bufWriter.Reset(out)
+			if err := cms.ExportKVPairs(height, modules, writeRecord); err != nil {
+				return fmt.Errorf("failed to export the state at height %d: %w", height, err)
+			}
+			return bufWriter.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flagFormat, ExportFormatNDJSON, fmt.Sprintf("Record format (%s|%s)", ExportFormatNDJSON, ExportFormatProtobuf))
+	cmd.Flags().StringSlice(flagModules, []string{}, "Comma-separated list of the module stores to export, all of them by default")
+	cmd.Flags().Bool(flagRaw, false, "Export the raw key-value pairs, without decoding the values")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the records to the given file instead of STDOUT")
+	return cmd
+}
+
+// newRecordWriter returns a function writing key-value pair records to w in the given format.
+func newRecordWriter(format string, w io.Writer) (func(pair *storetypes.StoreKVPair) error, error) {
+	switch format {
+	case ExportFormatNDJSON:
+		return func(pair *storetypes.StoreKVPair) error {
+			bz, err := codec.ProtoMarshalJSON(pair, nil)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(append(bz, '\n'))
+			return err
+		}, nil
+
+	case ExportFormatProtobuf:
+		protoWriter := protoio.NewDelimitedWriter(w)
+		return func(pair *storetypes.StoreKVPair) error {
+			return protoWriter.WriteMsg(pair)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown record format %q, expected %s or %s", format, ExportFormatNDJSON, ExportFormatProtobuf)
+	}
+}
+
+// newDecodingRecordWriter returns a function annotating the key-value pair records with
+// their values decoded by the decoders before writing them with writeRecord. The values
+// which fail to decode are logged and written raw.
+func newDecodingRecordWriter(
+	decoders *storetypes.KVDecoderRegistry, logger log.Logger, writeRecord func(pair *storetypes.StoreKVPair) error,
+) func(pair *storetypes.StoreKVPair) error {
+	return func(pair *storetypes.StoreKVPair) error {
+		if err := decoders.Annotate(pair); err != nil {
+			logger.Error("failed to decode a state record", "err", err)
+		}
+		return writeRecord(pair)
+	}
+}
+
+// newRecordReader returns a function reading the key-value pair records written by a
+// record writer of the given format from r, which returns io.EOF after the last one.
+func newRecordReader(format string, r io.Reader) (func() (*storetypes.StoreKVPair, error), error) {
+	switch format {
+	case ExportFormatNDJSON:
+		bufReader := bufio.NewReader(r)
+		return func() (*storetypes.StoreKVPair, error) {
+			line, err := bufReader.ReadBytes('\n')
+			if err == io.EOF && len(line) > 0 {
+				err = nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			pair := &storetypes.StoreKVPair{}
+			if err := jsonpb.Unmarshal(bytes.NewReader(line), pair); err != nil {
+				return nil, fmt.Errorf("invalid record: %w", err)
+			}
+			return pair, nil
+		}, nil
+
+	case ExportFormatProtobuf:
+		protoReader := protoio.NewDelimitedReader(r, maxRecordSize)
+		return func() (*storetypes.StoreKVPair, error) {
+			pair := &storetypes.StoreKVPair{}
+			if err := protoReader.ReadMsg(pair); err != nil {
+				return nil, err
+			}
+			return pair, nil
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown record format %q, expected %s or %s", format, ExportFormatNDJSON, ExportFormatProtobuf)
+	}
+}
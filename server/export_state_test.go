@@ -0,0 +1,58 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestDecodingRecordWriter(t *testing.T) {
+	decoders := storetypes.NewKVDecoderRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	decoders.Register("bank", []byte{1}, func(key, value []byte) (proto.Message, error) {
+		if string(value) == "invalid" {
+			return nil, errors.New("invalid value")
+		}
+		return &gogotypes.StringValue{Value: string(value)}, nil
+	})
+
+	for _, format := range []string{ExportFormatNDJSON, ExportFormatProtobuf} {
+		buf := &bytes.Buffer{}
+		writeRecord, err := newRecordWriter(format, buf)
+		require.NoError(t, err)
+		writeRecord = newDecodingRecordWriter(decoders, log.NewNopLogger(), writeRecord)
+		for _, pair := range []storetypes.StoreKVPair{
+			{StoreKey: "bank", Key: []byte{1}, Value: []byte("balance")},
+			{StoreKey: "bank", Key: []byte{1, 2}, Value: []byte("invalid")},
+			{StoreKey: "bank", Key: []byte{2}, Value: []byte("no decoder")},
+		} {
+			pair := pair
+			require.NoError(t, writeRecord(&pair))
+		}
+
+		readRecord, err := newRecordReader(format, buf)
+		require.NoError(t, err)
+		var pairs []*storetypes.StoreKVPair
+		for pair, err := readRecord(); err == nil; pair, err = readRecord() {
+			pairs = append(pairs, pair)
+		}
+		require.Len(t, pairs, 3, format)
+		require.Equal(t, []byte("balance"), pairs[0].Value)
+		require.Equal(t, "/google.protobuf.StringValue", pairs[0].ValueTypeUrl)
+		require.Equal(t, `"balance"`, pairs[0].ValueJson)
+		// the values which fail to decode, or have no decoder, are exported raw
+		for _, pair := range pairs[1:] {
+			require.NotEmpty(t, pair.Value)
+			require.Empty(t, pair.ValueTypeUrl)
+			require.Empty(t, pair.ValueJson)
+		}
+	}
+}
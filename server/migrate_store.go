@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// MigrateStoreCmd creates a command to migrate the application state from the
+// IAVL stores to the store/v2alpha1 multistore offline.
+func MigrateStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the application state from the IAVL stores to the SMT store",
+		Long: fmt.Sprintf(`
+Migrate the latest application state of the IAVL stores of the application.db database to
+the ADR-040 multistore of the %[1]s store backend, in the application.smt.db database. The
+node must be stopped. The application.db database is left untouched.
+
+The app hash of the migrated state differs from the one of the IAVL stores, so Tendermint
+state is rolled back by one height: upon restarting with the %[2]s = "%[1]s" option of
+app.toml, Tendermint replays the latest block and adopts the new app hash. All the validators
+must therefore migrate at the same height, e.g. at an upgrade halt height.
+`, config.StoreBackendSMT, FlagStoreBackend),
+		Example: fmt.Sprintf("$ %s migrate-store && %s start --%s %s", version.AppName, version.AppName, FlagStoreBackend, config.StoreBackendSMT),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			home := ctx.Config.RootDir
+
+			dbDir := multiStoreV2DBDir(home)
+			if _, err := os.Stat(dbDir); err == nil {
+				return fmt.Errorf("%s already exists, remove it to migrate the application state again", dbDir)
+			} else if !os.IsNotExist(err) {
+				return err
+			}
+
+			height, appHash, err := migrateAppDB(ctx, dbDir)
+			if err != nil {
+				return err
+			}
+
+			// rollback tendermint state so that it replays the latest block
+			tmHeight, tmHash, err := tmcmd.RollbackState(ctx.Config)
+			if err != nil {
+				if removeErr := os.RemoveAll(dbDir); removeErr != nil {
+					ctx.Logger.Error("failed to remove the migrated application state", "dir", dbDir, "err", removeErr)
+				}
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+
+			cmd.Printf("Migrated application state at height %d to %s, with app hash %X\n", height, dbDir, appHash)
+			cmd.Printf("Rolled back tendermint state to height %d and hash %X\n", tmHeight, tmHash)
+			cmd.Printf("Set %s = \"%s\" in app.toml before restarting the node\n", FlagStoreBackend, config.StoreBackendSMT)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// migrateAppDB migrates the latest state of the application database to a new
+// store/v2alpha1 multistore database in dbDir, returning its height and app hash.
+func migrateAppDB(ctx *Context, dbDir string) (int64, []byte, error) {
+	db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer db.Close()
+
+	cms := rootmulti.NewStore(db, ctx.Logger)
+	if err := cms.LoadLatestCommittedStores(); err != nil {
+		return 0, nil, fmt.Errorf("failed to load the application state: %w", err)
+	}
+
+	store2db, err := badgerdb.NewDB(dbDir)
+	if err != nil {
+		return 0, nil, err
+	}
+	var commitID storetypes.CommitID
+	store, err := multi.MigrateFromV1(cms, store2db, multi.DefaultStoreConfig())
+	if err == nil {
+		commitID = store.LastCommitID()
+		err = store.Close()
+	}
+	if closeErr := store2db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if removeErr := os.RemoveAll(dbDir); removeErr != nil {
+			ctx.Logger.Error("failed to remove the migrated application state", "dir", dbDir, "err", removeErr)
+		}
+		return 0, nil, fmt.Errorf("failed to migrate the application state: %w", err)
+	}
+	return commitID.Version, commitID.Hash, nil
+}
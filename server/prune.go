@@ -0,0 +1,162 @@
+package server
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagCompact = "compact"
+
+// NewPruneCmd creates a command to prune the application state offline.
+func NewPruneCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the application state offline",
+		Long: `
+Prune the versions of all the IAVL stores of the application database which the pruning
+options do not keep, i.e. all but the latest pruning-keep-recent versions. The pruning
+options are read from app.toml, unless given by flags. The node must be stopped.
+
+Since the deleted versions only free disk space once the database is compacted, the
+database can be compacted after pruning with --compact (goleveldb only).
+`,
+		Example: fmt.Sprintf("$ %s prune --pruning custom --pruning-keep-recent 100 --pruning-interval 10 --compact", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			if err := requireIAVLStore(ctx.Viper); err != nil {
+				return err
+			}
+			pruningOpts, err := GetPruningOptionsFromFlags(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			if pruningOpts.GetPruningStrategy() == pruningtypes.PruningNothing {
+				return fmt.Errorf("the %s pruning strategy keeps all the versions", pruningtypes.PruningOptionNothing)
+			}
+
+			dbDir := filepath.Join(ctx.Config.RootDir, "data", "application.db")
+			sizeBefore, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			compact, _ := cmd.Flags().GetBool(flagCompact)
+			err = pruneAppDB(cmd, db, pruningOpts, compact)
+			if closeErr := db.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+
+			sizeAfter, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+
+			reclaimed := int64(0)
+			if sizeAfter < sizeBefore {
+				reclaimed = sizeBefore - sizeAfter
+			}
+			cmd.Printf("Application database size went from %s to %s, %s reclaimed\n",
+				formatBytes(sizeBefore), formatBytes(sizeAfter), formatBytes(reclaimed))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(flagCompact, false, "Compact the database after pruning")
+	return cmd
+}
+
+// pruneAppDB prunes the versions of the IAVL stores of the application database which the
+// pruning options do not keep, and compacts the database if requested.
+func pruneAppDB(cmd *cobra.Command, db dbm.DB, pruningOpts pruningtypes.PruningOptions, compact bool) error {
+	cms := rootmulti.NewStore(db, GetServerContextFromCmd(cmd).Logger)
+	if err := cms.LoadLatestCommittedStores(); err != nil {
+		return fmt.Errorf("failed to load the application state: %w", err)
+	}
+
+	latest := cms.LastCommitID().Version
+	pruneBelow := latest - int64(pruningOpts.KeepRecent)
+	if pruneBelow <= 1 {
+		cmd.Printf("Nothing to prune at height %d, keeping the latest %d versions\n", latest, pruningOpts.KeepRecent)
+		return nil
+	}
+
+	cmd.Printf("Pruning the versions below %d at height %d...\n", pruneBelow, latest)
+	err := cms.PruneVersionsBelow(pruneBelow, func(storeName string, pruned, total int) {
+		cmd.Printf("%s: pruned %d/%d versions\n", storeName, pruned, total)
+	})
+	if err != nil {
+		return err
+	}
+
+	if compact {
+		cmd.Println("Compacting the application database...")
+		return compactDB(db)
+	}
+	return nil
+}
+
+// compactDB compacts the whole database, if its backend supports it.
+func compactDB(db dbm.DB) error {
+	switch db := db.(type) {
+	case *dbm.GoLevelDB:
+		return db.DB().CompactRange(util.Range{})
+	default:
+		return fmt.Errorf("compaction is not supported by the %T database backend", db)
+	}
+}
+
+// dirSize returns the total size of the files of a directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// formatBytes formats a number of bytes with a binary unit.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -1,49 +1,157 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/orderedcode"
 	"github.com/spf13/cobra"
 	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const (
+	flagTo     = "to"
+	flagBlocks = "blocks"
+
+	// blockMetaPrefix is the key prefix of the block metas in the Tendermint
+	// block store.
+	blockMetaPrefix = int64(0)
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
+// NewRollbackCmd creates a command to rollback tendermint and multistore state.
 func NewRollbackCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
-progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting Tendermint the transactions in block n will be re-executed against the
-application.
+progress. Rollback overwrites a state at height n with the state at height n - 1, or
+the application state at the height given by --to or --blocks.
+
+Before the rollback, the application state at the target height is checked to exist in
+every store and to match the app hash of the next block in the block store. Tendermint
+state is rolled back by one height. No blocks are removed, so upon restarting Tendermint
+the transactions of the blocks after the target height are re-executed against the
+application, checking the app hash of each of them.
 `,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
+			if err := requireIAVLStore(ctx.Viper); err != nil {
+				return err
+			}
 			cfg := ctx.Config
 			home := cfg.RootDir
 			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
+			defer db.Close()
+
+			cms := rootmulti.NewStore(db, ctx.Logger)
+			if err := cms.LoadLatestCommittedStores(); err != nil {
+				return fmt.Errorf("failed to load the application state: %w", err)
+			}
+			latest := cms.LastCommitID().Version
+
+			to, _ := cmd.Flags().GetInt64(flagTo)
+			blocks, _ := cmd.Flags().GetInt64(flagBlocks)
+			target, err := rollbackTarget(latest, to, blocks)
+			if err != nil {
+				return err
+			}
+
+			// verify the app hash of the target height against the block store
+			cInfo, err := cms.GetCommitInfo(target)
+			if err != nil {
+				return fmt.Errorf("failed to load the application state at height %d: %w", target, err)
+			}
+			blockMeta, err := loadBlockMeta(cfg, target+1)
+			if err != nil {
+				return err
+			}
+			if appHash := cInfo.Hash(); !bytes.Equal(appHash, blockMeta.Header.AppHash) {
+				return fmt.Errorf("app hash %X at height %d does not match the app hash %X of block %d",
+					appHash, target, blockMeta.Header.AppHash, target+1)
+			}
+
+			// rollback the multistore
+			if err := cms.RollbackToVersion(target); err != nil {
+				return fmt.Errorf("failed to rollback the application state: %w", err)
+			}
 			// rollback tendermint state
-			height, hash, err := tmcmd.RollbackState(ctx.Config)
+			height, hash, err := tmcmd.RollbackState(cfg)
 			if err != nil {
 				return fmt.Errorf("failed to rollback tendermint state: %w", err)
 			}
-			// rollback the multistore
-			cms := rootmulti.NewStore(db, ctx.Logger)
-			cms.RollbackToVersion(height)
 
-			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
+			cmd.Printf("Rolled back application state to height %d and hash %X\n", target, cInfo.Hash())
+			cmd.Printf("Rolled back tendermint state to height %d and hash %X\n", height, hash)
+			if height > target {
+				cmd.Printf("Blocks %d to %d will be replayed when the node restarts\n", target+1, height)
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagTo, 0, "The height to rollback the application state to")
+	cmd.Flags().Int64(flagBlocks, 0, "The number of heights to rollback the application state by")
 	return cmd
 }
+
+// rollbackTarget returns the height to rollback the application state to from
+// its latest height and the --to or --blocks flags, by one height by default.
+func rollbackTarget(latest, to, blocks int64) (int64, error) {
+	switch {
+	case to != 0 && blocks != 0:
+		return 0, fmt.Errorf("only one of --%s and --%s can be given", flagTo, flagBlocks)
+	case to < 0 || blocks < 0:
+		return 0, fmt.Errorf("--%s and --%s must be positive", flagTo, flagBlocks)
+	case blocks != 0:
+		to = latest - blocks
+	case to == 0:
+		to = latest - 1
+	}
+
+	if to <= 0 || to >= latest {
+		return 0, fmt.Errorf("rollback height %d must be between 1 and %d", to, latest-1)
+	}
+	return to, nil
+}
+
+// loadBlockMeta loads the meta of the block at the given height from the
+// Tendermint block store.
+func loadBlockMeta(cfg *tmcfg.Config, height int64) (*tmproto.BlockMeta, error) {
+	db, err := tmcfg.DefaultDBProvider(&tmcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the block store: %w", err)
+	}
+	defer db.Close()
+
+	// the key encoding of the block store of tendermint's internal/store package
+	key, err := orderedcode.Append(nil, blockMetaPrefix, height)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("block %d not found in the block store", height)
+	}
+
+	blockMeta := &tmproto.BlockMeta{}
+	if err := proto.Unmarshal(bz, blockMeta); err != nil {
+		return nil, fmt.Errorf("failed to decode the meta of block %d: %w", height, err)
+	}
+	return blockMeta, nil
+}
@@ -0,0 +1,460 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const (
+	flagOutput = "output"
+
+	// snapshotMetadataEntry is the name of the snapshot metadata entry of a
+	// snapshot archive, followed by the chunks named by their index.
+	snapshotMetadataEntry = "metadata"
+)
+
+// SnapshotsCmd returns the snapshots command, which manages the snapshots of
+// the local snapshot store offline.
+func SnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state snapshots",
+		Long: `Manage the state snapshots of the local snapshot store, in data/snapshots.
+
+Snapshots are portable: a snapshot can be taken from the local state, dumped
+to an archive, loaded into the snapshot store of another node and restored
+there, without using state sync. The node must be stopped.
+`,
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(defaultNodeHome),
+		ExportSnapshotCmd(appCreator, defaultNodeHome),
+		DumpSnapshotCmd(defaultNodeHome),
+		LoadSnapshotCmd(defaultNodeHome),
+		RestoreSnapshotCmd(appCreator, defaultNodeHome),
+		DeleteSnapshotCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ListSnapshotsCmd returns a command to list the local snapshots.
+func ListSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// ExportSnapshotCmd returns a command to take a snapshot of the local state at
+// its latest height.
+func ExportSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the local state at its latest height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, manager, err := newSnapshotApp(GetServerContextFromCmd(cmd), appCreator)
+			if err != nil {
+				return err
+			}
+
+			height := app.Info(abci.RequestInfo{}).LastBlockHeight
+			if height == 0 {
+				return fmt.Errorf("the application has no state to snapshot")
+			}
+
+			cmd.Printf("Taking a snapshot at height %d...\n", height)
+			snapshot, err := manager.Create(uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// DumpSnapshotCmd returns a command to dump a local snapshot to a portable
+// archive.
+func DumpSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [height] [format]",
+		Short: "Dump a local snapshot to a portable tar.gz archive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			snapshotStore, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := dumpSnapshotArchive(snapshotStore, height, format, file); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d dumped to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(flagOutput, "o", "", "The archive file, <height>-<format>.tar.gz by default")
+	return cmd
+}
+
+// LoadSnapshotCmd returns a command to load a snapshot archive into the local
+// snapshot store.
+func LoadSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load [archive-file]",
+		Short: "Load a snapshot archive into the local snapshot store",
+		Long: `Load a snapshot archive, dumped by the dump command, into the local snapshot
+store. The chunks are verified against the snapshot hash.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := loadSnapshotArchive(snapshotStore, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d loaded\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// RestoreSnapshotCmd returns a command to restore the application state from
+// a local snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot, e.g. loaded from an
+archive. The application state must be empty, i.e. the node home must be new.
+
+Only the application state is restored: Tendermint must still be bootstrapped
+at the snapshot height before the node is started.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			app, manager, err := newSnapshotApp(GetServerContextFromCmd(cmd), appCreator)
+			if err != nil {
+				return err
+			}
+			if latest := app.Info(abci.RequestInfo{}).LastBlockHeight; latest != 0 {
+				return fmt.Errorf("the application state is not empty, its latest height is %d", latest)
+			}
+
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			cmd.Printf("Application state restored at height %d\n", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// DeleteSnapshotCmd returns a command to delete a local snapshot.
+func DeleteSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			snapshotStore, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := snapshotStore.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d, format %d not found", height, format)
+			}
+
+			return snapshotStore.Delete(height, format)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// snapshotAppOptions are the options of an application created by the
+// snapshots commands, which force its snapshot interval on.
+type snapshotAppOptions struct {
+	types.AppOptions
+}
+
+// Get implements AppOptions.
+func (o snapshotAppOptions) Get(key string) interface{} {
+	if key == FlagStateSyncSnapshotInterval && cast.ToUint64(o.AppOptions.Get(key)) == snapshottypes.SnapshotIntervalOff {
+		// any interval sets up the snapshot manager: no block is committed by
+		// the commands, so no snapshot is taken at commit
+		return uint64(1)
+	}
+	return o.AppOptions.Get(key)
+}
+
+// newSnapshotApp creates the application on the local state with its snapshot
+// manager. An application doesn't set up its snapshot manager if its snapshot
+// interval is off, so the interval is forced on for the commands: the local
+// snapshots can be managed whether the node takes snapshots or not.
+func newSnapshotApp(ctx *Context, appCreator types.AppCreator) (types.Application, *snapshots.Manager, error) {
+	db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := appCreator(ctx.Logger, db, nil, snapshotAppOptions{ctx.Viper})
+	snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager })
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil, nil, fmt.Errorf("snapshots are not configured for the application")
+	}
+
+	return app, snapshotApp.SnapshotManager(), nil
+}
+
+// parseSnapshotArgs parses the height and format arguments of a snapshot.
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+
+	return height, uint32(format), nil
+}
+
+// dumpSnapshotArchive writes the given snapshot of the store as a tar.gz
+// archive, made of the snapshot metadata followed by its chunks.
+func dumpSnapshotArchive(store *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, err := store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d, format %d not found", height, format)
+	}
+
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := writeArchiveEntry(tw, snapshotMetadataEntry, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d of snapshot at height %d, format %d not found", i, height, format)
+		}
+
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+
+		if err := writeArchiveEntry(tw, strconv.FormatUint(uint64(i), 10), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, bz []byte) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(bz))}); err != nil {
+		return err
+	}
+	_, err := tw.Write(bz)
+	return err
+}
+
+// loadSnapshotArchive saves the snapshot of the given archive into the store.
+// The snapshot is deleted if its chunks don't match its metadata.
+func loadSnapshotArchive(store *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	if hdr.Name != snapshotMetadataEntry {
+		return nil, fmt.Errorf("invalid snapshot archive: unexpected entry %q, expected %q", hdr.Name, snapshotMetadataEntry)
+	}
+
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	var expected snapshottypes.Snapshot
+	if err := proto.Unmarshal(bz, &expected); err != nil {
+		return nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+	if expected.Chunks == 0 || uint32(len(expected.Metadata.ChunkHashes)) != expected.Chunks {
+		return nil, fmt.Errorf("invalid snapshot metadata: %d chunks and %d chunk hashes", expected.Chunks, len(expected.Metadata.ChunkHashes))
+	}
+
+	// the chunks are streamed from the archive to the store, one at a time
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+
+			hdr, err := tr.Next()
+			if err != nil {
+				_ = pw.CloseWithError(fmt.Errorf("invalid snapshot archive: %w", err))
+				return
+			}
+			if name := strconv.FormatUint(uint64(i), 10); hdr.Name != name {
+				_ = pw.CloseWithError(fmt.Errorf("invalid snapshot archive: unexpected entry %q, expected %q", hdr.Name, name))
+				return
+			}
+			if _, err := io.Copy(pw, tr); err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	snapshot, err := store.Save(expected.Height, expected.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(snapshot.Hash, expected.Hash) || !equalChunkHashes(snapshot.Metadata.ChunkHashes, expected.Metadata.ChunkHashes) {
+		if err := store.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("snapshot hash mismatch: expected %X, got %X", expected.Hash, snapshot.Hash)
+	}
+
+	return snapshot, nil
+}
+
+func equalChunkHashes(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
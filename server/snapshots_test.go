@@ -0,0 +1,99 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+func newSnapshotStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func makeSnapshotChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	source := newSnapshotStore(t)
+	expected, err := source.Save(3, 2, makeSnapshotChunks([]byte{3, 2, 0}, []byte{3, 2, 1}, []byte{3, 2, 2}))
+	require.NoError(t, err)
+
+	// a missing snapshot can't be dumped
+	var archive bytes.Buffer
+	require.Error(t, dumpSnapshotArchive(source, 4, 2, &archive))
+
+	require.NoError(t, dumpSnapshotArchive(source, 3, 2, &archive))
+
+	target := newSnapshotStore(t)
+	snapshot, err := loadSnapshotArchive(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+
+	loaded, err := target.Get(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, loaded)
+	chunk, err := target.LoadChunk(3, 2, 2)
+	require.NoError(t, err)
+	bz, err := io.ReadAll(chunk)
+	require.NoError(t, err)
+	require.NoError(t, chunk.Close())
+	require.Equal(t, []byte{3, 2, 2}, bz)
+
+	// the snapshot already exists
+	_, err = loadSnapshotArchive(target, bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+
+	// a snapshot whose chunks don't match its hash is not kept
+	bz, err = proto.Marshal(expected)
+	require.NoError(t, err)
+	archive.Reset()
+	gzw := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gzw)
+	require.NoError(t, writeArchiveEntry(tw, snapshotMetadataEntry, bz))
+	for i, chunk := range [][]byte{{3, 2, 0}, {9, 9, 9}, {3, 2, 2}} {
+		require.NoError(t, writeArchiveEntry(tw, strconv.Itoa(i), chunk))
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	tampered := newSnapshotStore(t)
+	_, err = loadSnapshotArchive(tampered, bytes.NewReader(archive.Bytes()))
+	require.ErrorContains(t, err, "snapshot hash mismatch")
+	snapshot, err = tampered.Get(3, 2)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func TestSnapshotAppOptions(t *testing.T) {
+	v := viper.New()
+	opts := snapshotAppOptions{v}
+
+	// the snapshot interval is forced on
+	require.Equal(t, uint64(1), opts.Get(FlagStateSyncSnapshotInterval))
+	v.Set(FlagStateSyncSnapshotInterval, 0)
+	require.Equal(t, uint64(1), opts.Get(FlagStateSyncSnapshotInterval))
+
+	// the other options are unchanged
+	v.Set(FlagStateSyncSnapshotInterval, 100)
+	v.Set(FlagStateSyncSnapshotKeepRecent, 2)
+	require.Equal(t, 100, opts.Get(FlagStateSyncSnapshotInterval))
+	require.Equal(t, 2, opts.Get(FlagStateSyncSnapshotKeepRecent))
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -59,6 +60,11 @@ const (
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat     = "state-sync.snapshot-format"
+	FlagStateSyncSnapshotChunkSize  = "state-sync.snapshot-chunk-size"
+
+	// store-related flags
+	FlagStoreBackend = "store.backend"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -184,6 +190,10 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, snapshottypes.CurrentFormat, "State sync snapshot format (2 for zlib, 3 for parallel zstd)")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotChunkSize, snapshottypes.DefaultChunkSize, "State sync snapshot uncompressed chunk size in bytes, for the zstd format")
+
+	cmd.Flags().String(FlagStoreBackend, serverconfig.StoreBackendIAVL, "Multistore of the application state (iavl|smt)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
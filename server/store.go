@@ -0,0 +1,607 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagEncoding = "encoding"
+	flagLimit    = "limit"
+
+	encodingHex    = "hex"
+	encodingBase64 = "base64"
+)
+
+// StoreCmd returns the commands to inspect the raw contents of the application stores.
+func StoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect the raw contents of the application stores at a height",
+		Long: `
+Inspect the raw key-value pairs of the application stores at any height which has not been
+pruned, e.g. to investigate an app hash mismatch. The application database is only read, but
+the node must be stopped. Keys and prefixes are given in hex.
+`,
+	}
+
+	cmd.AddCommand(
+		listStoresCmd(),
+		iterateStoreCmd(),
+		getStoreKeyCmd(),
+		diffStoreCmd(),
+		compareStoresCmd(),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.PersistentFlags().String(flagEncoding, encodingHex, fmt.Sprintf("Encoding of the printed keys and values (%s|%s)", encodingHex, encodingBase64))
+	return cmd
+}
+
+func listStoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the stores committed at a height with their commit hashes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withMultiStore(cmd, func(cms *rootmulti.Store) error {
+				height, err := storeHeight(cmd, cms)
+				if err != nil {
+					return err
+				}
+				cInfo, err := cms.GetCommitInfo(height)
+				if err != nil {
+					return fmt.Errorf("failed to load the commit info at height %d: %w", height, err)
+				}
+
+				cmd.Printf("height: %d\napp hash: %X\n", height, cInfo.Hash())
+				for _, storeInfo := range cInfo.StoreInfos {
+					cmd.Printf("%s\tversion: %d\thash: %X\n", storeInfo.Name, storeInfo.CommitId.Version, storeInfo.CommitId.Hash)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, -1, "The height to inspect (-1 means latest height)")
+	return cmd
+}
+
+func iterateStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "iterate <store> [prefix]",
+		Short:   "Print the key-value pairs of a store at a height, filtered by a key prefix",
+		Example: fmt.Sprintf("$ %s store iterate bank 02 --height 1000 --limit 10", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var prefix []byte
+			if len(args) == 2 {
+				var err error
+				if prefix, err = hex.DecodeString(args[1]); err != nil {
+					return fmt.Errorf("invalid hex prefix: %w", err)
+				}
+			}
+			encode, err := bytesEncoder(cmd)
+			if err != nil {
+				return err
+			}
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+
+			return withMultiStore(cmd, func(cms *rootmulti.Store) error {
+				height, err := storeHeight(cmd, cms)
+				if err != nil {
+					return err
+				}
+				store, err := loadKVStore(cms, args[0], height)
+				if err != nil {
+					return err
+				}
+
+				iter := storetypes.KVStorePrefixIterator(store, prefix)
+				defer iter.Close()
+				for n := 0; iter.Valid() && (limit <= 0 || n < limit); iter.Next() {
+					cmd.Printf("%s %s\n", encode(iter.Key()), encode(iter.Value()))
+					n++
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, -1, "The height to inspect (-1 means latest height)")
+	cmd.Flags().Int(flagLimit, 0, "The maximum number of key-value pairs to print, all of them if 0")
+	return cmd
+}
+
+// storeKeyProof is the output of the store get command.
+type storeKeyProof struct {
+	Height   int64              `json:"height"`
+	AppHash  string             `json:"app_hash"`
+	Key      string             `json:"key"`
+	Value    string             `json:"value,omitempty"`
+	Exists   bool               `json:"exists"`
+	ProofOps *tmcrypto.ProofOps `json:"proof_ops"`
+}
+
+func getStoreKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <store> <key>",
+		Short: "Print the value of a key of a store at a height with its ICS23 proof",
+		Long: `
+Print the value of a key of a store at a height, with the ICS23 proof of its existence, or
+absence, in the store and the proof of the store in the multistore. The proof is verified
+against the app hash of the height before it is printed.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storeName := args[0]
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key: %w", err)
+			}
+			encode, err := bytesEncoder(cmd)
+			if err != nil {
+				return err
+			}
+
+			return withMultiStore(cmd, func(cms *rootmulti.Store) error {
+				height, err := storeHeight(cmd, cms)
+				if err != nil {
+					return err
+				}
+				cInfo, err := cms.GetCommitInfo(height)
+				if err != nil {
+					return fmt.Errorf("failed to load the commit info at height %d: %w", height, err)
+				}
+
+				res := cms.Query(abci.RequestQuery{
+					Path:   fmt.Sprintf("/%s/key", storeName),
+					Data:   key,
+					Height: height,
+					Prove:  true,
+				})
+				if res.Code != 0 {
+					return fmt.Errorf("failed to query key %X of store %s: %s", key, storeName, res.Log)
+				}
+
+				appHash := cInfo.Hash()
+				keyPath := merkle.KeyPath{}.
+					AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+					AppendKey(key, merkle.KeyEncodingURL).
+					String()
+				prt := rootmulti.DefaultProofRuntime()
+				if res.Value != nil {
+					err = prt.VerifyValue(res.ProofOps, appHash, keyPath, res.Value)
+				} else {
+					err = prt.VerifyAbsence(res.ProofOps, appHash, keyPath)
+				}
+				if err != nil {
+					return fmt.Errorf("failed to verify the proof against app hash %X: %w", appHash, err)
+				}
+
+				out := storeKeyProof{
+					Height:   height,
+					AppHash:  fmt.Sprintf("%X", appHash),
+					Key:      encode(key),
+					Exists:   res.Value != nil,
+					ProofOps: res.ProofOps,
+				}
+				if res.Value != nil {
+					out.Value = encode(res.Value)
+				}
+				bz, err := json.MarshalIndent(out, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, -1, "The height to inspect (-1 means latest height)")
+	return cmd
+}
+
+func diffStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <store> <from-height> <to-height> [prefix]",
+		Short: "Print the key-value pairs of a store which differ between two heights",
+		Long: `
+Print the key-value pairs of a store, filtered by a key prefix, which differ between two
+heights: the keys added as "+ <key> <value>", the keys removed as "- <key> <value>" and the
+keys whose value changed as "~ <key> <from-value> <to-value>".
+`,
+		Example: fmt.Sprintf("$ %s store diff staking 999 1000 21", version.AppName),
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storeName := args[0]
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+			var prefix []byte
+			if len(args) == 4 {
+				if prefix, err = hex.DecodeString(args[3]); err != nil {
+					return fmt.Errorf("invalid hex prefix: %w", err)
+				}
+			}
+			encode, err := bytesEncoder(cmd)
+			if err != nil {
+				return err
+			}
+
+			return withMultiStore(cmd, func(cms *rootmulti.Store) error {
+				from, err := loadKVStore(cms, storeName, fromHeight)
+				if err != nil {
+					return err
+				}
+				to, err := loadKVStore(cms, storeName, toHeight)
+				if err != nil {
+					return err
+				}
+
+				diffKVStores(from, to, prefix, func(key, fromValue, toValue []byte) {
+					switch {
+					case fromValue == nil:
+						cmd.Printf("+ %s %s\n", encode(key), encode(toValue))
+					case toValue == nil:
+						cmd.Printf("- %s %s\n", encode(key), encode(fromValue))
+					default:
+						cmd.Printf("~ %s %s %s\n", encode(key), encode(fromValue), encode(toValue))
+					}
+				})
+				return nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+func compareStoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare <a> <b>",
+		Short: "Print the stores and the key-value pairs which differ between two nodes",
+		Long: `
+Compare the application state of two nodes, e.g. which disagree on an app hash, given either
+their data directories or two exports of the export-state command.
+
+Given two data directories, the commit hashes of the stores at a height, the latest height of
+both nodes by default, are compared, then the key-value pairs of the stores whose hash differs.
+Given two export files, all their key-value pairs are compared.
+
+The key-value pairs only in a are printed as "- <store> <key> <value>", only in b as
+"+ <store> <key> <value>" and those whose value differs as "~ <store> <key> <a-value> <b-value>".
+`,
+		Example: fmt.Sprintf("$ %s store compare node0/data node1/data --height 1000", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			encode, err := bytesEncoder(cmd)
+			if err != nil {
+				return err
+			}
+
+			infoA, err := os.Stat(args[0])
+			if err != nil {
+				return err
+			}
+			infoB, err := os.Stat(args[1])
+			if err != nil {
+				return err
+			}
+
+			differs := map[string]bool{}
+			printDiff := func(storeName string, key, valueA, valueB []byte) {
+				differs[storeName] = true
+				switch {
+				case valueA == nil:
+					cmd.Printf("+ %s %s %s\n", storeName, encode(key), encode(valueB))
+				case valueB == nil:
+					cmd.Printf("- %s %s %s\n", storeName, encode(key), encode(valueA))
+				default:
+					cmd.Printf("~ %s %s %s %s\n", storeName, encode(key), encode(valueA), encode(valueB))
+				}
+			}
+
+			switch {
+			case infoA.IsDir() && infoB.IsDir():
+				err = compareDataDirs(cmd, args[0], args[1], printDiff)
+			case !infoA.IsDir() && !infoB.IsDir():
+				format, _ := cmd.Flags().GetString(flagFormat)
+				err = compareExports(format, args[0], args[1], printDiff)
+			default:
+				return errors.New("expected either two data directories or two export files")
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(differs) == 0 {
+				cmd.Println("no difference found")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, -1, "The height to compare the data directories at (-1 means the latest height of both)")
+	cmd.Flags().String(flagFormat, ExportFormatNDJSON, fmt.Sprintf("Record format of the export files (%s|%s)", ExportFormatNDJSON, ExportFormatProtobuf))
+	return cmd
+}
+
+// compareDataDirs compares the commit hashes of the stores of two application databases at a
+// height, prints the stores which differ, and calls fn with their key-value pairs which differ.
+func compareDataDirs(cmd *cobra.Command, dataDirA, dataDirB string, fn func(storeName string, key, valueA, valueB []byte)) error {
+	return withMultiStoreAt(cmd, dataDirA, func(cmsA *rootmulti.Store) error {
+		return withMultiStoreAt(cmd, dataDirB, func(cmsB *rootmulti.Store) error {
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == -1 {
+				height = cmsA.LastCommitID().Version
+				if latestB := cmsB.LastCommitID().Version; latestB < height {
+					height = latestB
+				}
+			}
+			cInfoA, err := cmsA.GetCommitInfo(height)
+			if err != nil {
+				return fmt.Errorf("failed to load the commit info of a at height %d: %w", height, err)
+			}
+			cInfoB, err := cmsB.GetCommitInfo(height)
+			if err != nil {
+				return fmt.Errorf("failed to load the commit info of b at height %d: %w", height, err)
+			}
+
+			cmd.Printf("height: %d\napp hash a: %X\napp hash b: %X\n", height, cInfoA.Hash(), cInfoB.Hash())
+			if bytes.Equal(cInfoA.Hash(), cInfoB.Hash()) {
+				return nil
+			}
+
+			hashesB := map[string][]byte{}
+			for _, storeInfo := range cInfoB.StoreInfos {
+				hashesB[storeInfo.Name] = storeInfo.CommitId.Hash
+			}
+			sort.Slice(cInfoA.StoreInfos, func(i, j int) bool {
+				return cInfoA.StoreInfos[i].Name < cInfoA.StoreInfos[j].Name
+			})
+			var storeNames []string
+			for _, storeInfo := range cInfoA.StoreInfos {
+				hashB, ok := hashesB[storeInfo.Name]
+				delete(hashesB, storeInfo.Name)
+				switch {
+				case !ok:
+					cmd.Printf("store %s only in a\n", storeInfo.Name)
+				case !bytes.Equal(storeInfo.CommitId.Hash, hashB):
+					cmd.Printf("store %s differs: %X != %X\n", storeInfo.Name, storeInfo.CommitId.Hash, hashB)
+					storeNames = append(storeNames, storeInfo.Name)
+				}
+			}
+			onlyInB := make([]string, 0, len(hashesB))
+			for storeName := range hashesB {
+				onlyInB = append(onlyInB, storeName)
+			}
+			sort.Strings(onlyInB)
+			for _, storeName := range onlyInB {
+				cmd.Printf("store %s only in b\n", storeName)
+			}
+
+			for _, storeName := range storeNames {
+				storeA, err := loadKVStore(cmsA, storeName, height)
+				if err != nil {
+					return err
+				}
+				storeB, err := loadKVStore(cmsB, storeName, height)
+				if err != nil {
+					return err
+				}
+				diffKVStores(storeA, storeB, nil, func(key, valueA, valueB []byte) {
+					fn(storeName, key, valueA, valueB)
+				})
+			}
+			return nil
+		})
+	})
+}
+
+// compareExports calls fn with the key-value pairs which differ between two export files.
+func compareExports(format, fileA, fileB string, fn func(storeName string, key, valueA, valueB []byte)) error {
+	a, err := os.Open(fileA)
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+	b, err := os.Open(fileB)
+	if err != nil {
+		return err
+	}
+	defer b.Close()
+
+	readA, err := newRecordReader(format, a)
+	if err != nil {
+		return err
+	}
+	readB, err := newRecordReader(format, b)
+	if err != nil {
+		return err
+	}
+	return diffKVPairs(readA, readB, fn)
+}
+
+// diffKVStores calls fn, in ascending key order, with the keys under the prefix whose value
+// differs between the from and to stores, and their values, nil if the key does not exist.
+func diffKVStores(from, to storetypes.KVStore, prefix []byte, fn func(key, fromValue, toValue []byte)) {
+	fromIter := storetypes.KVStorePrefixIterator(from, prefix)
+	defer fromIter.Close()
+	toIter := storetypes.KVStorePrefixIterator(to, prefix)
+	defer toIter.Close()
+
+	// the iterator readers never fail
+	_ = diffKVPairs(iteratorReader(fromIter), iteratorReader(toIter), func(_ string, key, fromValue, toValue []byte) {
+		fn(key, fromValue, toValue)
+	})
+}
+
+// kvPairReader returns the next key-value pair of a sequence sorted by store name then key,
+// or io.EOF after the last one.
+type kvPairReader func() (*storetypes.StoreKVPair, error)
+
+// iteratorReader returns a reader of the key-value pairs of an iterator.
+func iteratorReader(iter storetypes.Iterator) kvPairReader {
+	return func() (*storetypes.StoreKVPair, error) {
+		if !iter.Valid() {
+			return nil, io.EOF
+		}
+		pair := &storetypes.StoreKVPair{Key: iter.Key(), Value: iter.Value()}
+		iter.Next()
+		return pair, nil
+	}
+}
+
+// diffKVPairs calls fn, in order, with the store names and keys whose value differs between
+// the from and to sequences of key-value pairs, and their values, nil if the key does not exist.
+func diffKVPairs(from, to kvPairReader, fn func(storeName string, key, fromValue, toValue []byte)) error {
+	next := func(read kvPairReader) (*storetypes.StoreKVPair, error) {
+		pair, err := read()
+		if err == io.EOF {
+			return nil, nil
+		}
+		return pair, err
+	}
+
+	fromPair, err := next(from)
+	if err != nil {
+		return err
+	}
+	toPair, err := next(to)
+	if err != nil {
+		return err
+	}
+
+	for fromPair != nil || toPair != nil {
+		cmp := 0
+		switch {
+		case toPair == nil:
+			cmp = -1
+		case fromPair == nil:
+			cmp = 1
+		default:
+			cmp = strings.Compare(fromPair.StoreKey, toPair.StoreKey)
+			if cmp == 0 {
+				cmp = bytes.Compare(fromPair.Key, toPair.Key)
+			}
+		}
+
+		switch {
+		case cmp < 0:
+			fn(fromPair.StoreKey, fromPair.Key, fromPair.Value, nil)
+			fromPair, err = next(from)
+		case cmp > 0:
+			fn(toPair.StoreKey, toPair.Key, nil, toPair.Value)
+			toPair, err = next(to)
+		default:
+			if !bytes.Equal(fromPair.Value, toPair.Value) {
+				fn(fromPair.StoreKey, fromPair.Key, fromPair.Value, toPair.Value)
+			}
+			if fromPair, err = next(from); err == nil {
+				toPair, err = next(to)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withMultiStore loads the multistore of the application database, mounting the stores
+// of its latest commit, and calls fn with it.
+func withMultiStore(cmd *cobra.Command, fn func(cms *rootmulti.Store) error) error {
+	return withMultiStoreAt(cmd, filepath.Join(GetServerContextFromCmd(cmd).Config.RootDir, "data"), fn)
+}
+
+// withMultiStoreAt is withMultiStore for the application database of the given data directory.
+func withMultiStoreAt(cmd *cobra.Command, dataDir string, fn func(cms *rootmulti.Store) error) error {
+	ctx := GetServerContextFromCmd(cmd)
+	if err := requireIAVLStore(ctx.Viper); err != nil {
+		return err
+	}
+	db, err := dbm.NewDB("application", GetAppDBBackend(ctx.Viper), dataDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	cms := rootmulti.NewStore(db, ctx.Logger)
+	if err := cms.LoadLatestCommittedStores(); err != nil {
+		return fmt.Errorf("failed to load the application state: %w", err)
+	}
+	return fn(cms)
+}
+
+// storeHeight returns the height given by the height flag, or the latest height.
+func storeHeight(cmd *cobra.Command, cms *rootmulti.Store) (int64, error) {
+	height, _ := cmd.Flags().GetInt64(FlagHeight)
+	latest := cms.LastCommitID().Version
+	switch {
+	case height == -1:
+		return latest, nil
+	case height <= 0 || height > latest:
+		return 0, fmt.Errorf("height %d must be between 1 and %d", height, latest)
+	default:
+		return height, nil
+	}
+}
+
+// loadKVStore returns the read-only state of a store at a height.
+func loadKVStore(cms *rootmulti.Store, storeName string, height int64) (storetypes.KVStore, error) {
+	key, ok := cms.StoreKeysByName()[storeName]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeName)
+	}
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying IAVL store.
+	if iavlStore, ok := cms.GetCommitKVStore(key).(*iavl.Store); !ok || !iavlStore.VersionExists(height) {
+		return nil, fmt.Errorf("height %d of store %s does not exist, it may have been pruned", height, storeName)
+	}
+	cacheMS, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+	return cacheMS.GetKVStore(key), nil
+}
+
+// bytesEncoder returns the function encoding the printed bytes per the encoding flag.
+func bytesEncoder(cmd *cobra.Command) (func([]byte) string, error) {
+	encoding, _ := cmd.Flags().GetString(flagEncoding)
+	switch encoding {
+	case encodingHex:
+		return func(bz []byte) string { return fmt.Sprintf("%X", bz) }, nil
+	case encodingBase64:
+		return base64.StdEncoding.EncodeToString, nil
+	default:
+		return nil, fmt.Errorf("unknown encoding %q, expected %s or %s", encoding, encodingHex, encodingBase64)
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	from := dbadapter.Store{DB: dbm.NewMemDB()}
+	to := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	from.Set([]byte("a1"), []byte("same"))
+	to.Set([]byte("a1"), []byte("same"))
+	from.Set([]byte("a2"), []byte("removed"))
+	from.Set([]byte("a3"), []byte("old"))
+	to.Set([]byte("a3"), []byte("new"))
+	to.Set([]byte("a4"), []byte("added"))
+	from.Set([]byte("b1"), []byte("removed"))
+	to.Set([]byte("b2"), []byte("added"))
+
+	type change struct{ key, from, to string }
+	diff := func(prefix []byte) []change {
+		var changes []change
+		diffKVStores(from, to, prefix, func(key, fromValue, toValue []byte) {
+			changes = append(changes, change{string(key), string(fromValue), string(toValue)})
+		})
+		return changes
+	}
+
+	require.Equal(t, []change{
+		{"a2", "removed", ""},
+		{"a3", "old", "new"},
+		{"a4", "", "added"},
+	}, diff([]byte("a")))
+	require.Equal(t, []change{
+		{"a2", "removed", ""},
+		{"a3", "old", "new"},
+		{"a4", "", "added"},
+		{"b1", "removed", ""},
+		{"b2", "", "added"},
+	}, diff(nil))
+	require.Empty(t, diff([]byte("c")))
+}
+
+func TestDiffKVPairsExports(t *testing.T) {
+	export := func(format string, pairs ...storetypes.StoreKVPair) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		writeRecord, err := newRecordWriter(format, buf)
+		require.NoError(t, err)
+		for i := range pairs {
+			require.NoError(t, writeRecord(&pairs[i]))
+		}
+		return buf
+	}
+	a := []storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte{1}, Value: []byte("same")},
+		{StoreKey: "bank", Key: []byte{1}, Value: []byte("old")},
+		{StoreKey: "bank", Key: []byte{2}, Value: []byte("removed")},
+		{StoreKey: "mint", Key: []byte{1}, Value: []byte("removed")},
+	}
+	b := []storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte{1}, Value: []byte("same")},
+		{StoreKey: "bank", Key: []byte{1}, Value: []byte("new")},
+		{StoreKey: "bank", Key: []byte{3}, Value: []byte("added")},
+		{StoreKey: "staking", Key: []byte{1}, Value: []byte("added")},
+	}
+
+	type change struct{ store, key, a, b string }
+	for _, format := range []string{ExportFormatNDJSON, ExportFormatProtobuf} {
+		readA, err := newRecordReader(format, export(format, a...))
+		require.NoError(t, err)
+		readB, err := newRecordReader(format, export(format, b...))
+		require.NoError(t, err)
+
+		var changes []change
+		err = diffKVPairs(readA, readB, func(storeName string, key, valueA, valueB []byte) {
+			changes = append(changes, change{storeName, string(key), string(valueA), string(valueB)})
+		})
+		require.NoError(t, err)
+		require.Equal(t, []change{
+			{"bank", "\x01", "old", "new"},
+			{"bank", "\x02", "removed", ""},
+			{"bank", "\x03", "", "added"},
+			{"mint", "\x01", "removed", ""},
+			{"staking", "\x01", "", "added"},
+		}, changes, format)
+	}
+
+	_, err := newRecordReader("xml", &bytes.Buffer{})
+	require.Error(t, err)
+	readA, err := newRecordReader(ExportFormatNDJSON, bytes.NewBufferString("{}\nnot json\n"))
+	require.NoError(t, err)
+	require.Error(t, diffKVPairs(readA, readA, func(string, []byte, []byte, []byte) {}))
+}
@@ -26,8 +26,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	dbv2 "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -293,8 +296,13 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		startCmd,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		ExportStateCmd(appCreator, defaultNodeHome),
+		StoreCmd(defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(defaultNodeHome),
+		NewPruneCmd(defaultNodeHome),
+		SnapshotsCmd(appCreator, defaultNodeHome),
+		MigrateStoreCmd(defaultNodeHome),
 	)
 }
 
@@ -377,6 +385,48 @@ func GetAppDBBackend(opts types.AppOptions) dbm.BackendType {
 	return dbm.GoLevelDBBackend
 }
 
+// GetSnapshotStore opens the snapshot store of the application, in the
+// data/snapshots directory of its home.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// GetMultiStoreV2DB opens the database of the store/v2alpha1 multistore, in the
+// data/application.smt.db directory of the application home, if the store
+// backend is smt. It returns nil for the IAVL store backend.
+func GetMultiStoreV2DB(appOpts types.AppOptions) (dbv2.Connection, error) {
+	if cast.ToString(appOpts.Get(FlagStoreBackend)) != config.StoreBackendSMT {
+		return nil, nil
+	}
+
+	return badgerdb.NewDB(multiStoreV2DBDir(cast.ToString(appOpts.Get(flags.FlagHome))))
+}
+
+// multiStoreV2DBDir returns the directory of the store/v2alpha1 multistore
+// database of the given application home.
+func multiStoreV2DBDir(rootDir string) string {
+	return filepath.Join(rootDir, "data", "application.smt.db")
+}
+
+// requireIAVLStore returns an error if the store backend is not IAVL, for the
+// commands which read the application.db database directly.
+func requireIAVLStore(appOpts types.AppOptions) error {
+	if backend := cast.ToString(appOpts.Get(FlagStoreBackend)); backend == config.StoreBackendSMT {
+		return fmt.Errorf("the command only supports the %s store backend, not %s", config.StoreBackendIAVL, backend)
+	}
+	return nil
+}
+
 func skipInterface(iface net.Interface) bool {
 	if iface.Flags&net.FlagUp == 0 {
 		return true // interface down
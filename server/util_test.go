@@ -523,3 +523,17 @@ func TestGetAppDBBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMultiStoreV2DB(t *testing.T) {
+	home := t.TempDir()
+
+	db, err := server.GetMultiStoreV2DB(mapGetter{flags.FlagHome: home})
+	require.NoError(t, err)
+	require.Nil(t, db)
+
+	db, err = server.GetMultiStoreV2DB(mapGetter{flags.FlagHome: home, server.FlagStoreBackend: config.StoreBackendSMT})
+	require.NoError(t, err)
+	require.NotNil(t, db)
+	require.NoError(t, db.Close())
+	require.DirExists(t, filepath.Join(home, "data", "application.smt.db"))
+}
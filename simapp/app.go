@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"cosmossdk.io/core/appconfig"
@@ -48,6 +49,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuitmodule "github.com/cosmos/cosmos-sdk/x/circuit/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -58,11 +62,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	feeabskeeper "github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	feeabsmodule "github.com/cosmos/cosmos-sdk/x/feeabs/module"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+	globalfeekeeper "github.com/cosmos/cosmos-sdk/x/globalfee/keeper"
+	globalfeemodule "github.com/cosmos/cosmos-sdk/x/globalfee/module"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -128,6 +141,10 @@ var (
 		groupmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
+		circuitmodule.AppModuleBasic{},
+		feeabsmodule.AppModuleBasic{},
+		feemarketmodule.AppModuleBasic{},
+		globalfeemodule.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -139,6 +156,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		feemarket.ModuleName:           {authtypes.Burner},
 	}
 )
 
@@ -180,6 +198,13 @@ type SimApp struct {
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	GroupKeeper      groupkeeper.Keeper
 	NFTKeeper        nftkeeper.Keeper
+	CircuitKeeper    circuitkeeper.Keeper
+	FeeAbsKeeper     feeabskeeper.Keeper
+	FeeMarketKeeper  feemarketkeeper.Keeper
+	GlobalFeeKeeper  globalfeekeeper.Keeper
+
+	// the KV decoders of the modules, decoding the values of their stores
+	kvDecoders *storetypes.KVDecoderRegistry
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -226,6 +251,10 @@ func NewSimApp(
 		&app.StakingKeeper,
 		&app.NFTKeeper,
 		&app.SlashingKeeper,
+		&app.CircuitKeeper,
+		&app.FeeAbsKeeper,
+		&app.FeeMarketKeeper,
+		&app.GlobalFeeKeeper,
 		&msgServiceRouter,
 	); err != nil {
 		panic(err)
@@ -243,9 +272,20 @@ func NewSimApp(
 	// not include this key.
 	app.memKeys = sdk.NewMemoryStoreKeys("testingkey")
 
-	// configure state listening capabilities using AppOptions
-	// we are doing nothing with the returned streamingServices and waitGroup in this case
-	if _, _, err := streaming.LoadStreamingServices(app.App.BaseApp, appOpts, app.appCodec, app.keys); err != nil {
+	// configure state listening capabilities using AppOptions, exposing the stores of the modules
+	// built by the runtime along with app.keys
+	// we are doing nothing with the returned waitGroup in this case
+	streamingKeys := make(map[string]*storetypes.KVStoreKey, len(app.keys))
+	for _, key := range app.GetStoreKeys() {
+		if key, ok := key.(*storetypes.KVStoreKey); ok {
+			streamingKeys[key.Name()] = key
+		}
+	}
+	for name, key := range app.keys {
+		streamingKeys[name] = key
+	}
+	streamingServices, _, err := streaming.LoadStreamingServices(app.App.BaseApp, appOpts, app.appCodec, streamingKeys)
+	if err != nil {
 		tmos.Exit(err.Error())
 	}
 
@@ -257,7 +297,7 @@ func NewSimApp(
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		app.appCodec, app.keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, authtypes.FeeCollectorName,
+		app.StakingKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
@@ -303,6 +343,9 @@ func NewSimApp(
 		app.appCodec, app.keys[evidencetypes.StoreKey], app.StakingKeeper, app.SlashingKeeper,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(evidencetypes.RouteDoubleSign, evidencekeeper.NewDoubleSignHandler(*evidenceKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
 	/****  Module Options ****/
@@ -337,7 +380,8 @@ func NewSimApp(
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
 		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
-		vestingtypes.ModuleName,
+		vestingtypes.ModuleName, circuit.ModuleName, feeabs.ModuleName, feemarket.ModuleName,
+		globalfee.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -348,6 +392,12 @@ func NewSimApp(
 	app.ModuleManager.RegisterInvariants(&app.CrisisKeeper)
 	app.ModuleManager.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
+	// register the KV decoders of the modules, used by the streaming services which decode the
+	// state changes they stream
+	app.kvDecoders = sdk.NewKVDecoderRegistry(app.appCodec)
+	app.ModuleManager.RegisterKVDecoders(app.kvDecoders)
+	streaming.SetKVDecoders(streamingServices, app.kvDecoders, logger)
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.mm` and `app.configurator` are set.
 	app.RegisterUpgradeHandlers()
@@ -394,6 +444,34 @@ func (app *SimApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// CheckInvariants runs the invariants with the given full routes, or all the
+// registered invariants if none is given, on the latest committed state.
+func (app *SimApp) CheckInvariants(routes []string) ([]crisistypes.InvariantResult, error) {
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+	return app.CrisisKeeper.CheckInvariants(ctx, routes...)
+}
+
+// DryRunUpgrade runs the upgrade handler registered for the given plan name on
+// top of the latest committed state, as if it was applied at the next height,
+// and discards the resulting state changes. The chain ID and block time are
+// the ones of the last committed block header, as tracked by x/staking.
+func (app *SimApp) DryRunUpgrade(planName string) (upgradetypes.DryRunResult, error) {
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	ctx := app.NewUncachedContext(false, header)
+	if histInfo, found := app.StakingKeeper.GetHistoricalInfo(ctx, app.LastBlockHeight()); found {
+		header.ChainID = histInfo.Header.ChainID
+		header.Time = histInfo.Header.Time
+		ctx = ctx.WithBlockHeader(header).WithChainID(header.ChainID)
+	}
+
+	plan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	if !found || plan.Name != planName {
+		plan = upgradetypes.Plan{Name: planName, Height: ctx.BlockHeight()}
+	}
+
+	return app.UpgradeKeeper.DryRunUpgrade(ctx, plan)
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -465,6 +543,12 @@ func (app *SimApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// KVDecoders returns the KV decoders registered by the modules, decoding the
+// values of their stores.
+func (app *SimApp) KVDecoders() *storetypes.KVDecoderRegistry {
+	return app.kvDecoders
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -3,6 +3,7 @@ package simapp
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -24,8 +25,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	feeabsmodule "github.com/cosmos/cosmos-sdk/x/feeabs/module"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	globalfeemodule "github.com/cosmos/cosmos-sdk/x/globalfee/module"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -33,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -191,6 +196,9 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"feeabs":       feeabsmodule.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarketmodule.AppModule{}.ConsensusVersion(),
+					"globalfee":    globalfeemodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -269,3 +277,39 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
+
+func TestDryRunUpgrade(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := dbm.NewMemDB()
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:             log.NewNopLogger(),
+		DB:                 db,
+		InvCheckPeriod:     0,
+		EncConfig:          encCfg,
+		HomePath:           DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		AppOpts:            EmptyAppOptions{},
+	})
+	app.Commit()
+
+	header := tmproto.Header{ChainID: "dry-run-chain", Height: app.LastBlockHeight() + 1, Time: time.Unix(1600000000, 0).UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	var upgradeCtx sdk.Context
+	app.UpgradeKeeper.SetUpgradeHandler("dry-run", func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		upgradeCtx = ctx
+		return fromVM, nil
+	})
+
+	res, err := app.DryRunUpgrade("dry-run")
+	require.NoError(t, err)
+	require.False(t, res.Failed())
+
+	// the upgrade runs at the next height, with the last committed block header
+	require.Equal(t, header.Height+1, upgradeCtx.BlockHeight())
+	require.Equal(t, header.ChainID, upgradeCtx.ChainID())
+	require.Equal(t, header.ChainID, upgradeCtx.BlockHeader().ChainID)
+	require.Equal(t, header.Time, upgradeCtx.BlockTime())
+}
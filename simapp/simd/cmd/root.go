@@ -4,7 +4,6 @@ import (
 	"errors"
 	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -25,7 +24,6 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
-	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,7 +31,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiscli "github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -171,6 +171,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	rootCmd.AddCommand(crisiscli.CheckInvariantsCmd(a.appInvariants, simapp.DefaultNodeHome))
+	rootCmd.AddCommand(upgradecli.UpgradeDryRunCmd(a.appUpgradeDryRun, simapp.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -202,6 +204,7 @@ func queryCommand() *cobra.Command {
 		authcmd.GetAccountCmd(),
 		rpc.ValidatorCommand(),
 		rpc.BlockCommand(),
+		rpc.CommitInfoCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
 	)
@@ -261,12 +264,7 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := server.GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -275,13 +273,14 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Format = cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotFormat))
+	snapshotOptions.ChunkSize = cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotChunkSize))
 
-	return simapp.NewSimApp(
-		logger, db, traceStore, true, skipUpgradeHeights,
-		cast.ToString(appOpts.Get(flags.FlagHome)),
-		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
-		a.encCfg,
-		appOpts,
+	baseappOptions, err := multiStoreOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	baseappOptions = append(baseappOptions,
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
@@ -292,6 +291,25 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 	)
+
+	return simapp.NewSimApp(
+		logger, db, traceStore, true, skipUpgradeHeights,
+		cast.ToString(appOpts.Get(flags.FlagHome)),
+		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
+		a.encCfg,
+		appOpts,
+		baseappOptions...,
+	)
+}
+
+// multiStoreOptions returns the options selecting the multistore of the store
+// backend of the app options, which must precede the other baseapp options.
+func multiStoreOptions(appOpts servertypes.AppOptions) ([]func(*baseapp.BaseApp), error) {
+	db, err := server.GetMultiStoreV2DB(appOpts)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	return []func(*baseapp.BaseApp){baseapp.SetMultiStoreV2(db)}, nil
 }
 
 // appExport creates a new simapp (optionally at a given height)
@@ -304,16 +322,64 @@ func (a appCreator) appExport(
 	if !ok || homePath == "" {
 		return servertypes.ExportedApp{}, errors.New("application home not set")
 	}
+	baseappOptions, err := multiStoreOptions(appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
 
 	if height != -1 {
-		simApp = simapp.NewSimApp(logger, db, traceStore, false, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+		simApp = simapp.NewSimApp(logger, db, traceStore, false, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts, baseappOptions...)
 
 		if err := simApp.LoadHeight(height); err != nil {
 			return servertypes.ExportedApp{}, err
 		}
 	} else {
-		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts, baseappOptions...)
 	}
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// appInvariants creates a new simapp at a given height, or at the latest
+// height if height is -1, to check its invariants.
+func (a appCreator) appInvariants(
+	logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions,
+) (crisiscli.InvariantsApp, error) {
+	return a.appAtHeight(logger, db, height, appOpts)
+}
+
+// appUpgradeDryRun creates a new simapp at a given height, or at the latest
+// height if height is -1, to rehearse an upgrade on top of its state.
+func (a appCreator) appUpgradeDryRun(
+	logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions,
+) (upgradecli.DryRunApp, error) {
+	return a.appAtHeight(logger, db, height, appOpts)
+}
+
+// appAtHeight creates a new simapp whose state is loaded at a given height, or
+// at the latest height if height is -1.
+func (a appCreator) appAtHeight(
+	logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions,
+) (*simapp.SimApp, error) {
+	var simApp *simapp.SimApp
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return nil, errors.New("application home not set")
+	}
+	baseappOptions, err := multiStoreOptions(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if height != -1 {
+		simApp = simapp.NewSimApp(logger, db, nil, false, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts, baseappOptions...)
+
+		if err := simApp.LoadHeight(height); err != nil {
+			return nil, err
+		}
+	} else {
+		simApp = simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts, baseappOptions...)
+	}
+
+	return simApp, nil
+}
@@ -1,8 +1,10 @@
 package snapshots
 
 import (
+	"bytes"
 	"io"
 	"math"
+	"runtime"
 
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -157,6 +159,83 @@ func (r *ChunkReader) Read(p []byte) (int, error) {
 	return n, err
 }
 
+// chunkConcurrency is the number of chunks processed in parallel by processChunks.
+var chunkConcurrency = runtime.NumCPU()
+
+// processChunks reads the chunks of a chunk channel into memory and processes them with fn
+// concurrently, passing the processed chunks to the returned channel in their original order. If
+// reading or processing a chunk fails, the reader of that chunk returns the error and no further
+// chunks are passed.
+func processChunks(chunks <-chan io.ReadCloser, fn func(index uint32, chunk []byte) ([]byte, error)) <-chan io.ReadCloser {
+	type result struct {
+		chunk []byte
+		err   error
+	}
+
+	// pending holds the results of the chunks being processed in order, and bounds the number of
+	// chunks held in memory.
+	pending := make(chan chan result, chunkConcurrency)
+	quit := make(chan struct{})
+	go func() {
+		defer close(pending)
+		defer DrainChunks(chunks)
+		for index := uint32(0); ; index++ {
+			var (
+				chunk io.ReadCloser
+				ok    bool
+			)
+			select {
+			case chunk, ok = <-chunks:
+			case <-quit:
+				return
+			}
+			if !ok {
+				return
+			}
+
+			done := make(chan result, 1)
+			select {
+			case pending <- done:
+			case <-quit:
+				_ = chunk.Close()
+				return
+			}
+
+			body, err := io.ReadAll(chunk)
+			if closeErr := chunk.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				done <- result{err: err}
+				return
+			}
+			go func(index uint32, body []byte) {
+				body, err := fn(index, body)
+				done <- result{chunk: body, err: err}
+			}(index, body)
+		}
+	}()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for done := range pending {
+			res := <-done
+			if res.err != nil {
+				close(quit)
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(res.err) // CloseWithError always returns nil
+				ch <- pr
+				for range pending { // nolint: revive
+				}
+				return
+			}
+			ch <- io.NopCloser(bytes.NewReader(res.chunk))
+		}
+	}()
+	return ch
+}
+
 // DrainChunks drains and closes all remaining chunks from a chunk channel.
 func DrainChunks(chunks <-chan io.ReadCloser) {
 	for chunk := range chunks {
@@ -166,7 +245,7 @@ func DrainChunks(chunks <-chan io.ReadCloser) {
 
 // ValidRestoreHeight will check height is valid for snapshot restore or not
 func ValidRestoreHeight(format uint32, height uint64) error {
-	if format != snapshottypes.CurrentFormat {
+	if !snapshottypes.IsFormatSupported(format) {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 
@@ -176,17 +176,31 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	format := m.opts.SnapshotFormat()
+	if !types.IsFormatSupported(format) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+	if format == types.FormatZstd && m.opts.SnapshotChunkSize() > types.MaxChunkSize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"snapshot chunk size %v cannot exceed %v", m.opts.SnapshotChunkSize(), types.MaxChunkSize)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, format, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, format, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
+func (m *Manager) createSnapshot(height uint64, format uint32, ch chan<- io.ReadCloser) {
+	var streamWriter *StreamWriter
+	if format == types.FormatZstd {
+		streamWriter = NewZstdStreamWriter(ch, m.opts.SnapshotChunkSize())
+	} else {
+		streamWriter = NewStreamWriter(ch)
+	}
 	if streamWriter == nil {
 		return
 	}
@@ -267,7 +281,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsFormatSupported(snapshot.Format) {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -303,9 +317,40 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot of the local
+// snapshot store, e.g. loaded from an archive, instead of chunks received from
+// peers. It blocks until the restoration is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if err := ValidRestoreHeight(snapshot.Format, snapshot.Height); err != nil {
+		return err
+	}
+
+	err = m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	// the verified chunks must be drained too if the restoration fails, so that
+	// the verification doesn't block on the chunks left
+	verified := VerifyChunks(chChunks, snapshot.Metadata.ChunkHashes)
+	defer DrainChunks(verified)
+
+	return m.restoreSnapshot(*snapshot, verified)
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := NewStreamReaderWithFormat(snapshot.Format, chChunks)
 	if err != nil {
 		return err
 	}
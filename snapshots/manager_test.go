@@ -1,15 +1,20 @@
 package snapshots_test
 
 import (
+	"bytes"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -228,3 +233,90 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	_, err := store.Save(4, types.CurrentFormat, makeChunks(snapshotItems(expectItems)))
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+
+	// Restore errors on a missing snapshot or an unsupported format
+	err = manager.RestoreLocalSnapshot(5, types.CurrentFormat)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	err = manager.RestoreLocalSnapshot(1, 1)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	err = manager.RestoreLocalSnapshot(4, types.CurrentFormat)
+	require.NoError(t, err)
+	assert.Equal(t, expectItems, target.items)
+
+	// the restore is complete, other operations can run
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
+
+func TestManager_TakeAndRestoreZstd(t *testing.T) {
+	var items [][]byte
+	for i := 0; i < 10; i++ {
+		items = append(items, bytes.Repeat([]byte{byte(i)}, 64))
+	}
+	zstdOpts := types.NewSnapshotOptions(1500, 2)
+	zstdOpts.Format = types.FormatZstd
+	zstdOpts.ChunkSize = 100
+
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(db.NewMemDB(), dir)
+	require.NoError(t, err)
+	source := &mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})}
+	manager := snapshots.NewManager(store, zstdOpts, source, nil, log.NewNopLogger())
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatZstd, snapshot.Format)
+	require.Greater(t, snapshot.Chunks, uint32(1))
+
+	// the snapshot is identical on another node
+	otherStore, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	other := &mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})}
+	otherSnapshot, err := snapshots.NewManager(otherStore, zstdOpts, other, nil, log.NewNopLogger()).Create(5)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, otherSnapshot)
+
+	// restore from chunks received one by one
+	target := &mockSnapshotter{}
+	targetManager := snapshots.NewManager(otherStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, items, target.items)
+
+	// restore from the local snapshot store
+	target = &mockSnapshotter{}
+	err = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger()).RestoreLocalSnapshot(5, types.FormatZstd)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// a tampered chunk fails the restore
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5", "3", "1"), []byte{1, 2, 3}, 0o644))
+	target = &mockSnapshotter{}
+	err = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger()).RestoreLocalSnapshot(5, types.FormatZstd)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	// the chunk size is bounded
+	zstdOpts.ChunkSize = types.MaxChunkSize + 1
+	_, err = snapshots.NewManager(store, zstdOpts, source, nil, log.NewNopLogger()).Create(6)
+	require.Error(t, err)
+}
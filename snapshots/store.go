@@ -255,39 +255,66 @@ func (s *Store) Save(
 		Height: height,
 		Format: format,
 	}
+
+	// The chunks are read in order to compute the snapshot hash, while they are hashed and written
+	// to disk concurrently.
+	var (
+		wg       sync.WaitGroup
+		mtx      sync.Mutex
+		writeErr error
+	)
+	sem := make(chan struct{}, chunkConcurrency)
 	index := uint32(0)
 	snapshotHasher := sha256.New()
-	chunkHasher := sha256.New()
 	for chunkBody := range chunks {
-		defer chunkBody.Close() // nolint: staticcheck
-		dir := s.pathSnapshot(height, format)
-		err = os.MkdirAll(dir, 0o755)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
-		}
-		path := s.pathChunk(height, format, index)
-		file, err := os.Create(path)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+		body, err := io.ReadAll(chunkBody)
+		if closeErr := chunkBody.Close(); err == nil {
+			err = closeErr
 		}
-		defer file.Close() // nolint: staticcheck
-
-		chunkHasher.Reset()
-		_, err = io.Copy(io.MultiWriter(file, chunkHasher, snapshotHasher), chunkBody)
 		if err != nil {
+			wg.Wait()
 			return nil, sdkerrors.Wrapf(err, "failed to generate snapshot chunk %v", index)
 		}
-		err = file.Close()
+		_, _ = snapshotHasher.Write(body) // hash.Hash.Write never returns an error
+		dir := s.pathSnapshot(height, format)
+		err = os.MkdirAll(dir, 0o755)
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to close snapshot chunk %v", index)
+			wg.Wait()
+			return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 		}
-		err = chunkBody.Close()
+
+		mtx.Lock()
+		err = writeErr
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, nil)
+		mtx.Unlock()
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to close snapshot chunk %v", index)
+			break
 		}
-		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(index uint32, body []byte) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			hash := sha256.Sum256(body)
+			path := s.pathChunk(height, format, index)
+			err := os.WriteFile(path, body, 0o644)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+			snapshot.Metadata.ChunkHashes[index] = hash[:]
+			if err != nil && writeErr == nil {
+				writeErr = sdkerrors.Wrapf(err, "failed to write snapshot chunk file %q", path)
+			}
+		}(index, body)
 		index++
 	}
+	wg.Wait()
+	if writeErr != nil {
+		return nil, writeErr
+	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
 	return snapshot, s.saveSnapshot(snapshot)
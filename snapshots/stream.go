@@ -2,12 +2,16 @@ package snapshots
 
 import (
 	"bufio"
+	"bytes"
 	"compress/zlib"
+	"crypto/sha256"
 	"io"
 
 	protoio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -17,6 +21,8 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+	// Do not change zstd compression level without new snapshot format (must be uniform across nodes)
+	snapshotZstdCompressionLevel = zstd.SpeedDefault
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
@@ -24,7 +30,7 @@ const (
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
+	zWriter     io.WriteCloser
 	protoWriter protoio.WriteCloser
 }
 
@@ -46,6 +52,41 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	}
 }
 
+// NewZstdStreamWriter set up a stream pipeline to serialize snapshot DB records in the FormatZstd
+// format, compressing the chunks of chunkSize bytes in parallel:
+// Exported Items -> delimited Protobuf -> buffer -> chunkWriter -> zstd -> chan io.ReadCloser
+func NewZstdStreamWriter(ch chan<- io.ReadCloser, chunkSize uint64) *StreamWriter {
+	rawChunks := make(chan io.ReadCloser)
+	chunkWriter := NewChunkWriter(rawChunks, chunkSize)
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(snapshotZstdCompressionLevel),
+		zstd.WithEncoderConcurrency(chunkConcurrency),
+	)
+	if err != nil {
+		close(ch)
+		chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zstd failure"))
+		return nil
+	}
+
+	chunks := processChunks(rawChunks, func(_ uint32, chunk []byte) ([]byte, error) {
+		return encoder.EncodeAll(chunk, nil), nil
+	})
+	go func() {
+		defer close(ch)
+		defer encoder.Close()
+		for chunk := range chunks {
+			ch <- chunk
+		}
+	}()
+
+	bufWriter := bufio.NewWriterSize(chunkWriter, int(chunkSize))
+	return &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufWriter,
+		protoWriter: protoio.NewDelimitedWriter(bufWriter),
+	}
+}
+
 // WriteMsg implements protoio.Write interface
 func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
 	return sw.protoWriter.WriteMsg(msg)
@@ -57,9 +98,11 @@ func (sw *StreamWriter) Close() error {
 		sw.chunkWriter.CloseWithError(err)
 		return err
 	}
-	if err := sw.zWriter.Close(); err != nil {
-		sw.chunkWriter.CloseWithError(err)
-		return err
+	if sw.zWriter != nil {
+		if err := sw.zWriter.Close(); err != nil {
+			sw.chunkWriter.CloseWithError(err)
+			return err
+		}
 	}
 	if err := sw.bufWriter.Flush(); err != nil {
 		sw.chunkWriter.CloseWithError(err)
@@ -77,7 +120,7 @@ func (sw *StreamWriter) CloseWithError(err error) {
 // chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 type StreamReader struct {
 	chunkReader *ChunkReader
-	zReader     io.ReadCloser
+	zReader     io.Closer
 	protoReader protoio.ReadCloser
 }
 
@@ -96,6 +139,72 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	}, nil
 }
 
+// NewZstdStreamReader set up a restore stream pipeline for the FormatZstd format, decompressing
+// the chunks in parallel:
+// chan io.ReadCloser -> zstd -> chunkReader -> delimited Protobuf -> ExportNode
+func NewZstdStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(chunkConcurrency),
+		zstd.WithDecoderMaxMemory(snapshottypes.MaxChunkSize),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "zstd failure")
+	}
+
+	chunkReader := NewChunkReader(processChunks(chunks, func(index uint32, chunk []byte) ([]byte, error) {
+		chunk, err := decoder.DecodeAll(chunk, nil)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to decompress chunk %v", index)
+		}
+		return chunk, nil
+	}))
+	return &StreamReader{
+		chunkReader: chunkReader,
+		zReader:     decoderCloser{decoder},
+		protoReader: protoio.NewDelimitedReader(chunkReader, snapshotMaxItemSize),
+	}, nil
+}
+
+// NewStreamReaderWithFormat set up a restore stream pipeline for the given snapshot format.
+func NewStreamReaderWithFormat(format uint32, chunks <-chan io.ReadCloser) (*StreamReader, error) {
+	switch format {
+	case snapshottypes.FormatZlib:
+		return NewStreamReader(chunks)
+	case snapshottypes.FormatZstd:
+		return NewZstdStreamReader(chunks)
+	default:
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+}
+
+// VerifyChunks verifies the hashes of the chunks of a chunk channel in parallel, passing them on
+// to the returned channel in order. The reader of the first chunk that does not match its hash
+// returns ErrChunkHashMismatch.
+func VerifyChunks(chunks <-chan io.ReadCloser, hashes [][]byte) <-chan io.ReadCloser {
+	return processChunks(chunks, func(index uint32, chunk []byte) ([]byte, error) {
+		if int(index) >= len(hashes) {
+			return nil, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata, "unexpected chunk %v", index)
+		}
+		hash := sha256.Sum256(chunk)
+		if !bytes.Equal(hash[:], hashes[index]) {
+			return nil, sdkerrors.Wrapf(snapshottypes.ErrChunkHashMismatch,
+				"chunk %v: expected %x, got %x", index, hashes[index], hash)
+		}
+		return chunk, nil
+	})
+}
+
+// decoderCloser adapts a zstd.Decoder to io.Closer.
+type decoderCloser struct {
+	*zstd.Decoder
+}
+
+// Close implements io.Closer interface
+func (d decoderCloser) Close() error {
+	d.Decoder.Close()
+	return nil
+}
+
 // ReadMsg implements protoio.Reader interface
 func (sr *StreamReader) ReadMsg(msg proto.Message) error {
 	return sr.protoReader.ReadMsg(msg)
@@ -107,8 +216,10 @@ func (sr *StreamReader) Close() error {
 	if err1 := sr.protoReader.Close(); err1 != nil {
 		err = err1
 	}
-	if err2 := sr.zReader.Close(); err2 != nil {
-		err = err2
+	if sr.zReader != nil {
+		if err2 := sr.zReader.Close(); err2 != nil {
+			err = err2
+		}
 	}
 	if err3 := sr.chunkReader.Close(); err3 != nil {
 		err = err3
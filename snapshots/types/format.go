@@ -1,6 +1,35 @@
 package types
 
+const (
+	// FormatZlib is the snapshot format whose stream of snapshot items is compressed with zlib
+	// and split in chunks of 10 MB, one after another.
+	FormatZlib uint32 = 2
+
+	// FormatZstd is the snapshot format whose stream of snapshot items is split in chunks of a
+	// configurable size, each compressed with zstd on its own so that the chunks can be
+	// compressed, hashed, written and restored in parallel.
+	FormatZstd uint32 = 3
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 2
+const CurrentFormat = FormatZlib
+
+// DefaultChunkSize is the default size of the uncompressed chunks of FormatZstd snapshots.
+const DefaultChunkSize uint64 = 10e6
+
+// MaxChunkSize is the maximum size of the uncompressed chunks of FormatZstd snapshots, which
+// leaves room for the compression overhead of incompressible data below the 16 MB limit of
+// Tendermint's state sync chunk messages.
+const MaxChunkSize uint64 = 15e6
+
+// MinChunkSize is the minimum size of the uncompressed chunks of FormatZstd snapshots. The
+// snapshot metadata holds the hash of every chunk and must fit in Tendermint's 4 MB state sync
+// snapshot messages, so tiny chunks would make the snapshots of a large state unshareable.
+const MinChunkSize uint64 = 1e6
+
+// IsFormatSupported returns whether snapshots of the given format can be taken and restored.
+func IsFormatSupported(format uint32) bool {
+	return format == FormatZlib || format == FormatZstd
+}
@@ -8,6 +8,14 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Format defines the format of the snapshots taken, CurrentFormat if 0.
+	Format uint32
+
+	// ChunkSize defines the size of the uncompressed chunks of FormatZstd
+	// snapshots, DefaultChunkSize if 0. Nodes only share the snapshots of a
+	// height with peers that use the same chunk size.
+	ChunkSize uint64
 }
 
 // SnapshotIntervalOff represents the snapshot interval, at which
@@ -20,3 +28,20 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// SnapshotFormat returns the format of the snapshots taken.
+func (o SnapshotOptions) SnapshotFormat() uint32 {
+	if o.Format == 0 {
+		return CurrentFormat
+	}
+	return o.Format
+}
+
+// SnapshotChunkSize returns the size of the uncompressed chunks of FormatZstd
+// snapshots.
+func (o SnapshotOptions) SnapshotChunkSize() uint64 {
+	if o.ChunkSize == 0 {
+		return DefaultChunkSize
+	}
+	return o.ChunkSize
+}
@@ -144,6 +144,16 @@ func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// LoadVersionForOverwriting loads the tree at a previously committed version,
+// deleting all the later versions, and returns the latest version.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, errors.New("cannot overwrite the versions of an immutable IAVL tree")
+	}
+	return tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // GetAllVersions returns all versions in the iavl tree
 func (st *Store) GetAllVersions() []int {
 	return st.tree.AvailableVersions()
@@ -33,6 +33,9 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// pruneBatchSize is the number of versions PruneVersionsBelow deletes from a store at once.
+	pruneBatchSize = 100
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -923,30 +926,189 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	}
 }
 
-// RollbackToVersion delete the versions after `target` and update the latest version.
-func (rs *Store) RollbackToVersion(target int64) int64 {
-	if target < 0 {
-		panic("Negative rollback target")
+// RollbackToVersion deletes the versions after `target` from all the mounted IAVL
+// stores and updates the latest version. It errors, before modifying any store, if
+// the target version does not exist in one of the stores.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
 	current := getLatestVersion(rs.db)
 	if target >= current {
-		return current
+		return fmt.Errorf("rollback height target %d must be lower than the latest height %d", target, current)
 	}
-	for ; current > target; current-- {
-		rs.pruningManager.HandleHeight(current)
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the commit info of version %d", target)
 	}
-	if err := rs.pruneStores(); err != nil {
-		panic(err)
+
+	stores := make(map[types.StoreKey]*iavl.Store)
+	for key, store := range rs.stores {
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !iavlStore.VersionExists(target) {
+			return fmt.Errorf("version %d of store %s does not exist, it may have been pruned", target, key.Name())
+		}
+		stores[key] = iavlStore
+	}
+	for key, store := range stores {
+		if _, err := store.LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
 	}
 
 	// update latest height
-	bz, err := gogotypes.StdInt64Marshal(current)
+	bz, err := gogotypes.StdInt64Marshal(target)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if err := rs.db.Set([]byte(latestVersionKey), bz); err != nil {
+		return err
 	}
+	rs.lastCommitInfo = cInfo
+	return nil
+}
 
-	rs.db.Set([]byte(latestVersionKey), bz)
-	return current
+// GetCommitInfo returns the commit info of a committed version.
+func (rs *Store) GetCommitInfo(version int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, version)
+}
+
+// LoadLatestCommittedStores mounts the stores of the latest commit info persisted in the
+// database as IAVL stores, and loads their latest version. It allows offline tools, such as
+// the prune command, to load the multistore of an application without mounting its stores.
+// Memory stores, which are committed without a version, are skipped.
+func (rs *Store) LoadLatestCommittedStores() error {
+	latest := getLatestVersion(rs.db)
+	if latest == 0 {
+		return errors.New("no committed version found")
+	}
+	return rs.LoadCommittedStores(latest)
+}
+
+// LoadCommittedStores mounts the stores of the commit info persisted in the database at the
+// given version as IAVL stores, and loads that version. Unlike LoadLatestCommittedStores, the
+// stores deleted by a store upgrade after the version are mounted, and the stores added after
+// it are not.
+func (rs *Store) LoadCommittedStores(version int64) error {
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return err
+	}
+	for _, storeInfo := range cInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		rs.MountStoreWithDB(types.NewKVStoreKey(storeInfo.Name), types.StoreTypeIAVL, nil)
+	}
+	return rs.LoadVersion(version)
+}
+
+// PruneVersionsBelow deletes the versions below the given version from all the mounted IAVL
+// stores, pruneBatchSize versions at a time. The progress function, if any, is called after each
+// batch with the number of versions of the store deleted so far and in total.
+func (rs *Store) PruneVersionsBelow(version int64, progress func(storeName string, pruned, total int)) error {
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key := range rs.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	for _, key := range keys {
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		var versions []int64
+		for _, v := range store.GetAllVersions() {
+			if int64(v) < version {
+				versions = append(versions, int64(v))
+			}
+		}
+		for start := 0; start < len(versions); start += pruneBatchSize {
+			end := start + pruneBatchSize
+			if end > len(versions) {
+				end = len(versions)
+			}
+			if err := store.DeleteVersions(versions[start:end]...); err != nil {
+				return errors.Wrapf(err, "failed to prune store %s", key.Name())
+			}
+			if progress != nil {
+				progress(key.Name(), end, len(versions))
+			}
+		}
+	}
+	return nil
+}
+
+// ExportKVPairs calls fn with the raw key-value pairs of the mounted IAVL stores at a committed
+// version, store by store in name order and key by key in ascending order, without loading
+// a whole store in memory. Only the given stores are exported, or all the stores committed at
+// the version if none is given. It errors if one of the stores does not exist at the version,
+// e.g. because it was pruned. The stores should be mounted with LoadCommittedStores at the
+// version, so that the stores deleted since then are exported too.
+func (rs *Store) ExportKVPairs(version int64, storeNames []string, fn func(pair *types.StoreKVPair) error) error {
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the commit info of version %d", version)
+	}
+
+	if len(storeNames) == 0 {
+		for _, storeInfo := range cInfo.StoreInfos {
+			if storeInfo.CommitId.Version != 0 {
+				storeNames = append(storeNames, storeInfo.Name)
+			}
+		}
+	}
+	storeNames = append([]string(nil), storeNames...)
+	sort.Strings(storeNames)
+
+	for _, name := range storeNames {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("store %s is not mounted", name)
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			return fmt.Errorf("store %s is not an IAVL store", name)
+		}
+		if !store.VersionExists(version) {
+			return fmt.Errorf("version %d of store %s does not exist, it may have been pruned", version, name)
+		}
+		immutable, err := store.GetImmutable(version)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load version %d of store %s", version, name)
+		}
+
+		if err := exportStoreKVPairs(name, immutable, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportStoreKVPairs(name string, store *iavl.Store, fn func(pair *types.StoreKVPair) error) error {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pair := &types.StoreKVPair{StoreKey: name, Key: iter.Key(), Value: iter.Value()}
+		if err := fn(pair); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
 }
 
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
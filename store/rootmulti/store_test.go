@@ -833,6 +833,149 @@ func TestCommitOrdered(t *testing.T) {
 //-----------------------------------------------------------------------
 // utils
 
+func TestPruneVersionsBelowOffline(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.MountStoreWithDB(types.NewMemoryStoreKey("mem"), types.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := byte(0); i < 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte{i}, []byte{i})
+		ms.Commit()
+	}
+
+	// load the stores without mounting them
+	ms = NewStore(db, log.NewNopLogger())
+	require.NoError(t, ms.LoadLatestCommittedStores())
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+	require.NotNil(t, ms.GetStoreByName("store1"))
+	require.Nil(t, ms.GetStoreByName("mem"))
+
+	progress := map[string]int{}
+	err := ms.PruneVersionsBelow(8, func(storeName string, pruned, total int) {
+		require.Equal(t, 7, total)
+		progress[storeName] = pruned
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"store1": 7, "store2": 7, "store3": 7}, progress)
+	require.Equal(t, []int{8, 9, 10}, ms.GetStoreByName("store1").(*iavl.Store).GetAllVersions())
+
+	// the pruned versions can't be loaded anymore
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadVersion(8))
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, ms.LoadVersion(7))
+
+	// an empty database has no stores to load
+	require.Error(t, NewStore(dbm.NewMemDB(), log.NewNopLogger()).LoadLatestCommittedStores())
+}
+
+func TestRollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	commitIDs := make([]types.CommitID, 10)
+	for i := byte(0); i < 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte{i}, []byte{i})
+		commitIDs[i] = ms.Commit()
+	}
+
+	// only heights below the latest one can be rolled back to
+	require.Error(t, ms.RollbackToVersion(0))
+	require.Error(t, ms.RollbackToVersion(10))
+	require.Error(t, ms.RollbackToVersion(11))
+
+	require.NoError(t, ms.RollbackToVersion(7))
+	require.Equal(t, commitIDs[6], ms.LastCommitID())
+
+	// the rolled back state is loaded upon restart and can be committed on
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitIDs[6], ms.LastCommitID())
+	require.Nil(t, ms.GetKVStore(testStoreKey1).Get([]byte{7}))
+	ms.GetKVStore(testStoreKey1).Set([]byte{7}, []byte{7})
+	require.Equal(t, commitIDs[7], ms.Commit())
+
+	// a pruned height can't be rolled back to
+	require.NoError(t, ms.PruneVersionsBelow(5, nil))
+	require.Error(t, ms.RollbackToVersion(4))
+	require.Equal(t, commitIDs[7], ms.LastCommitID())
+}
+
+func TestExportKVPairs(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := byte(0); i < 3; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte{i}, []byte{i})
+		ms.GetKVStore(testStoreKey3).Set([]byte{2 - i}, []byte{i})
+		ms.Commit()
+	}
+
+	var pairs []types.StoreKVPair
+	export := func(pair *types.StoreKVPair) error {
+		pairs = append(pairs, *pair)
+		return nil
+	}
+
+	require.NoError(t, ms.ExportKVPairs(2, nil, export))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte{0}, Value: []byte{0}},
+		{StoreKey: "store1", Key: []byte{1}, Value: []byte{1}},
+		{StoreKey: "store3", Key: []byte{1}, Value: []byte{1}},
+		{StoreKey: "store3", Key: []byte{2}, Value: []byte{0}},
+	}, pairs)
+
+	pairs = nil
+	require.NoError(t, ms.ExportKVPairs(3, []string{"store3"}, export))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store3", Key: []byte{0}, Value: []byte{2}},
+		{StoreKey: "store3", Key: []byte{1}, Value: []byte{1}},
+		{StoreKey: "store3", Key: []byte{2}, Value: []byte{0}},
+	}, pairs)
+
+	require.Error(t, ms.ExportKVPairs(3, []string{"unknown"}, export))
+	require.Error(t, ms.ExportKVPairs(4, nil, export))
+	require.NoError(t, ms.PruneVersionsBelow(2, nil))
+	require.Error(t, ms.ExportKVPairs(1, nil, export))
+}
+
+func TestExportKVPairsOfDeletedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey3).Set([]byte{1}, []byte{1})
+	ms.Commit()
+
+	// store3 is deleted and store4 added at version 2
+	ms, upgrades := newMultiStoreWithModifiedMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersionAndUpgrade(upgrades))
+	ms.GetKVStore(ms.keysByName["store4"]).Set([]byte{2}, []byte{2})
+	ms.Commit()
+
+	var pairs []types.StoreKVPair
+	export := func(pair *types.StoreKVPair) error {
+		pairs = append(pairs, *pair)
+		return nil
+	}
+
+	// store3 is not mounted from the latest commit info
+	ms = NewStore(db, log.NewNopLogger())
+	require.NoError(t, ms.LoadLatestCommittedStores())
+	require.Nil(t, ms.GetStoreByName("store3"))
+	require.Error(t, ms.ExportKVPairs(1, []string{"store3"}, export))
+
+	// but it is from the commit info of version 1, unlike store4
+	ms = NewStore(db, log.NewNopLogger())
+	require.NoError(t, ms.LoadCommittedStores(1))
+	require.Equal(t, int64(1), ms.LastCommitID().Version)
+	require.Nil(t, ms.GetStoreByName("store4"))
+	require.NoError(t, ms.ExportKVPairs(1, nil, export))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store3", Key: []byte{1}, Value: []byte{1}}}, pairs)
+
+	// a version without commit info can't be loaded
+	require.Error(t, NewStore(db, log.NewNopLogger()).LoadCommittedStores(3))
+}
+
 var (
 	testStoreKey1 = types.NewKVStoreKey("store1")
 	testStoreKey2 = types.NewKVStoreKey("store2")
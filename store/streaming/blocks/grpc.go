@@ -0,0 +1,78 @@
+package blocks
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ Sink = &GRPCSink{}
+
+// GRPCSink is a Sink which pushes the blocks to a StreamingListener gRPC service, over a
+// ListenBlocks stream on which the listener acknowledges each block once it is processed
+type GRPCSink struct {
+	conn   *grpc.ClientConn
+	client types.StreamingListenerClient
+	stream types.StreamingListener_ListenBlocksClient
+	cancel context.CancelFunc
+}
+
+// NewGRPCSink creates a new GRPCSink pushing the blocks to the StreamingListener service at
+// address. The connection is established lazily, and the stream is opened again after an error.
+func NewGRPCSink(address string) (*GRPCSink, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCSink{conn: conn, client: types.NewStreamingListenerClient(conn)}, nil
+}
+
+// Deliver satisfies the Sink interface
+// It returns once the listener has acknowledged the block
+func (s *GRPCSink) Deliver(block *types.StreamBlock) error {
+	if s.stream == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := s.client.ListenBlocks(ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		s.stream, s.cancel = stream, cancel
+	}
+
+	err := s.stream.Send(&types.ListenBlocksRequest{Block: block})
+	if err == nil {
+		var res *types.ListenBlocksResponse
+		res, err = s.stream.Recv()
+		if err == nil {
+			height := block.GetRequestBeginBlock().GetHeader().Height
+			if res.Height == height {
+				return nil
+			}
+			err = fmt.Errorf("the listener acknowledged height %d instead of %d", res.Height, height)
+		}
+	}
+	s.closeStream()
+	return err
+}
+
+func (s *GRPCSink) closeStream() {
+	if s.stream != nil {
+		s.cancel()
+		s.stream, s.cancel = nil, nil
+	}
+}
+
+// Close satisfies the io.Closer interface
+func (s *GRPCSink) Close() error {
+	if s.stream != nil {
+		// let the listener know that no more blocks are coming
+		_ = s.stream.CloseSend()
+		s.closeStream()
+	}
+	return s.conn.Close()
+}
@@ -0,0 +1,43 @@
+package blocks
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ Sink = &NDJSONSink{}
+
+// NDJSONSink is a Sink which writes each block out as a line of protobuf JSON
+type NDJSONSink struct {
+	w *bufio.Writer
+}
+
+// NewNDJSONSink creates a new NDJSONSink writing to w, e.g. os.Stdout
+func NewNDJSONSink(w io.Writer) *NDJSONSink {
+	return &NDJSONSink{w: bufio.NewWriter(w)}
+}
+
+// Deliver satisfies the Sink interface
+// It returns once the line of the block has been flushed to the writer
+func (s *NDJSONSink) Deliver(block *types.StreamBlock) error {
+	bz, err := codec.ProtoMarshalJSON(block, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := s.w.Write(bz); err != nil {
+		return err
+	}
+	if err := s.w.WriteByte('\n'); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// Close satisfies the io.Closer interface
+// The writer is not closed, since it may be shared, e.g. os.Stdout
+func (s *NDJSONSink) Close() error {
+	return s.w.Flush()
+}
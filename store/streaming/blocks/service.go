@@ -0,0 +1,269 @@
+package blocks
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ types.WriteListener      = &StreamingService{}
+)
+
+// Sink delivers the blocks of a StreamingService to their destination
+type Sink interface {
+	// Deliver delivers a block, returning once it has been processed by the destination
+	Deliver(block *types.StreamBlock) error
+	io.Closer
+}
+
+// Options are the options of a StreamingService
+type Options struct {
+	// BufferSize is the number of blocks buffered for delivery while the sink processes the
+	// previous ones. Once the buffer is full, committing a block waits for the sink, so that the
+	// node is slowed down to the pace of the sink instead of dropping blocks.
+	BufferSize int
+	// HaltOnError halts the node when a block cannot be delivered. Each block is then delivered
+	// before it is committed, so that a block which fails is streamed again when the node restarts.
+	HaltOnError bool
+	// Decode annotates the state changes with their values decoded by the KV decoders of the
+	// modules, once they are set with SetKVDecoders. The state changes which fail to decode are
+	// left unannotated.
+	Decode bool
+}
+
+// StreamingService is a StreamingService which collects the ABCI messages and the state changes
+// of each block into a StreamBlock, and delivers it to a Sink once the block is committed
+type StreamingService struct {
+	sink      Sink
+	opts      Options
+	listeners map[types.StoreKey][]types.WriteListener
+
+	blockMtx sync.Mutex         // mutex for the block being collected
+	block    *types.StreamBlock // the block being collected
+
+	queueMtx  sync.RWMutex             // mutex for the state of the queue
+	queue     chan delivery            // the blocks awaiting delivery
+	decoders  *types.KVDecoderRegistry // the KV decoders annotating the state changes, if any
+	logger    log.Logger               // logs the state changes which fail to decode
+	streaming bool                     // whether the delivery loop has been started
+	closed    bool                     // whether the queue has been closed
+	done      chan struct{}            // closed once the delivery loop is over
+	errMtx    sync.Mutex               // mutex for the delivery error
+	err       error                    // the latest error of an asynchronous delivery
+}
+
+// delivery is a block queued for delivery
+type delivery struct {
+	block    *types.StreamBlock
+	decoders *types.KVDecoderRegistry // the KV decoders annotating the state changes, if any
+	logger   log.Logger               // logs the state changes which fail to decode
+	result   chan error               // receives the result of the delivery, if it is awaited
+}
+
+// NewStreamingService creates a new StreamingService delivering the blocks, with the state
+// changes of the provided storeKeys, to the sink
+func NewStreamingService(sink Sink, storeKeys []types.StoreKey, opts Options) (*StreamingService, error) {
+	if opts.BufferSize < 0 {
+		return nil, fmt.Errorf("negative buffer size %d", opts.BufferSize)
+	}
+	s := &StreamingService{
+		sink:      sink,
+		opts:      opts,
+		listeners: make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		block:     &types.StreamBlock{},
+		queue:     make(chan delivery, opts.BufferSize),
+		logger:    log.NewNopLogger(),
+		done:      make(chan struct{}),
+	}
+	for _, key := range storeKeys {
+		s.listeners[key] = []types.WriteListener{s}
+	}
+	return s, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return s.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface
+// It adds the state change to the block being collected
+func (s *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	s.blockMtx.Lock()
+	defer s.blockMtx.Unlock()
+	s.block.StateChanges = append(s.block.StateChanges, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.blockMtx.Lock()
+	defer s.blockMtx.Unlock()
+	s.block.RequestBeginBlock = &req
+	s.block.ResponseBeginBlock = &res
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.blockMtx.Lock()
+	defer s.blockMtx.Unlock()
+	s.block.DeliverTxs = append(s.block.DeliverTxs, &types.StreamDeliverTx{Request: &req, Response: &res})
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.blockMtx.Lock()
+	defer s.blockMtx.Unlock()
+	s.block.RequestEndBlock = &req
+	s.block.ResponseEndBlock = &res
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It queues the collected block for delivery, waiting for a slot in the buffer. If the service
+// halts the node on errors, it waits for the delivery of the block and returns its error.
+// Otherwise it returns the error of an earlier delivery, if any.
+func (s *StreamingService) ListenCommit(ctx sdk.Context) error {
+	s.blockMtx.Lock()
+	block := s.block
+	s.block = &types.StreamBlock{}
+	s.blockMtx.Unlock()
+
+	d := delivery{block: block}
+	if s.opts.HaltOnError {
+		d.result = make(chan error, 1)
+	}
+	s.queueMtx.RLock()
+	if s.closed {
+		s.queueMtx.RUnlock()
+		return errors.New("the streaming service is closed")
+	}
+	d.decoders = s.decoders
+	d.logger = s.logger
+	s.queue <- d
+	s.queueMtx.RUnlock()
+	if d.result != nil {
+		return <-d.result
+	}
+
+	s.errMtx.Lock()
+	defer s.errMtx.Unlock()
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// SetKVDecoders sets the KV decoders of the modules, which annotate the state changes of the
+// blocks committed afterwards if the service decodes them, and the logger of the state changes
+// which fail to decode
+func (s *StreamingService) SetKVDecoders(decoders *types.KVDecoderRegistry, logger log.Logger) {
+	if !s.opts.Decode {
+		return
+	}
+	s.queueMtx.Lock()
+	defer s.queueMtx.Unlock()
+	s.decoders = decoders
+	s.logger = logger
+}
+
+// HaltAppOnDeliveryError satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) HaltAppOnDeliveryError() bool {
+	return s.opts.HaltOnError
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up the goroutine which delivers the queued blocks to the sink in order
+// returns an error if it is called twice
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error {
+	s.queueMtx.Lock()
+	defer s.queueMtx.Unlock()
+	if s.streaming || s.closed {
+		return errors.New("`Stream` has already been called or the service is closed")
+	}
+	s.streaming = true
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(s.done)
+		for d := range s.queue {
+			annotate(d)
+			err := s.sink.Deliver(d.block)
+			if err != nil {
+				err = fmt.Errorf("failed to deliver block %d: %w", d.block.GetRequestBeginBlock().GetHeader().Height, err)
+			}
+			if d.result != nil {
+				d.result <- err
+			} else if err != nil {
+				s.errMtx.Lock()
+				s.err = err
+				s.errMtx.Unlock()
+			}
+		}
+	}()
+	return nil
+}
+
+// annotate annotates the state changes of the block with their decoded values
+// The state changes which fail to decode are logged and left unannotated, so that a faulty
+// decoder does not hold up the stream.
+func annotate(d delivery) {
+	if d.decoders == nil {
+		return
+	}
+	for _, pair := range d.block.StateChanges {
+		if err := annotatePair(d.decoders, pair); err != nil {
+			d.logger.Error("failed to annotate a state change", "height", d.block.GetRequestBeginBlock().GetHeader().Height, "err", err)
+		}
+	}
+}
+
+// annotatePair annotates a state change with its decoded value, recovering from a panic of its
+// decoder
+func annotatePair(decoders *types.KVDecoderRegistry, pair *types.StoreKVPair) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while decoding the value of the key %X of store %s: %v", pair.Key, pair.StoreKey, r)
+		}
+		if err != nil {
+			pair.ValueTypeUrl, pair.ValueJson = "", ""
+		}
+	}()
+	return decoders.Annotate(pair)
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It waits for the delivery of the queued blocks, and closes the sink
+func (s *StreamingService) Close() error {
+	s.queueMtx.Lock()
+	if s.closed {
+		s.queueMtx.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.queue)
+	streaming := s.streaming
+	s.queueMtx.Unlock()
+
+	if streaming {
+		<-s.done
+	}
+	return s.sink.Close()
+}
@@ -0,0 +1,354 @@
+package blocks
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	mockKeys      = []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	emptyContext  = sdk.Context{}
+)
+
+// mockSink records the delivered blocks, and fails or blocks on demand
+type mockSink struct {
+	mtx     sync.Mutex
+	blocks  []*types.StreamBlock
+	err     error
+	release chan struct{} // if set, deliveries wait for it
+	closed  bool
+}
+
+func (s *mockSink) Deliver(block *types.StreamBlock) error {
+	if s.release != nil {
+		<-s.release
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func (s *mockSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *mockSink) setErr(err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.err = err
+}
+
+func (s *mockSink) delivered() []*types.StreamBlock {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]*types.StreamBlock{}, s.blocks...)
+}
+
+// streamBlock feeds a block with a transaction and a state change to the service
+func streamBlock(t *testing.T, s *StreamingService, height int64) error {
+	req := abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}
+	require.NoError(t, s.ListenBeginBlock(emptyContext, req, abci.ResponseBeginBlock{}))
+	require.NoError(t, s.OnWrite(mockStoreKey1, []byte("key"), []byte{byte(height)}, false))
+	require.NoError(t, s.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{Tx: []byte{byte(height)}}, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, s.OnWrite(mockStoreKey2, []byte("key"), nil, true))
+	require.NoError(t, s.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	return s.ListenCommit(emptyContext)
+}
+
+func TestStreamingService(t *testing.T) {
+	_, err := NewStreamingService(&mockSink{}, mockKeys, Options{BufferSize: -1})
+	require.Error(t, err)
+
+	sink := &mockSink{}
+	s, err := NewStreamingService(sink, mockKeys, Options{BufferSize: 10})
+	require.NoError(t, err)
+	for _, key := range mockKeys {
+		require.Equal(t, []types.WriteListener{s}, s.Listeners()[key])
+	}
+	require.False(t, s.HaltAppOnDeliveryError())
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	require.Error(t, s.Stream(wg))
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, streamBlock(t, s, height))
+	}
+	require.NoError(t, s.Close())
+	wg.Wait()
+	require.True(t, sink.closed)
+	require.Error(t, s.ListenCommit(emptyContext))
+
+	blocks := sink.delivered()
+	require.Len(t, blocks, 3)
+	for i, block := range blocks {
+		height := int64(i + 1)
+		require.Equal(t, height, block.RequestBeginBlock.Header.Height)
+		require.Len(t, block.DeliverTxs, 1)
+		require.Equal(t, []byte{byte(height)}, block.DeliverTxs[0].Request.Tx)
+		require.Equal(t, uint32(1), block.DeliverTxs[0].Response.Code)
+		require.Equal(t, height, block.RequestEndBlock.Height)
+		require.Equal(t, []*types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: []byte("key"), Value: []byte{byte(height)}},
+			{StoreKey: mockStoreKey2.Name(), Key: []byte("key"), Delete: true},
+		}, block.StateChanges)
+	}
+}
+
+func TestStreamingServiceErrors(t *testing.T) {
+	sink := &mockSink{}
+	s, err := NewStreamingService(sink, mockKeys, Options{})
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+
+	// without halting, the error of a delivery is returned by the next commit
+	sink.setErr(errors.New("sink failure"))
+	height := int64(0)
+	require.Eventually(t, func() bool {
+		height++
+		err = streamBlock(t, s, height)
+		return err != nil
+	}, time.Second, time.Millisecond)
+	require.EqualError(t, err, "failed to deliver block 1: sink failure")
+	require.NoError(t, s.Close())
+	wg.Wait()
+
+	// when halting, the error of the delivery of the block is returned by its commit
+	sink = &mockSink{}
+	s, err = NewStreamingService(sink, mockKeys, Options{HaltOnError: true})
+	require.NoError(t, err)
+	require.True(t, s.HaltAppOnDeliveryError())
+	require.NoError(t, s.Stream(wg))
+	require.NoError(t, streamBlock(t, s, 1))
+	require.Len(t, sink.delivered(), 1)
+	sink.setErr(errors.New("sink failure"))
+	err = streamBlock(t, s, 2)
+	require.EqualError(t, err, "failed to deliver block 2: sink failure")
+	require.NoError(t, s.Close())
+	wg.Wait()
+}
+
+func TestStreamingServiceBackpressure(t *testing.T) {
+	sink := &mockSink{release: make(chan struct{})}
+	s, err := NewStreamingService(sink, mockKeys, Options{BufferSize: 1})
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+
+	// the first block is being delivered and the second one is buffered
+	require.NoError(t, streamBlock(t, s, 1))
+	require.NoError(t, streamBlock(t, s, 2))
+
+	// the third commit waits for the sink
+	committed := make(chan error)
+	go func() { committed <- streamBlock(t, s, 3) }()
+	select {
+	case <-committed:
+		t.Fatal("the commit did not wait for the sink")
+	case <-time.After(50 * time.Millisecond):
+	}
+	sink.release <- struct{}{}
+	require.NoError(t, <-committed)
+
+	close(sink.release)
+	require.NoError(t, s.Close())
+	wg.Wait()
+	require.Len(t, sink.delivered(), 3)
+}
+
+func TestStreamingServiceDecoding(t *testing.T) {
+	decoders := types.NewKVDecoderRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	decoders.Register(mockStoreKey1.Name(), []byte("key"), func(key, value []byte) (proto.Message, error) {
+		switch value[0] {
+		case 3:
+			return nil, errors.New("invalid value")
+		case 4:
+			panic("invalid value")
+		}
+		return &gogotypes.UInt32Value{Value: uint32(value[0])}, nil
+	})
+
+	// the state changes are not decoded unless the service is configured to
+	sink := &mockSink{}
+	s, err := NewStreamingService(sink, mockKeys, Options{HaltOnError: true})
+	require.NoError(t, err)
+	s.SetKVDecoders(decoders, log.NewNopLogger())
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	require.NoError(t, streamBlock(t, s, 1))
+	require.NoError(t, s.Close())
+	require.Empty(t, sink.delivered()[0].StateChanges[0].ValueTypeUrl)
+
+	sink = &mockSink{}
+	s, err = NewStreamingService(sink, mockKeys, Options{HaltOnError: true, Decode: true})
+	require.NoError(t, err)
+	require.NoError(t, s.Stream(wg))
+	require.NoError(t, streamBlock(t, s, 1))
+	logger := &mockLogger{Logger: log.NewNopLogger()}
+	s.SetKVDecoders(decoders, logger)
+	require.NoError(t, streamBlock(t, s, 2))
+	// the values which cannot be decoded, or whose decoder panics, are logged and left raw
+	require.NoError(t, streamBlock(t, s, 3))
+	require.NoError(t, streamBlock(t, s, 4))
+	require.NoError(t, s.Close())
+	wg.Wait()
+
+	blocks := sink.delivered()
+	require.Len(t, blocks, 4)
+	require.Empty(t, blocks[0].StateChanges[0].ValueTypeUrl)
+	pairs := blocks[1].StateChanges
+	require.Equal(t, "/google.protobuf.UInt32Value", pairs[0].ValueTypeUrl)
+	require.Equal(t, "2", pairs[0].ValueJson)
+	// the deleted pair is not decoded
+	require.Empty(t, pairs[1].ValueTypeUrl)
+	for _, block := range blocks[2:] {
+		require.Empty(t, block.StateChanges[0].ValueTypeUrl)
+		require.Empty(t, block.StateChanges[0].ValueJson)
+	}
+	require.Equal(t, []string{
+		"failed to decode the value of the key 6B6579 of store mockStore1: invalid value",
+		"panic while decoding the value of the key 6B6579 of store mockStore1: invalid value",
+	}, logger.errs)
+}
+
+// mockLogger records the errors it logs
+type mockLogger struct {
+	log.Logger
+	errs []string
+}
+
+func (l *mockLogger) Error(msg string, keyVals ...interface{}) {
+	for i := 1; i < len(keyVals); i += 2 {
+		if err, ok := keyVals[i].(error); ok {
+			l.errs = append(l.errs, err.Error())
+		}
+	}
+}
+
+func TestNDJSONSink(t *testing.T) {
+	buf := new(bytes.Buffer)
+	s, err := NewStreamingService(NewNDJSONSink(buf), mockKeys, Options{HaltOnError: true})
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	for height := int64(1); height <= 2; height++ {
+		require.NoError(t, streamBlock(t, s, height))
+	}
+	require.NoError(t, s.Close())
+	wg.Wait()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	scanner := bufio.NewScanner(buf)
+	var height int64
+	for scanner.Scan() {
+		height++
+		var block types.StreamBlock
+		require.NoError(t, cdc.UnmarshalJSON(scanner.Bytes(), &block))
+		require.Equal(t, height, block.RequestBeginBlock.Header.Height)
+		require.Len(t, block.StateChanges, 2)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, int64(2), height)
+}
+
+// mockListener is a StreamingListener gRPC service recording the received blocks
+type mockListener struct {
+	types.UnimplementedStreamingListenerServer
+	mtx    sync.Mutex
+	blocks []*types.StreamBlock
+	ack    int64 // if set, the height acknowledged instead of the one of the block
+}
+
+func (l *mockListener) ListenBlocks(stream types.StreamingListener_ListenBlocksServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		l.mtx.Lock()
+		l.blocks = append(l.blocks, req.Block)
+		ack := l.ack
+		l.mtx.Unlock()
+		if ack == 0 {
+			ack = req.Block.RequestBeginBlock.Header.Height
+		}
+		if err := stream.Send(&types.ListenBlocksResponse{Height: ack}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestGRPCSink(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	listener := &mockListener{}
+	types.RegisterStreamingListenerServer(server, listener)
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	sink, err := NewGRPCSink(lis.Addr().String())
+	require.NoError(t, err)
+	s, err := NewStreamingService(sink, mockKeys, Options{HaltOnError: true})
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	require.NoError(t, streamBlock(t, s, 1))
+	require.NoError(t, streamBlock(t, s, 2))
+
+	// a wrong acknowledgement fails the delivery, and the stream is opened again afterwards
+	listener.mtx.Lock()
+	listener.ack = 100
+	listener.mtx.Unlock()
+	err = streamBlock(t, s, 3)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "acknowledged height 100 instead of 3"))
+	listener.mtx.Lock()
+	listener.ack = 0
+	listener.mtx.Unlock()
+	require.NoError(t, streamBlock(t, s, 4))
+
+	require.NoError(t, s.Close())
+	wg.Wait()
+
+	listener.mtx.Lock()
+	defer listener.mtx.Unlock()
+	require.Len(t, listener.blocks, 4)
+	for i, height := range []int64{1, 2, 3, 4} {
+		require.Equal(t, height, listener.blocks[i].RequestBeginBlock.Header.Height)
+		require.Len(t, listener.blocks[i].DeliverTxs, 1)
+	}
+}
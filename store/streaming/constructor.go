@@ -2,18 +2,24 @@ package streaming
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/blocks"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
+// DefaultBufferSize is the default number of blocks buffered for delivery by the block streaming services
+const DefaultBufferSize = 100
+
 // ServiceConstructor is used to construct a streaming service
 type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error)
 
@@ -23,7 +29,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
-	// add more in the future
+	GRPC
+	Stdout
 )
 
 // ServiceTypeFromString returns the streaming.ServiceType corresponding to the provided name
@@ -31,6 +38,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc":
+		return GRPC
+	case "stdout":
+		return Stdout
 	default:
 		return Unknown
 	}
@@ -41,6 +52,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
+	case Stdout:
+		return "stdout"
 	default:
 		return "unknown"
 	}
@@ -48,11 +63,39 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	GRPC:   NewGRPCStreamingService,
+	Stdout: NewStdoutStreamingService,
+}
+
+// pluginConstructors is a mapping of the names of the streaming service plugins registered by the
+// App to their streaming.ServiceConstructors
+var pluginConstructors = map[string]ServiceConstructor{}
+
+// RegisterServiceConstructor registers the streaming.ServiceConstructor of a streaming service
+// plugin under the name by which it is listed in store.streamers, and configured in
+// streamers.<name>. It must be called before the streaming services are loaded, e.g. from an init
+// function, and the name must not be the one of a built-in streaming service.
+func RegisterServiceConstructor(name string, constructor ServiceConstructor) error {
+	if ServiceTypeFromString(name) != Unknown {
+		return fmt.Errorf("streaming service name %s is reserved for a built-in streaming service", name)
+	}
+	if _, ok := pluginConstructors[name]; ok {
+		return fmt.Errorf("streaming service %s is already registered", name)
+	}
+	if constructor == nil {
+		return fmt.Errorf("nil constructor for streaming service %s", name)
+	}
+	pluginConstructors[name] = constructor
+	return nil
 }
 
-// NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
+// NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name,
+// of either a built-in streaming service or a registered plugin
 func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	if constructor, ok := pluginConstructors[name]; ok {
+		return constructor, nil
+	}
 	ssType := ServiceTypeFromString(name)
 	if ssType == Unknown {
 		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
@@ -67,7 +110,61 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+	haltOnError := cast.ToBool(opts.Get("streamers.file.halt_on_error"))
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, haltOnError)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a block
+// streaming service which pushes the blocks to the StreamingListener gRPC service at streamers.grpc.address
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get("streamers.grpc.address"))
+	if address == "" {
+		return nil, fmt.Errorf("streamers.grpc.address must be set")
+	}
+	sink, err := blocks.NewGRPCSink(address)
+	if err != nil {
+		return nil, err
+	}
+	return blocks.NewStreamingService(sink, keys, BlocksOptions(opts, "grpc"))
+}
+
+// NewStdoutStreamingService is the streaming.ServiceConstructor function for creating a block
+// streaming service which writes the blocks out to the standard output, as newline-delimited JSON
+func NewStdoutStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	return blocks.NewStreamingService(blocks.NewNDJSONSink(os.Stdout), keys, BlocksOptions(opts, "stdout"))
+}
+
+// BlocksOptions returns the options of the block streaming service of the provided name, from
+// streamers.<name>.buffer_size, streamers.<name>.halt_on_error and streamers.<name>.decode. It
+// can be used by the streaming service plugins which deliver the blocks to a blocks.Sink.
+func BlocksOptions(opts serverTypes.AppOptions, name string) blocks.Options {
+	bufferSize := DefaultBufferSize
+	if size := opts.Get(fmt.Sprintf("streamers.%s.buffer_size", name)); size != nil {
+		bufferSize = cast.ToInt(size)
+	}
+	return blocks.Options{
+		BufferSize:  bufferSize,
+		HaltOnError: cast.ToBool(opts.Get(fmt.Sprintf("streamers.%s.halt_on_error", name))),
+		Decode:      cast.ToBool(opts.Get(fmt.Sprintf("streamers.%s.decode", name))),
+	}
+}
+
+// KVDecodingService is implemented by the streaming services which can annotate the state changes
+// they stream with their values decoded by the KV decoders of the modules
+type KVDecodingService interface {
+	SetKVDecoders(decoders *types.KVDecoderRegistry, logger log.Logger)
+}
+
+// SetKVDecoders sets the KV decoders of the modules into the streaming services which implement
+// KVDecodingService. It must be called before the node starts, once the modules have registered
+// their decoders, e.g. with module.Manager.RegisterKVDecoders. The state changes which fail to
+// decode are logged to the logger.
+func SetKVDecoders(services []baseapp.StreamingService, decoders *types.KVDecoderRegistry, logger log.Logger) {
+	for _, service := range services {
+		if service, ok := service.(KVDecodingService); ok {
+			service.SetKVDecoders(decoders, logger)
+		}
+	}
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
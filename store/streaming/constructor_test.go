@@ -1,14 +1,19 @@
 package streaming_test
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/streaming/blocks"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +53,82 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestBlocksStreamingServiceConstructors(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+	serv, err := constructor(mapOptions{"streamers.grpc.address": "127.0.0.1:9999"}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &blocks.StreamingService{}, serv)
+	require.False(t, serv.HaltAppOnDeliveryError())
+	require.Nil(t, serv.Close())
+
+	constructor, err = streaming.NewServiceConstructor("stdout")
+	require.Nil(t, err)
+	serv, err = constructor(mapOptions{"streamers.stdout.halt_on_error": true}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &blocks.StreamingService{}, serv)
+	require.True(t, serv.HaltAppOnDeliveryError())
+
+	require.Equal(t, blocks.Options{BufferSize: streaming.DefaultBufferSize}, streaming.BlocksOptions(mockOptions, "stdout"))
+	require.Equal(t, blocks.Options{BufferSize: 0, HaltOnError: true, Decode: true}, streaming.BlocksOptions(mapOptions{
+		"streamers.stdout.buffer_size":   0,
+		"streamers.stdout.halt_on_error": true,
+		"streamers.stdout.decode":        true,
+	}, "stdout"))
+}
+
+func TestSetKVDecoders(t *testing.T) {
+	sink := &recordingSink{}
+	decodingService, err := blocks.NewStreamingService(sink, mockKeys, blocks.Options{HaltOnError: true, Decode: true})
+	require.Nil(t, err)
+	fileService, err := file.NewStreamingService(t.TempDir(), "", mockKeys, testMarshaller, false)
+	require.Nil(t, err)
+
+	decoders := types.NewKVDecoderRegistry(testMarshaller)
+	decoders.Register(mockKeys[0].Name(), nil, func(key, value []byte) (proto.Message, error) {
+		return &gogotypes.StringValue{Value: string(value)}, nil
+	})
+	streaming.SetKVDecoders([]baseapp.StreamingService{fileService, decodingService}, decoders, log.NewNopLogger())
+
+	require.Nil(t, decodingService.Stream(new(sync.WaitGroup)))
+	require.Nil(t, decodingService.OnWrite(mockKeys[0], []byte("key"), []byte("value"), false))
+	require.Nil(t, decodingService.ListenCommit(sdk.Context{}))
+	require.Nil(t, decodingService.Close())
+	require.Equal(t, "/google.protobuf.StringValue", sink.blocks[0].StateChanges[0].ValueTypeUrl)
+	require.Equal(t, `"value"`, sink.blocks[0].StateChanges[0].ValueJson)
+}
+
+type recordingSink struct {
+	blocks []*types.StreamBlock
+}
+
+func (s *recordingSink) Deliver(block *types.StreamBlock) error {
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func TestRegisterServiceConstructor(t *testing.T) {
+	var called bool
+	plugin := func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+		called = true
+		return file.NewStreamingService("", "", keys, marshaller, false)
+	}
+	require.Error(t, streaming.RegisterServiceConstructor("file", plugin))
+	require.Error(t, streaming.RegisterServiceConstructor("mockPlugin", nil))
+	require.Nil(t, streaming.RegisterServiceConstructor("mockPlugin", plugin))
+	require.Error(t, streaming.RegisterServiceConstructor("mockPlugin", plugin))
+
+	constructor, err := streaming.NewServiceConstructor("mockPlugin")
+	require.Nil(t, err)
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -97,3 +178,7 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return nil
 	}
 }
+
+type mapOptions map[string]interface{}
+
+func (o mapOptions) Get(key string) interface{} { return o[key] }
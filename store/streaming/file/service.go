@@ -22,15 +22,39 @@ var _ baseapp.StreamingService = &StreamingService{}
 // StreamingService is a concrete implementation of StreamingService that writes state changes out to files
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
-	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
-	stateCache         [][]byte                                 // cache the protobuf binary encoded StoreKVPairs in the order they are received
-	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	stateCache         *stateCache                              // cache the protobuf binary encoded StoreKVPairs in the order they are received
 	currentBlockNumber int64                                    // the current block number
 	currentTxIndex     int64                                    // the index of the current tx
-	quitChan           chan struct{}                            // channel to synchronize closure
+	haltOnError        bool                                     // whether the node halts when a file cannot be written
+	streaming          bool                                     // whether Stream has been called
+}
+
+// stateCache is the io.Writer of the WriteListeners, which caches the length-prefixed binary
+// encoded KV pairs synchronously, so that the ABCI hooks find all the state changes written
+// before them
+type stateCache struct {
+	mtx   sync.Mutex
+	pairs [][]byte
+}
+
+// Write satisfies io.Writer
+func (sc *stateCache) Write(b []byte) (int, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+	sc.pairs = append(sc.pairs, b)
+	return len(b), nil
+}
+
+// pop returns the cached KV pairs and resets the cache
+func (sc *stateCache) pop() [][]byte {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+	pairs := sc.pairs
+	sc.pairs = nil
+	return pairs
 }
 
 // IntermediateWriter is used so that we do not need to update the underlying io.Writer
@@ -53,10 +77,10 @@ func (iw *IntermediateWriter) Write(b []byte) (int, error) {
 }
 
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
-func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
-	listenChan := make(chan []byte)
-	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
+// If haltOnError is true, the node halts when a file cannot be written.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec, haltOnError bool) (*StreamingService, error) {
+	cache := new(stateCache)
+	listener := types.NewStoreKVPairWriteListener(cache, c)
 	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
 	// in this case, we are using the same listener for each Store
 	for _, key := range storeKeys {
@@ -68,13 +92,12 @@ func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey
 		return nil, err
 	}
 	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
-		filePrefix:     filePrefix,
-		writeDir:       writeDir,
-		codec:          c,
-		stateCache:     make([][]byte, 0),
-		stateCacheLock: new(sync.Mutex),
+		listeners:   listeners,
+		filePrefix:  filePrefix,
+		writeDir:    writeDir,
+		codec:       c,
+		stateCache:  cache,
+		haltOnError: haltOnError,
 	}, nil
 }
 
@@ -103,17 +126,11 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 		return err
 	}
 	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
+	for _, stateChange := range fss.stateCache.pop() {
 		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
 			return err
 		}
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
 	// write res to file
 	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
 	if err != nil {
@@ -133,7 +150,7 @@ func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os
 	if fss.filePrefix != "" {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
@@ -154,17 +171,11 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 		return err
 	}
 	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
+	for _, stateChange := range fss.stateCache.pop() {
 		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
 			return err
 		}
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
 	// write res to file
 	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
 	if err != nil {
@@ -183,7 +194,7 @@ func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
 	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
 }
 
 // ListenEndBlock satisfies the baseapp.ABCIListener interface
@@ -204,17 +215,11 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 		return err
 	}
 	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
+	for _, stateChange := range fss.stateCache.pop() {
 		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
 			return err
 		}
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
 	// write res to file
 	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
 	if err != nil {
@@ -232,39 +237,57 @@ func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
 	if fss.filePrefix != "" {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the state changes written when the block is committed out to a file as described
+// in the above the naming schema
+func (fss *StreamingService) ListenCommit(ctx sdk.Context) error {
+	// generate the new file
+	dstFile, err := fss.openCommitFile()
+	if err != nil {
+		return err
+	}
+	// write all state changes cached for this stage to file
+	for _, stateChange := range fss.stateCache.pop() {
+		if _, err = dstFile.Write(stateChange); err != nil {
+			dstFile.Close()
+			return err
+		}
+	}
+	// close file
+	return dstFile.Close()
+}
+
+func (fss *StreamingService) openCommitFile() (*os.File, error) {
+	fileName := fmt.Sprintf("block-%d-commit", fss.currentBlockNumber)
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+}
+
+// HaltAppOnDeliveryError satisfies the baseapp.ABCIListener interface
+// It returns true if the node halts when a file cannot be written
+func (fss *StreamingService) HaltAppOnDeliveryError() bool {
+	return fss.haltOnError
 }
 
 // Stream satisfies the baseapp.StreamingService interface
-// It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
-// and caches them in the order they were received
+// The KV pairs are cached synchronously by the listeners, so there is no background loop
 // returns an error if it is called twice
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
-	if fss.quitChan != nil {
+	if fss.streaming {
 		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
 	}
-	fss.quitChan = make(chan struct{})
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for {
-			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
-				return
-			case by := <-fss.srcChan:
-				fss.stateCacheLock.Lock()
-				fss.stateCache = append(fss.stateCache, by)
-				fss.stateCacheLock.Unlock()
-			}
-		}
-	}()
+	fss.streaming = true
 	return nil
 }
 
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
 func (fss *StreamingService) Close() error {
-	close(fss.quitChan)
+	fss.streaming = false
 	return nil
 }
 
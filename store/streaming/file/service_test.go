@@ -133,7 +133,7 @@ func TestFileStreamingService(t *testing.T) {
 	defer os.RemoveAll(testDir)
 
 	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
-	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller)
+	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller, false)
 	require.Nil(t, err)
 	require.IsType(t, &StreamingService{}, testStreamingService)
 	require.Equal(t, testPrefix, testStreamingService.filePrefix)
@@ -147,6 +147,7 @@ func TestFileStreamingService(t *testing.T) {
 	testListenDeliverTx1(t)
 	testListenDeliverTx2(t)
 	testListenEndBlock(t)
+	testListenCommit(t)
 	testStreamingService.Close()
 	wg.Wait()
 }
@@ -367,6 +368,40 @@ func testListenEndBlock(t *testing.T) {
 	require.Equal(t, expectedEndBlockResBytes, segments[4])
 }
 
+func testListenCommit(t *testing.T) {
+	// write state changes
+	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
+	testListener2.OnWrite(mockStoreKey2, mockKey2, nil, true)
+
+	// expected KV pairs
+	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey1.Name(),
+		Key:      mockKey1,
+		Value:    mockValue1,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey2.Name(),
+		Key:      mockKey2,
+		Delete:   true,
+	})
+	require.Nil(t, err)
+
+	err = testStreamingService.ListenCommit(emptyContext)
+	require.Nil(t, err)
+
+	// load the file, checking that it was created with the expected name
+	fileName := fmt.Sprintf("%s-block-%d-commit", testPrefix, testBeginBlockReq.GetHeader().Height)
+	fileBytes, err := readInFile(fileName)
+	require.Nil(t, err)
+
+	// segment the file into the separate gRPC messages and check the correctness of each
+	segments, err := segmentBytes(fileBytes)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{expectedKVPair1, expectedKVPair2}, segments)
+}
+
 func readInFile(name string) ([]byte, error) {
 	path := filepath.Join(testDir, name)
 	return ioutil.ReadFile(path)
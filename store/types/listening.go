@@ -1,7 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
 	"io"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -45,3 +50,96 @@ func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value
 	}
 	return nil
 }
+
+// KVDecoder decodes the value of a KV pair into the protobuf message it encodes. It receives the
+// full key of the pair, which starts with the prefix the decoder is registered for.
+type KVDecoder func(key, value []byte) (proto.Message, error)
+
+// NewProtoKVDecoder returns a KVDecoder which unmarshals the values into the protobuf messages
+// returned by newMsg
+func NewProtoKVDecoder(cdc codec.BinaryCodec, newMsg func() codec.ProtoMarshaler) KVDecoder {
+	return func(_, value []byte) (proto.Message, error) {
+		msg := newMsg()
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+}
+
+// KVDecoderRegistry holds the KVDecoders registered by the modules for the key prefixes of their
+// stores. The streaming services use it to annotate the streamed StoreKVPairs with the type and the
+// JSON encoding of their values, so that the consumers of the streams do not depend on the key
+// layouts and the value encodings of the modules.
+// The registry must be filled before the blocks are streamed, it is not safe for concurrent writes.
+type KVDecoderRegistry struct {
+	cdc      codec.JSONCodec
+	decoders map[string][]prefixDecoder // by store key name, by decreasing prefix length
+}
+
+// prefixDecoder is a KVDecoder with the key prefix it is registered for
+type prefixDecoder struct {
+	prefix  []byte
+	decoder KVDecoder
+}
+
+// NewKVDecoderRegistry creates a new KVDecoderRegistry encoding the decoded values to JSON with cdc
+func NewKVDecoderRegistry(cdc codec.JSONCodec) *KVDecoderRegistry {
+	return &KVDecoderRegistry{
+		cdc:      cdc,
+		decoders: make(map[string][]prefixDecoder),
+	}
+}
+
+// Register registers the decoder of the values of the KV pairs of the store named storeKey whose
+// keys start with prefix. A pair is decoded by the decoder of the longest prefix of its key.
+// It panics if a decoder is already registered for the same store and prefix.
+func (r *KVDecoderRegistry) Register(storeKey string, prefix []byte, decoder KVDecoder) {
+	if decoder == nil {
+		panic(fmt.Sprintf("nil KV decoder for the prefix %X of store %s", prefix, storeKey))
+	}
+	decoders := r.decoders[storeKey]
+	for _, d := range decoders {
+		if bytes.Equal(d.prefix, prefix) {
+			panic(fmt.Sprintf("a KV decoder is already registered for the prefix %X of store %s", prefix, storeKey))
+		}
+	}
+	decoders = append(decoders, prefixDecoder{prefix: prefix, decoder: decoder})
+	sort.SliceStable(decoders, func(i, j int) bool {
+		return len(decoders[i].prefix) > len(decoders[j].prefix)
+	})
+	r.decoders[storeKey] = decoders
+}
+
+// Decode decodes the value of a KV pair of the store named storeKey. It returns nil if no decoder
+// is registered for the key.
+func (r *KVDecoderRegistry) Decode(storeKey string, key, value []byte) (proto.Message, error) {
+	for _, d := range r.decoders[storeKey] {
+		if bytes.HasPrefix(key, d.prefix) {
+			return d.decoder(key, value)
+		}
+	}
+	return nil, nil
+}
+
+// Annotate sets the type URL and the JSON encoding of the decoded value of the pair. The deleted
+// pairs, and the pairs which have no decoder, are left untouched.
+func (r *KVDecoderRegistry) Annotate(pair *StoreKVPair) error {
+	if pair.Delete {
+		return nil
+	}
+	msg, err := r.Decode(pair.StoreKey, pair.Key, pair.Value)
+	if err != nil {
+		return fmt.Errorf("failed to decode the value of the key %X of store %s: %w", pair.Key, pair.StoreKey, err)
+	}
+	if msg == nil {
+		return nil
+	}
+	bz, err := r.cdc.MarshalJSON(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode the value of the key %X of store %s: %w", pair.Key, pair.StoreKey, err)
+	}
+	pair.ValueTypeUrl = "/" + proto.MessageName(msg)
+	pair.ValueJson = string(bz)
+	return nil
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -64,3 +67,44 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestKVDecoderRegistry(t *testing.T) {
+	registry := NewKVDecoderRegistry(codec.NewProtoCodec(types.NewInterfaceRegistry()))
+	stringDecoder := func(key, value []byte) (proto.Message, error) {
+		return &gogotypes.StringValue{Value: string(value)}, nil
+	}
+	registry.Register("store1", []byte{0x01}, stringDecoder)
+	registry.Register("store1", []byte{0x01, 0x02}, func(key, value []byte) (proto.Message, error) {
+		if len(value) == 0 {
+			return nil, errors.New("empty value")
+		}
+		return &gogotypes.UInt64Value{Value: uint64(value[0])}, nil
+	})
+	require.Panics(t, func() { registry.Register("store1", []byte{0x01}, stringDecoder) })
+	require.Panics(t, func() { registry.Register("store2", []byte{0x01}, nil) })
+
+	// the decoder of the longest prefix is used
+	pair := &StoreKVPair{StoreKey: "store1", Key: []byte{0x01, 0x03}, Value: []byte("value")}
+	require.NoError(t, registry.Annotate(pair))
+	require.Equal(t, "/google.protobuf.StringValue", pair.ValueTypeUrl)
+	require.Equal(t, `"value"`, pair.ValueJson)
+
+	pair = &StoreKVPair{StoreKey: "store1", Key: []byte{0x01, 0x02, 0x03}, Value: []byte{7}}
+	require.NoError(t, registry.Annotate(pair))
+	require.Equal(t, "/google.protobuf.UInt64Value", pair.ValueTypeUrl)
+	require.Equal(t, `"7"`, pair.ValueJson)
+
+	pair = &StoreKVPair{StoreKey: "store1", Key: []byte{0x01, 0x02}}
+	require.Error(t, registry.Annotate(pair))
+
+	// deleted pairs and pairs without decoder are left untouched
+	for _, pair := range []*StoreKVPair{
+		{StoreKey: "store1", Key: []byte{0x01}, Delete: true},
+		{StoreKey: "store1", Key: []byte{0x02}, Value: []byte("value")},
+		{StoreKey: "store2", Key: []byte{0x01}, Value: []byte("value")},
+	} {
+		require.NoError(t, registry.Annotate(pair))
+		require.Empty(t, pair.ValueTypeUrl)
+		require.Empty(t, pair.ValueJson)
+	}
+}
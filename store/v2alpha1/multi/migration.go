@@ -56,6 +56,9 @@ func MigrateFromV1(rootMultiStore *v1Store.Store, store2db dbm.Connection, store
 			// set the iavl key,values into smt node
 			subStore.Set(iterator.Key(), iterator.Value())
 		}
+		if err := iterator.Close(); err != nil {
+			return nil, err
+		}
 	}
 
 	// commit the all key/values from iavl to smt tree (SMT Store)
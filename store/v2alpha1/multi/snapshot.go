@@ -129,7 +129,7 @@ loop:
 				receivedStoreSchema[string(sKey)] = types.StoreTypePersistent
 			}
 
-			if !rs.schema.equal(receivedStoreSchema) {
+			if !rs.schema.persistent().equal(receivedStoreSchema) {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received schema does not match app schema")
 			}
 
@@ -99,6 +99,11 @@ type Store struct {
 
 	PersistentCache types.MultiStorePersistentCache
 	substoreCache   map[string]*substore
+
+	// Versions kept for state sync snapshots, see SetSnapshotInterval and PruneSnapshotHeight
+	pruneMtx         sync.Mutex
+	snapshotInterval uint64
+	snapshotHeights  []int64
 }
 
 type substore struct {
@@ -175,6 +180,17 @@ func validSubStoreType(sst types.StoreType) bool {
 	}
 }
 
+// Returns the persistent substores of the schema
+func (ss StoreSchema) persistent() StoreSchema {
+	ret := StoreSchema{}
+	for key, typ := range ss {
+		if typ == types.StoreTypePersistent {
+			ret[key] = typ
+		}
+	}
+	return ret
+}
+
 // Returns true iff both schema maps match exactly (including mem/tran stores)
 func (ss StoreSchema) equal(that StoreSchema) bool {
 	if len(ss) != len(that) {
@@ -288,18 +304,19 @@ func NewStore(db dbm.Connection, opts StoreConfig) (ret *Store, err error) {
 	if err != nil {
 		return
 	}
-	// If the loaded schema is empty (for new store), just copy the config schema;
-	// Otherwise, verify it is identical to the config schema
-	if len(reg.StoreSchema) == 0 {
-		for k, v := range opts.StoreSchema {
-			reg.StoreSchema[k] = v
-		}
-		reg.reserved = make([]string, len(opts.reserved))
-		copy(reg.reserved, opts.reserved)
-	} else if !reg.equal(opts.StoreSchema) {
+	// If the loaded schema is not empty (for existing store), verify its persistent substores are
+	// identical to the config ones. Memory and transient substores hold no saved data, so they are
+	// always copied from the config schema along with the persistent ones.
+	if len(reg.StoreSchema) != 0 && !reg.persistent().equal(opts.persistent()) {
 		err = errors.New("loaded schema does not match configured schema")
 		return
 	}
+	reg.StoreSchema = StoreSchema{}
+	for k, v := range opts.StoreSchema {
+		reg.StoreSchema[k] = v
+	}
+	reg.reserved = make([]string, len(opts.reserved))
+	copy(reg.reserved, opts.reserved)
 
 	// Apply migrations, then clear old schema and write the new one
 	for _, upgrades := range opts.Upgrades {
@@ -545,20 +562,43 @@ func (s *Store) Commit() types.CommitID {
 		// The range of newly prunable versions
 		lastPrunable := previous - int64(s.Pruning.KeepRecent)
 		firstPrunable := lastPrunable - int64(s.Pruning.Interval)
+		if firstPrunable < 1 {
+			firstPrunable = 1
+		}
 
+		s.pruneMtx.Lock()
 		for version := firstPrunable; version <= lastPrunable; version++ {
-			s.stateDB.DeleteVersion(uint64(version))
-
-			if s.StateCommitmentDB != nil {
-				s.StateCommitmentDB.DeleteVersion(uint64(version))
+			// Snapshot heights are kept until the snapshot is done, see PruneSnapshotHeight
+			if s.snapshotInterval != 0 && uint64(version)%s.snapshotInterval == 0 {
+				continue
+			}
+			s.deleteVersion(version)
+		}
+		var kept []int64
+		for _, height := range s.snapshotHeights {
+			if height <= lastPrunable {
+				s.deleteVersion(height)
+			} else {
+				kept = append(kept, height)
 			}
 		}
+		s.snapshotHeights = kept
+		s.pruneMtx.Unlock()
 	}
 
 	s.tran.Commit()
 	return *cid
 }
 
+// Deletes a version from the backing DBs, if it exists.
+func (s *Store) deleteVersion(version int64) {
+	s.stateDB.DeleteVersion(uint64(version))
+
+	if s.StateCommitmentDB != nil {
+		s.StateCommitmentDB.DeleteVersion(uint64(version))
+	}
+}
+
 func (s *Store) getMerkleRoots() (ret map[string][]byte, err error) {
 	ret = map[string][]byte{}
 	for key := range s.schema {
@@ -694,13 +734,20 @@ func (s *Store) CacheMultiStore() types.CacheMultiStore {
 // If other strategy, this height is persisted until it is
 // less than <current height> - KeepRecent and <current height> % Interval == 0
 func (s *Store) PruneSnapshotHeight(height int64) {
-	panic("not implemented")
+	if s.Pruning.Interval == 0 {
+		return
+	}
+	s.pruneMtx.Lock()
+	defer s.pruneMtx.Unlock()
+	s.snapshotHeights = append(s.snapshotHeights, height)
 }
 
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (s *Store) SetSnapshotInterval(snapshotInterval uint64) {
-	panic("not implemented")
+	s.pruneMtx.Lock()
+	defer s.pruneMtx.Unlock()
+	s.snapshotInterval = snapshotInterval
 }
 
 // parsePath expects a format like /<storeName>[/<subpath>]
@@ -868,9 +915,9 @@ func (pr *prefixRegistry) RegisterSubstore(key string, typ types.StoreType) erro
 	if i < len(pr.reserved) && strings.HasPrefix(pr.reserved[i], key) {
 		return fmt.Errorf("prefix conflict: '%v' exists, cannot add '%v'", pr.reserved[i], key)
 	}
-	reserved := pr.reserved[:i]
-	reserved = append(reserved, key)
-	pr.reserved = append(reserved, pr.reserved[i:]...)
+	pr.reserved = append(pr.reserved, "")
+	copy(pr.reserved[i+1:], pr.reserved[i:])
+	pr.reserved[i] = key
 	pr.StoreSchema[key] = typ
 	return nil
 }
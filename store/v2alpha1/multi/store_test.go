@@ -393,6 +393,38 @@ func sliceToSet(slice []uint64) map[uint64]struct{} {
 	return res
 }
 
+func TestPruningSnapshotHeights(t *testing.T) {
+	db := memdb.NewDB()
+	opts := simpleStoreConfig(t)
+	opts.Pruning = pruningtypes.NewCustomPruningOptions(2, 10)
+	store, err := NewStore(db, opts)
+	require.NoError(t, err)
+	store.SetSnapshotInterval(4)
+
+	requireKept := func(latest uint64, kept ...uint64) {
+		versions, err := db.Versions()
+		require.NoError(t, err)
+		keptMap := sliceToSet(kept)
+		for v := uint64(1); v <= latest; v++ {
+			_, has := keptMap[v]
+			require.Equal(t, has, versions.Exists(v), "Version = %v", v)
+		}
+	}
+
+	for i := byte(1); i <= 10; i++ {
+		store.GetKVStore(skey_1).Set([]byte{i}, []byte{i})
+		store.Commit()
+	}
+	// The snapshot height 4 is kept until the snapshot is done
+	requireKept(10, 4, 8, 9, 10)
+	store.PruneSnapshotHeight(4)
+	for i := byte(11); i <= 20; i++ {
+		store.GetKVStore(skey_1).Set([]byte{i}, []byte{i})
+		store.Commit()
+	}
+	requireKept(20, 8, 12, 16, 18, 19, 20)
+}
+
 func TestPruning(t *testing.T) {
 	// Save versions up to 10 and verify pruning at final commit
 	testCases := []struct {
@@ -654,6 +686,12 @@ func TestStoreConfig(t *testing.T) {
 	require.Error(t, opts.RegisterSubstore(skey_1b.Name(), types.StoreTypePersistent))
 	require.Error(t, opts.RegisterSubstore(skey_2b.Name(), types.StoreTypePersistent))
 	require.Error(t, opts.RegisterSubstore(skey_3.Name(), types.StoreTypePersistent))
+	// Substores can be registered in any order
+	opts = DefaultStoreConfig()
+	for _, key := range []string{"b", "c", "a"} {
+		require.NoError(t, opts.RegisterSubstore(key, types.StoreTypePersistent))
+	}
+	require.Equal(t, []string{"a", "b", "c"}, opts.reserved)
 }
 
 func TestMultiStoreBasic(t *testing.T) {
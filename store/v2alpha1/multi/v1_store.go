@@ -0,0 +1,443 @@
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	prefixdb "github.com/cosmos/cosmos-sdk/db/prefix"
+	util "github.com/cosmos/cosmos-sdk/internal"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+)
+
+var (
+	_ v1.CommitMultiStore = (*V1Store)(nil)
+	_ v1.Queryable        = (*V1Store)(nil)
+)
+
+// V1Store adapts a Store to the v1 CommitMultiStore interface, so that it can be used as the
+// root store of a BaseApp in place of the IAVL based rootmulti.Store.
+//
+// The schema of the underlying Store is defined by the stores mounted with MountStoreWithDB, and
+// the Store is only created when a version is loaded. Only the latest version can be loaded, as
+// the Store can't revert to a past version.
+type V1Store struct {
+	db    dbm.Connection
+	store *Store
+	// Placeholder DB of the branches, as the stores are all given to them as substores
+	branchDB tmdb.DB
+
+	keysByName       map[string]v1.StoreKey
+	storeTypes       map[string]types.StoreType
+	pruning          pruningtypes.PruningOptions
+	initialVersion   uint64
+	snapshotInterval uint64
+
+	traceWriter       io.Writer
+	traceContext      v1.TraceContext
+	traceContextMutex sync.Mutex
+	listeners         map[v1.StoreKey][]v1.WriteListener
+}
+
+// NewV1Store returns a V1Store backed by the given DB.
+func NewV1Store(db dbm.Connection) *V1Store {
+	return &V1Store{
+		db:         db,
+		branchDB:   tmdb.NewMemDB(),
+		keysByName: map[string]v1.StoreKey{},
+		storeTypes: map[string]types.StoreType{},
+		pruning:    pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
+		listeners:  map[v1.StoreKey][]v1.WriteListener{},
+	}
+}
+
+// Store returns the underlying Store, or nil if no version is loaded yet.
+func (s *V1Store) Store() *Store {
+	return s.store
+}
+
+// Close closes the underlying Store and its DB.
+func (s *V1Store) Close() error {
+	if s.store != nil {
+		if err := s.store.Close(); err != nil {
+			return err
+		}
+	}
+	return s.db.Close()
+}
+
+// MountStoreWithDB implements CommitMultiStore. IAVL stores are mounted as persistent substores;
+// mounting a store with its own DB is not supported.
+func (s *V1Store) MountStoreWithDB(key v1.StoreKey, typ v1.StoreType, db tmdb.DB) {
+	if key == nil {
+		panic("MountIAVLStore() key cannot be nil")
+	}
+	if db != nil {
+		panic(fmt.Sprintf("cannot mount store %s with its own DB", key.Name()))
+	}
+	if _, ok := s.keysByName[key.Name()]; ok {
+		panic(fmt.Sprintf("store duplicate store key name %v", key.Name()))
+	}
+	switch typ {
+	case v1.StoreTypeIAVL, v1.StoreTypeDB, v1.StoreTypeSMT, v1.StoreTypePersistent:
+		typ = types.StoreTypePersistent
+	case v1.StoreTypeMemory, v1.StoreTypeTransient:
+	default:
+		panic(fmt.Sprintf("unsupported store type %v for store %s", typ, key.Name()))
+	}
+	s.keysByName[key.Name()] = key
+	s.storeTypes[key.Name()] = typ
+}
+
+// LoadLatestVersion implements CommitMultiStore.
+func (s *V1Store) LoadLatestVersion() error {
+	return s.loadVersion(-1, nil)
+}
+
+// LoadLatestVersionAndUpgrade implements CommitMultiStore.
+func (s *V1Store) LoadLatestVersionAndUpgrade(upgrades *v1.StoreUpgrades) error {
+	return s.loadVersion(-1, upgrades)
+}
+
+// LoadVersion implements CommitMultiStore. It fails unless ver is the latest version.
+func (s *V1Store) LoadVersion(ver int64) error {
+	return s.loadVersion(ver, nil)
+}
+
+// LoadVersionAndUpgrade implements CommitMultiStore. It fails unless ver is the latest version.
+func (s *V1Store) LoadVersionAndUpgrade(ver int64, upgrades *v1.StoreUpgrades) error {
+	return s.loadVersion(ver, upgrades)
+}
+
+func (s *V1Store) loadVersion(ver int64, upgrades *v1.StoreUpgrades) error {
+	versions, err := s.db.Versions()
+	if err != nil {
+		return err
+	}
+	if ver >= 0 && uint64(ver) != versions.Last() {
+		return fmt.Errorf("cannot load version %d, only the latest version %d can be loaded", ver, versions.Last())
+	}
+
+	if s.store != nil {
+		if err := s.store.Close(); err != nil {
+			return err
+		}
+		s.store = nil
+	}
+	opts, err := s.storeConfig(upgrades)
+	if err != nil {
+		return err
+	}
+	store, err := NewStore(s.db, opts)
+	if err != nil {
+		return err
+	}
+
+	mounted := StoreSchema{}
+	for name, typ := range s.storeTypes {
+		mounted[name] = typ
+	}
+	if !store.schema.persistent().equal(mounted.persistent()) {
+		err = errors.New("mounted stores do not match the stores of the loaded state")
+		return util.CombineErrors(err, store.Close(), "store.Close also failed")
+	}
+	store.SetSnapshotInterval(s.snapshotInterval)
+	s.store = store
+	return nil
+}
+
+// Returns the config of the Store: the persistent substores are the saved ones, to which the
+// upgrades are applied, and the other substores are the mounted ones.
+func (s *V1Store) storeConfig(upgrades *v1.StoreUpgrades) (StoreConfig, error) {
+	opts := DefaultStoreConfig()
+	opts.Pruning = s.pruning
+	opts.InitialVersion = s.initialVersion
+
+	reader := s.db.Reader()
+	saved, err := readSavedSchema(prefixdb.NewReader(reader, schemaPrefix))
+	if err != nil {
+		return opts, err
+	}
+	if err = reader.Discard(); err != nil {
+		return opts, err
+	}
+
+	schema := saved.persistent()
+	if len(schema) == 0 {
+		// New store, there is nothing to upgrade
+		schema = StoreSchema{}
+		upgrades = nil
+	}
+	for name, typ := range s.storeTypes {
+		if len(saved.StoreSchema) == 0 || typ != types.StoreTypePersistent {
+			schema[name] = typ
+		}
+	}
+	for name, typ := range schema {
+		if err = opts.RegisterSubstore(name, typ); err != nil {
+			return opts, err
+		}
+	}
+	if upgrades != nil {
+		opts.Upgrades = []types.StoreUpgrades{*upgrades}
+	}
+	return opts, nil
+}
+
+// GetStoreType implements Store.
+func (s *V1Store) GetStoreType() v1.StoreType {
+	return v1.StoreTypeMulti
+}
+
+// CacheWrap implements CacheWrapper/Store.
+func (s *V1Store) CacheWrap() v1.CacheWrap {
+	return s.CacheMultiStore().(v1.CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface. The writes to the
+// substores are traced to the given writer.
+func (s *V1Store) CacheWrapWithTrace(w io.Writer, tc v1.TraceContext) v1.CacheWrap {
+	return s.newCacheMultiStore(w, tc, s.listeners)
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface. The given
+// listeners receive the writes to the storeKey substore, in addition to the
+// listeners of the store.
+func (s *V1Store) CacheWrapWithListeners(storeKey v1.StoreKey, listeners []v1.WriteListener) v1.CacheWrap {
+	allListeners := make(map[v1.StoreKey][]v1.WriteListener, len(s.listeners)+1)
+	for key, ls := range s.listeners {
+		allListeners[key] = ls
+	}
+	allListeners[storeKey] = append(append([]v1.WriteListener{}, s.listeners[storeKey]...), listeners...)
+	return s.newCacheMultiStore(s.traceWriter, s.getTracingContext(), allListeners)
+}
+
+// Commit implements Committer.
+func (s *V1Store) Commit() v1.CommitID {
+	return s.store.Commit()
+}
+
+// LastCommitID implements Committer. Before a version is loaded, it returns the latest saved
+// version without its hash.
+func (s *V1Store) LastCommitID() v1.CommitID {
+	if s.store == nil {
+		versions, err := s.db.Versions()
+		if err != nil {
+			panic(err)
+		}
+		return v1.CommitID{Version: int64(versions.Last())}
+	}
+	return s.store.LastCommitID()
+}
+
+// SetPruning implements Committer.
+func (s *V1Store) SetPruning(opts pruningtypes.PruningOptions) {
+	s.pruning = opts
+	if s.store != nil {
+		s.store.SetPruning(opts)
+	}
+}
+
+// GetPruning implements Committer.
+func (s *V1Store) GetPruning() pruningtypes.PruningOptions {
+	return s.pruning
+}
+
+// CacheMultiStore implements MultiStore.
+func (s *V1Store) CacheMultiStore() v1.CacheMultiStore {
+	return s.newCacheMultiStore(s.traceWriter, s.getTracingContext(), s.listeners)
+}
+
+// newCacheMultiStore branches the current substores, whose writes are traced
+// and sent to the given listeners by the returned store.
+func (s *V1Store) newCacheMultiStore(
+	w io.Writer, tc v1.TraceContext, listeners map[v1.StoreKey][]v1.WriteListener,
+) cachemulti.Store {
+	stores := make(map[v1.StoreKey]v1.CacheWrapper, len(s.keysByName))
+	for _, key := range s.keysByName {
+		stores[key] = s.store.GetKVStore(key)
+	}
+	return cachemulti.NewStore(s.branchDB, stores, s.keysByName, w, tc, listeners)
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. The persistent substores are read at the
+// given version, and the other ones are the current ones.
+func (s *V1Store) CacheMultiStoreWithVersion(version int64) (v1.CacheMultiStore, error) {
+	view, err := s.store.getView(version)
+	if err != nil {
+		return nil, err
+	}
+	stores := make(map[v1.StoreKey]v1.CacheWrapper, len(s.keysByName))
+	for name, key := range s.keysByName {
+		if s.store.schema[name] != types.StoreTypePersistent {
+			stores[key] = s.store.GetKVStore(key)
+			continue
+		}
+		// Substores added after this version read as empty
+		sub, err := view.getSubstore(name)
+		if err != nil {
+			return nil, err
+		}
+		stores[key] = sub
+	}
+	return cachemulti.NewStore(s.branchDB, stores, s.keysByName, s.traceWriter, s.getTracingContext(), s.listeners), nil
+}
+
+// GetStore implements MultiStore.
+func (s *V1Store) GetStore(key v1.StoreKey) v1.Store {
+	return s.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (s *V1Store) GetKVStore(key v1.StoreKey) v1.KVStore {
+	store := s.store.GetKVStore(key)
+	if s.TracingEnabled() {
+		store = tracekv.NewStore(store, s.traceWriter, s.getTracingContext())
+	}
+	if s.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, s.listeners[key])
+	}
+	return store
+}
+
+// GetCommitStore implements CommitMultiStore. It returns nil, as the substores are only
+// committed together.
+func (s *V1Store) GetCommitStore(_ v1.StoreKey) v1.CommitStore {
+	return nil
+}
+
+// GetCommitKVStore implements CommitMultiStore. It returns nil, as the substores are only
+// committed together.
+func (s *V1Store) GetCommitKVStore(_ v1.StoreKey) v1.CommitKVStore {
+	return nil
+}
+
+// TracingEnabled implements MultiStore.
+func (s *V1Store) TracingEnabled() bool {
+	return s.traceWriter != nil
+}
+
+// SetTracer implements MultiStore.
+func (s *V1Store) SetTracer(w io.Writer) v1.MultiStore {
+	s.traceWriter = w
+	return s
+}
+
+// SetTracingContext implements MultiStore. The given context is merged with the existing one.
+func (s *V1Store) SetTracingContext(tc v1.TraceContext) v1.MultiStore {
+	s.traceContextMutex.Lock()
+	defer s.traceContextMutex.Unlock()
+	s.traceContext = s.traceContext.Merge(tc)
+	return s
+}
+
+func (s *V1Store) getTracingContext() v1.TraceContext {
+	s.traceContextMutex.Lock()
+	defer s.traceContextMutex.Unlock()
+	if s.traceContext == nil {
+		return nil
+	}
+	ctx := v1.TraceContext{}
+	for k, v := range s.traceContext {
+		ctx[k] = v
+	}
+	return ctx
+}
+
+// ListeningEnabled implements MultiStore.
+func (s *V1Store) ListeningEnabled(key v1.StoreKey) bool {
+	if ls, ok := s.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
+// AddListeners implements MultiStore.
+func (s *V1Store) AddListeners(key v1.StoreKey, listeners []v1.WriteListener) {
+	s.listeners[key] = append(s.listeners[key], listeners...)
+}
+
+// Query implements Queryable. The proofs of the persistent substores are SMT proofs, to be
+// verified with DefaultProofRuntime.
+func (s *V1Store) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return s.store.Query(req)
+}
+
+// GetCommitInfo returns the commit info of the given version, which hash is the app hash of the
+// version.
+func (s *V1Store) GetCommitInfo(version int64) (*v1.CommitInfo, error) {
+	view, err := s.store.getView(version)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := view.getMerkleRoots()
+	if err != nil {
+		return nil, err
+	}
+	cInfo := &v1.CommitInfo{Version: version}
+	for name, root := range roots {
+		cInfo.StoreInfos = append(cInfo.StoreInfos, v1.StoreInfo{
+			Name:     name,
+			CommitId: v1.CommitID{Version: version, Hash: root},
+		})
+	}
+	sort.Slice(cInfo.StoreInfos, func(i, j int) bool {
+		return cInfo.StoreInfos[i].Name < cInfo.StoreInfos[j].Name
+	})
+	return cInfo, nil
+}
+
+// Snapshot implements Snapshotter.
+func (s *V1Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return s.store.Snapshot(height, protoWriter)
+}
+
+// Restore implements Snapshotter.
+func (s *V1Store) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	return s.store.Restore(height, format, protoReader)
+}
+
+// PruneSnapshotHeight implements Snapshotter.
+func (s *V1Store) PruneSnapshotHeight(height int64) {
+	s.store.PruneSnapshotHeight(height)
+}
+
+// SetSnapshotInterval implements Snapshotter.
+func (s *V1Store) SetSnapshotInterval(snapshotInterval uint64) {
+	s.snapshotInterval = snapshotInterval
+	if s.store != nil {
+		s.store.SetSnapshotInterval(snapshotInterval)
+	}
+}
+
+// SetInterBlockCache implements CommitMultiStore. It is a no-op, as the Store has no inter-block
+// cache.
+func (s *V1Store) SetInterBlockCache(_ v1.MultiStorePersistentCache) {}
+
+// SetInitialVersion implements CommitMultiStore.
+func (s *V1Store) SetInitialVersion(version int64) error {
+	if version < 0 {
+		return fmt.Errorf("invalid initial version %d", version)
+	}
+	s.initialVersion = uint64(version)
+	if s.store != nil {
+		return s.store.SetInitialVersion(uint64(version))
+	}
+	return nil
+}
+
+// SetIAVLCacheSize implements CommitMultiStore. It is a no-op, as there are no IAVL trees.
+func (s *V1Store) SetIAVLCacheSize(_ int) {}
@@ -0,0 +1,142 @@
+package multi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+)
+
+var (
+	memKey  = v1.NewMemoryStoreKey("mem")
+	tranKey = v1.NewTransientStoreKey("tran")
+)
+
+func newV1Store(t *testing.T, db *memdb.MemDB, keys ...types.StoreKey) *V1Store {
+	store := NewV1Store(db)
+	store.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	for _, key := range keys {
+		switch key.(type) {
+		case *v1.MemoryStoreKey:
+			store.MountStoreWithDB(key, v1.StoreTypeMemory, nil)
+		case *v1.TransientStoreKey:
+			store.MountStoreWithDB(key, v1.StoreTypeTransient, nil)
+		default:
+			store.MountStoreWithDB(key, v1.StoreTypeIAVL, nil)
+		}
+	}
+	return store
+}
+
+func TestV1Store(t *testing.T) {
+	db := memdb.NewDB()
+	store := newV1Store(t, db, skey_1, skey_2, memKey, tranKey)
+	require.Equal(t, v1.CommitID{}, store.LastCommitID())
+	require.NoError(t, store.LoadLatestVersion())
+
+	var changes []*v1.StoreKVPair
+	store.AddListeners(skey_1, []v1.WriteListener{listenerFunc(func(_ v1.StoreKey, key, value []byte, delete bool) error {
+		changes = append(changes, &v1.StoreKVPair{Key: key, Value: value, Delete: delete})
+		return nil
+	})})
+
+	cms := store.CacheMultiStore()
+	cms.GetKVStore(skey_1).Set([]byte("key"), []byte("value1"))
+	cms.GetKVStore(skey_2).Set([]byte("key"), []byte("value"))
+	cms.GetKVStore(memKey).Set([]byte("key"), []byte("mem"))
+	cms.GetKVStore(tranKey).Set([]byte("key"), []byte("tran"))
+	require.Nil(t, store.GetKVStore(skey_1).Get([]byte("key")))
+	cms.Write()
+	require.Equal(t, []*v1.StoreKVPair{{Key: []byte("key"), Value: []byte("value1")}}, changes)
+	cid1 := store.Commit()
+	require.Equal(t, int64(1), cid1.Version)
+	require.Equal(t, cid1, store.LastCommitID())
+	require.Equal(t, []byte("mem"), store.GetKVStore(memKey).Get([]byte("key")))
+	require.Nil(t, store.GetKVStore(tranKey).Get([]byte("key")))
+
+	// The commit info hash is the app hash
+	cInfo, err := store.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, cid1.Hash, cInfo.Hash())
+
+	store.GetKVStore(skey_1).Set([]byte("key"), []byte("value2"))
+	cid2 := store.Commit()
+
+	// Past versions can be read and queried with proofs
+	cms, err = store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), cms.GetKVStore(skey_1).Get([]byte("key")))
+	require.Equal(t, []byte("mem"), cms.GetKVStore(memKey).Get([]byte("key")))
+	_, err = store.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+
+	res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 2, Prove: true})
+	require.Equal(t, []byte("value2"), res.Value)
+	require.NoError(t, DefaultProofRuntime().VerifyValue(res.ProofOps, cid2.Hash, keyPath("/store1/", "key"), []byte("value2")))
+
+	// Only the latest version can be reloaded, with the same persistent stores
+	require.NoError(t, store.Store().Close())
+	store = newV1Store(t, db, skey_1, skey_2, memKey)
+	require.Equal(t, int64(2), store.LastCommitID().Version)
+	require.Error(t, store.LoadVersion(1))
+	require.NoError(t, store.LoadVersion(2))
+	require.Equal(t, cid2, store.LastCommitID())
+	require.Equal(t, []byte("value2"), store.GetKVStore(skey_1).Get([]byte("key")))
+	require.Nil(t, store.GetKVStore(memKey).Get([]byte("key")))
+
+	require.NoError(t, store.Store().Close())
+	store = newV1Store(t, db, skey_1, memKey)
+	require.Error(t, store.LoadLatestVersion())
+
+	// Stores are added, renamed and deleted by upgrades
+	store = newV1Store(t, db, skey_2b, skey_3, memKey)
+	require.NoError(t, store.LoadLatestVersionAndUpgrade(&v1.StoreUpgrades{
+		Added:   []string{skey_3.Name()},
+		Renamed: []v1.StoreRename{{OldKey: skey_2.Name(), NewKey: skey_2b.Name()}},
+		Deleted: []string{skey_1.Name()},
+	}))
+	require.Equal(t, []byte("value"), store.GetKVStore(skey_2b).Get([]byte("key")))
+	require.Equal(t, int64(3), store.Commit().Version)
+}
+
+func TestV1StoreCacheWrap(t *testing.T) {
+	store := newV1Store(t, memdb.NewDB(), skey_1, skey_2)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// the writes to the branched substore are sent to the given listeners
+	var changes []*v1.StoreKVPair
+	cw := store.CacheWrapWithListeners(skey_1, []v1.WriteListener{listenerFunc(func(_ v1.StoreKey, key, value []byte, delete bool) error {
+		changes = append(changes, &v1.StoreKVPair{Key: key, Value: value, Delete: delete})
+		return nil
+	})})
+	cms := cw.(v1.CacheMultiStore)
+	cms.GetKVStore(skey_1).Set([]byte("key"), []byte("value"))
+	cms.GetKVStore(skey_2).Set([]byte("key"), []byte("value"))
+	cms.Write()
+	require.Equal(t, []*v1.StoreKVPair{{Key: []byte("key"), Value: []byte("value")}}, changes)
+	require.Equal(t, []byte("value"), store.GetKVStore(skey_1).Get([]byte("key")))
+	require.False(t, store.ListeningEnabled(skey_1))
+
+	// the writes to the branched substores are traced to the given writer
+	var buf bytes.Buffer
+	cms = store.CacheWrapWithTrace(&buf, v1.TraceContext{"blockHeight": 1}).(v1.CacheMultiStore)
+	cms.GetKVStore(skey_2).Set([]byte("key"), []byte("value2"))
+	require.Zero(t, buf.Len())
+	cms.Write()
+	require.Contains(t, buf.String(), `"operation":"write"`)
+	require.Contains(t, buf.String(), `"blockHeight":1`)
+	require.Contains(t, buf.String(), skey_2.Name())
+	require.False(t, store.TracingEnabled())
+}
+
+type listenerFunc func(storeKey v1.StoreKey, key, value []byte, delete bool) error
+
+func (f listenerFunc) OnWrite(storeKey v1.StoreKey, key, value []byte, delete bool) error {
+	return f(storeKey, key, value, delete)
+}
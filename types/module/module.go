@@ -32,6 +32,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -175,6 +176,13 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// HasKVDecoders is the interface for the modules which register the decoders of the KV pairs of
+// their stores, used to annotate the state changes streamed by the state streaming services with
+// their decoded values. The decoders must follow the key layouts of the module stores.
+type HasKVDecoders interface {
+	RegisterKVDecoders(*sdk.KVDecoderRegistry)
+}
+
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
 type GenesisOnlyAppModule struct {
 	AppModuleGenesis
@@ -280,6 +288,15 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// RegisterKVDecoders registers the KV decoders of the modules which implement HasKVDecoders
+func (m *Manager) RegisterKVDecoders(registry *sdk.KVDecoderRegistry) {
+	for _, module := range m.Modules {
+		if module, ok := module.(HasKVDecoders); ok {
+			module.RegisterKVDecoders(registry)
+		}
+	}
+}
+
 // RegisterRoutes registers all module routes and module querier routes
 func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter, legacyQuerierCdc *codec.LegacyAmino) {
 	for _, module := range m.Modules {
@@ -426,33 +443,34 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		modules = DefaultMigrationsOrder(m.ModuleNames())
 	}
 
+	recorder := migrationRecorderFromContext(ctx)
+
 	updatedVM := VersionMap{}
 	for _, moduleName := range modules {
 		module := m.Modules[moduleName]
 		fromVersion, exists := fromVM[moduleName]
 		toVersion := module.ConsensusVersion()
 
-		// We run migration if the module is specified in `fromVM`.
-		// Otherwise we run InitGenesis.
-		//
-		// The module won't exist in the fromVM in two cases:
-		// 1. A new module is added. In this case we run InitGenesis with an
-		// empty genesis state.
-		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
-		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
-		if exists {
-			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
-			if err != nil {
-				return nil, err
+		start := time.Now()
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		err := m.runModuleMigrations(ctx, c, moduleName, fromVersion, toVersion, exists)
+		if recorder != nil {
+			res := MigrationResult{
+				Module:      moduleName,
+				FromVersion: fromVersion,
+				ToVersion:   toVersion,
+				InitGenesis: !exists,
+				Duration:    time.Since(start),
+				GasUsed:     ctx.GasMeter().GasConsumed() - gasBefore,
 			}
-		} else {
-			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
-			// The module manager assumes only one module will update the
-			// validator set, and it can't be a new module.
-			if len(moduleValUpdates) > 0 {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+			if err != nil {
+				res.Error = err.Error()
 			}
+			recorder.Results = append(recorder.Results, res)
+		}
+		if err != nil {
+			return nil, err
 		}
 
 		updatedVM[moduleName] = toVersion
@@ -461,6 +479,65 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	return updatedVM, nil
 }
 
+// runModuleMigrations runs the in-place store migrations of a module, or its
+// InitGenesis if the module does not exist in the fromVM.
+func (m Manager) runModuleMigrations(ctx sdk.Context, c configurator, moduleName string, fromVersion, toVersion uint64, exists bool) error {
+	// We run migration if the module is specified in `fromVM`.
+	// Otherwise we run InitGenesis.
+	//
+	// The module won't exist in the fromVM in two cases:
+	// 1. A new module is added. In this case we run InitGenesis with an
+	// empty genesis state.
+	// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
+	// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
+	if exists {
+		return c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+	}
+
+	module := m.Modules[moduleName]
+	ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+	moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+	// The module manager assumes only one module will update the
+	// validator set, and it can't be a new module.
+	if len(moduleValUpdates) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+	}
+
+	return nil
+}
+
+// MigrationResult is the result of the in-place store migrations, or of the
+// InitGenesis, of a module run by RunMigrations.
+type MigrationResult struct {
+	Module      string        `json:"module" yaml:"module"`
+	FromVersion uint64        `json:"from_version" yaml:"from_version"`
+	ToVersion   uint64        `json:"to_version" yaml:"to_version"`
+	InitGenesis bool          `json:"init_genesis" yaml:"init_genesis"`
+	Duration    time.Duration `json:"duration" yaml:"duration"`
+	GasUsed     uint64        `json:"gas_used" yaml:"gas_used"`
+	Error       string        `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// MigrationRecorder records the result of the migrations of each module run
+// by RunMigrations with a context returned by WithMigrationRecorder.
+type MigrationRecorder struct {
+	Results []MigrationResult
+}
+
+type migrationRecorderKey struct{}
+
+// WithMigrationRecorder returns a copy of the context with which RunMigrations
+// records the result of the migrations of each module in r, e.g. to report on
+// a dry run of an upgrade.
+func WithMigrationRecorder(ctx sdk.Context, r *MigrationRecorder) sdk.Context {
+	return ctx.WithValue(migrationRecorderKey{}, r)
+}
+
+func migrationRecorderFromContext(ctx sdk.Context) *MigrationRecorder {
+	r, _ := ctx.Value(migrationRecorderKey{}).(*MigrationRecorder)
+	return r
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -131,6 +133,36 @@ func TestManager_RegisterInvariants(t *testing.T) {
 	mm.RegisterInvariants(mockInvariantRegistry)
 }
 
+// kvDecodersModule is an AppModule registering a KV decoder
+type kvDecodersModule struct {
+	*mocks.MockAppModule
+}
+
+func (kvDecodersModule) RegisterKVDecoders(registry *sdk.KVDecoderRegistry) {
+	registry.Register("store1", nil, func(key, value []byte) (proto.Message, error) {
+		return &gogotypes.BytesValue{Value: value}, nil
+	})
+}
+
+func TestManager_RegisterKVDecoders(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(kvDecodersModule{mockAppModule1}, mockAppModule2)
+	require.NotNil(t, mm)
+	require.Equal(t, 2, len(mm.Modules))
+
+	registry := sdk.NewKVDecoderRegistry(codec.NewProtoCodec(types.NewInterfaceRegistry()))
+	mm.RegisterKVDecoders(registry)
+	msg, err := registry.Decode("store1", []byte("key"), []byte("value"))
+	require.NoError(t, err)
+	require.Equal(t, &gogotypes.BytesValue{Value: []byte("value")}, msg)
+}
+
 func TestManager_RegisterRoutes(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
@@ -20,12 +21,26 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	KVDecoder                 = types.KVDecoder
+	KVDecoderRegistry         = types.KVDecoderRegistry
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
 // simulation.
 type StoreDecoderRegistry map[string]func(kvA, kvB kv.Pair) string
 
+// NewKVDecoderRegistry returns a new registry of the KV decoders of the modules, used to
+// annotate the state changes streamed by the state streaming services.
+func NewKVDecoderRegistry(cdc codec.JSONCodec) *KVDecoderRegistry {
+	return types.NewKVDecoderRegistry(cdc)
+}
+
+// NewProtoKVDecoder returns a KVDecoder which unmarshals the values into the protobuf messages
+// returned by newMsg.
+func NewProtoKVDecoder(cdc codec.BinaryCodec, newMsg func() codec.ProtoMarshaler) KVDecoder {
+	return types.NewProtoKVDecoder(cdc, newMsg)
+}
+
 // Iterator over all the keys with a certain prefix in ascending order
 func KVStorePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 	return types.KVStorePrefixIterator(kvs, prefix)
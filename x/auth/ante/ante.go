@@ -17,6 +17,10 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	CircuitKeeper          CircuitBreaker
+	FeeAbstractionKeeper   FeeAbstractionKeeper
+	BaseFeeKeeper          BaseFeeKeeper
+	GlobalFeeKeeper        GlobalFeeKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -35,20 +39,36 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	txFeeChecker := options.TxFeeChecker
+	if options.GlobalFeeKeeper != nil {
+		txFeeChecker = NewGlobalFeeTxFeeChecker(options.GlobalFeeKeeper, txFeeChecker)
+	}
+	if options.BaseFeeKeeper != nil {
+		txFeeChecker = NewBaseFeeTxFeeChecker(options.BaseFeeKeeper, txFeeChecker)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+	}
+
+	if options.CircuitKeeper != nil {
+		// reject the messages disabled by the circuit breaker before any other check
+		anteDecorators = append(anteDecorators, NewCircuitBreakerDecorator(options.CircuitKeeper))
+	}
+
+	anteDecorators = append(anteDecorators,
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewDeductFeeDecoratorWithFeeAbstraction(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.FeeAbstractionKeeper, txFeeChecker),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -0,0 +1,32 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreakerDecorator rejects the transactions containing a message whose
+// execution is disabled by the circuit breaker, before any fee is deducted.
+// Messages dispatched by other messages, e.g. by authz or group, are checked by
+// the MsgServiceRouter when they are executed.
+// CONTRACT: Tx must implement sdk.Tx.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !cbd.circuitKeeper.IsAllowed(ctx, typeURL) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables execution of this message: %s", typeURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
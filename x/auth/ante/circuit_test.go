@@ -0,0 +1,40 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// disabledMsgs is a circuit breaker disabling the Msg type URLs it contains.
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) IsAllowed(_ sdk.Context, typeURL string) bool { return !d[typeURL] }
+
+type msgsTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(addr, addr, nil)
+	multiSend := banktypes.NewMsgMultiSend(nil, nil)
+
+	decorator := ante.NewCircuitBreakerDecorator(disabledMsgs{sdk.MsgTypeURL(multiSend): true})
+	anteHandler := sdk.ChainAnteDecorators(decorator)
+
+	_, err := anteHandler(sdk.Context{}, msgsTx{msgs: []sdk.Msg{send}}, false)
+	require.NoError(t, err)
+
+	_, err = anteHandler(sdk.Context{}, msgsTx{msgs: []sdk.Msg{send, multiSend}}, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -18,3 +18,37 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeAbstractionKeeper defines the expected fee abstraction keeper, which
+// allows to pay the fees in other denoms than the native one.
+type FeeAbstractionKeeper interface {
+	// ConvertFee returns the given fee where the coins in an allowed denom are
+	// replaced by their value in the native denom, and whether any coin was
+	// converted.
+	ConvertFee(ctx sdk.Context, fee sdk.Coins) (sdk.Coins, bool, error)
+}
+
+// BaseFeeKeeper defines the expected base fee keeper, which provides a
+// consensus-level minimum gas price.
+type BaseFeeKeeper interface {
+	// GetBaseFee returns the base fee per unit of gas. A zero base fee
+	// disables the check.
+	GetBaseFee(ctx sdk.Context) sdk.DecCoin
+}
+
+// GlobalFeeKeeper defines the expected global fee keeper, e.g. the x/globalfee
+// keeper, which provides consensus-level minimum gas prices set by governance.
+type GlobalFeeKeeper interface {
+	// GetMinimumGasPrices returns the global minimum gas prices. Empty gas
+	// prices disable the check.
+	GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins
+	// IsBypassMinFee reports whether a transaction with the given Msg type
+	// URLs and gas limit is exempted from the minimum gas prices.
+	IsBypassMinFee(ctx sdk.Context, typeURLs []string, gas uint64) bool
+}
+
+// CircuitBreaker defines the expected circuit breaker, e.g. the x/circuit
+// keeper, which reports whether the execution of a Msg type URL is allowed.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) bool
+}
@@ -20,6 +20,7 @@ type DeductFeeDecorator struct {
 	accountKeeper  AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
+	feeAbsKeeper   FeeAbstractionKeeper
 	txFeeChecker   TxFeeChecker
 }
 
@@ -36,8 +37,23 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 	}
 }
 
+// NewDeductFeeDecoratorWithFeeAbstraction returns a DeductFeeDecorator which
+// also accepts the fees paid in the denoms allowed by the fee abstraction
+// keeper. Such fees are converted into the native denom before being checked
+// by the TxFeeChecker, but are deducted in the denoms they were paid in.
+func NewDeductFeeDecoratorWithFeeAbstraction(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, fak FeeAbstractionKeeper, tfc TxFeeChecker) DeductFeeDecorator {
+	dfd := NewDeductFeeDecorator(ak, bk, fk, tfc)
+	dfd.feeAbsKeeper = fak
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	fee, priority, err := dfd.txFeeChecker(ctx, tx)
+	checkCtx := ctx
+	if simulate {
+		checkCtx = ctx.WithValue(simulationKey{}, true)
+	}
+
+	fee, priority, err := dfd.checkTxFee(checkCtx, tx)
 	if err != nil {
 		return ctx, err
 	}
@@ -50,6 +66,101 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(newCtx, tx, simulate)
 }
 
+// simulationKey marks the context passed to the TxFeeChecker when the tx is
+// simulated.
+type simulationKey struct{}
+
+// isSimulation reports whether the TxFeeChecker is run for a simulated tx.
+func isSimulation(ctx sdk.Context) bool {
+	simulate, _ := ctx.Value(simulationKey{}).(bool)
+	return simulate
+}
+
+// checkTxFee runs the TxFeeChecker and returns the fee to deduct and the tx
+// priority. If a fee abstraction keeper is set and the fee is paid in an
+// allowed denom, the checker is run against the fee converted into the native
+// denom, and the effective fee it returns is deducted in the denoms the fee
+// was paid in.
+func (dfd DeductFeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	if dfd.feeAbsKeeper == nil {
+		return dfd.txFeeChecker(ctx, tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	convertedFee, converted, err := dfd.feeAbsKeeper.ConvertFee(ctx, fee)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
+	}
+	if !converted {
+		return dfd.txFeeChecker(ctx, tx)
+	}
+
+	effectiveFee, priority, err := dfd.txFeeChecker(ctx, convertedFeeTx{FeeTx: feeTx, fee: convertedFee})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fee, err = dfd.paidEffectiveFee(ctx, fee, convertedFee, effectiveFee)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fee, priority, nil
+}
+
+// paidEffectiveFee converts the effective fee returned by the TxFeeChecker for
+// the converted fee back into the denoms the fee was paid in. Each paid coin
+// is scaled by the ratio of the effective to the converted amount of the denom
+// it is converted into, rounded up. The fee paid is the most that is deducted.
+func (dfd DeductFeeDecorator) paidEffectiveFee(ctx sdk.Context, paidFee, convertedFee, effectiveFee sdk.Coins) (sdk.Coins, error) {
+	if convertedFee.IsAllLTE(effectiveFee) {
+		return paidFee, nil
+	}
+
+	// the conversion of the whole fee was already reported
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	fee := sdk.NewCoins()
+	for _, coin := range paidFee {
+		converted, _, err := dfd.feeAbsKeeper.ConvertFee(ctx, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
+		}
+		if len(converted) != 1 {
+			// the coin is worth nothing in the native denom
+			fee = fee.Add(coin)
+			continue
+		}
+
+		denom := converted[0].Denom
+		ratio := sdk.NewDecFromInt(effectiveFee.AmountOf(denom)).QuoInt(convertedFee.AmountOf(denom))
+		if ratio.GTE(sdk.OneDec()) {
+			fee = fee.Add(coin)
+			continue
+		}
+		fee = fee.Add(sdk.NewCoin(coin.Denom, ratio.MulInt(coin.Amount).Ceil().TruncateInt()))
+	}
+
+	return fee, nil
+}
+
+// convertedFeeTx wraps a FeeTx to expose its fee converted into the native
+// denom to the TxFeeChecker.
+type convertedFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+// GetFee returns the converted fee.
+func (tx convertedFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
 func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
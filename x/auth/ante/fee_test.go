@@ -1,11 +1,21 @@
 package ante_test
 
 import (
+	"errors"
+	"time"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	feeabskeeper "github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -107,3 +117,271 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+// mockFeeAbstractionKeeper converts the fees paid in ibc/atom at a price of 2bnkt.
+type mockFeeAbstractionKeeper struct{}
+
+func (mockFeeAbstractionKeeper) ConvertFee(_ sdk.Context, fee sdk.Coins) (sdk.Coins, bool, error) {
+	atom := fee.AmountOf("ibc/atom")
+	if atom.IsZero() {
+		return fee, false, nil
+	}
+
+	converted := sdk.NewCoins(sdk.NewCoin("bnkt", atom.MulRaw(2)))
+	for _, coin := range fee {
+		if coin.Denom != "ibc/atom" {
+			converted = converted.Add(coin)
+		}
+	}
+	return converted, true, nil
+}
+
+func (suite *AnteTestSuite) TestDeductFeesWithFeeAbstraction() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecoratorWithFeeAbstraction(suite.app.AccountKeeper, suite.app.BankKeeper, nil, mockFeeAbstractionKeeper{}, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100)))
+	suite.Require().NoError(err)
+
+	// msg and signatures, the fee of 75ibc/atom is worth 150bnkt
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 75))
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+
+	// the converted fee is below the minimum gas prices
+	highGasPrice := []sdk.DecCoin{sdk.NewDecCoinFromDec("bnkt", sdk.NewDec(200).Quo(sdk.NewDec(100000)))}
+	_, err = antehandler(suite.ctx.WithMinGasPrices(highGasPrice), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the converted fee meets the minimum gas prices, the fee is deducted in
+	// the denom it was paid in and the priority is computed from the converted fee
+	lowGasPrice := []sdk.DecCoin{sdk.NewDecCoinFromDec("bnkt", sdk.NewDec(50).Quo(sdk.NewDec(100000)))}
+	newCtx, err := antehandler(suite.ctx.WithMinGasPrices(lowGasPrice), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(150), newCtx.Priority())
+	suite.Require().Equal(sdk.NewInt(25), suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "ibc/atom").Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "bnkt").IsZero())
+}
+
+func (suite *AnteTestSuite) TestDeductEffectiveFeeWithFeeAbstraction() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the checker only charges 60% of the converted fee in bnkt
+	var checkedFee sdk.Coins
+	txFeeChecker := func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		checkedFee = tx.(sdk.FeeTx).GetFee()
+		effectiveFee := sdk.NewCoins()
+		for _, coin := range checkedFee {
+			if coin.Denom == "bnkt" {
+				coin.Amount = coin.Amount.MulRaw(6).QuoRaw(10)
+			}
+			effectiveFee = effectiveFee.Add(coin)
+		}
+		return effectiveFee, 1, nil
+	}
+	dfd := ante.NewDeductFeeDecoratorWithFeeAbstraction(suite.app.AccountKeeper, suite.app.BankKeeper, nil, mockFeeAbstractionKeeper{}, txFeeChecker)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100), sdk.NewInt64Coin("bnkt", 100), sdk.NewInt64Coin("other", 100)))
+	suite.Require().NoError(err)
+
+	// msg and signatures, the fee of 75ibc/atom, 50bnkt and 10other is
+	// converted into 200bnkt and 10other
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 75), sdk.NewInt64Coin("bnkt", 50), sdk.NewInt64Coin("other", 10))
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the effective fee of 120bnkt and 10other is deducted as 45ibc/atom,
+	// 30bnkt and 10other
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bnkt", 200), sdk.NewInt64Coin("other", 10)), checkedFee)
+	suite.Require().Equal(sdk.NewInt(55), suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "ibc/atom").Amount)
+	suite.Require().Equal(sdk.NewInt(70), suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "bnkt").Amount)
+	suite.Require().Equal(sdk.NewInt(90), suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "other").Amount)
+}
+
+// mockBaseFeeKeeper returns a fixed base fee.
+type mockBaseFeeKeeper struct {
+	baseFee sdk.DecCoin
+}
+
+func (k mockBaseFeeKeeper) GetBaseFee(_ sdk.Context) sdk.DecCoin {
+	return k.baseFee
+}
+
+func (suite *AnteTestSuite) TestBaseFeeTxFeeChecker() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the base fee of 0.001bnkt per gas requires 200bnkt for the test gas limit
+	bfk := mockBaseFeeKeeper{baseFee: sdk.NewDecCoinFromDec("bnkt", sdk.NewDecWithPrec(1, 3))}
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, ante.NewBaseFeeTxFeeChecker(bfk, nil))
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("bnkt", 1000)))
+	suite.Require().NoError(err)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the base fee is enforced in DeliverTx, unlike the validator min gas prices
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithIsCheckTx(false)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the genesis transactions are not checked
+	_, err = antehandler(suite.ctx.WithBlockHeight(0), tx, false)
+	suite.Require().NoError(err)
+
+	// the base fee check doesn't fail the simulation of a tx
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+
+	// unlike the validator min gas prices
+	minGasPrice := []sdk.DecCoin{sdk.NewDecCoinFromDec("bnkt", sdk.NewDecWithPrec(1, 3))}
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrice), tx, true)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("bnkt", 200)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
+
+// atomPriceProvider prices ibc/atom at 2bnkt.
+type atomPriceProvider struct{}
+
+func (atomPriceProvider) GetTWAP(_ sdk.Context, baseDenom, quoteDenom string, _ time.Duration) (sdk.Dec, error) {
+	if baseDenom != "ibc/atom" || quoteDenom != "bnkt" {
+		return sdk.Dec{}, errors.New("no pool")
+	}
+	return sdk.NewDec(2), nil
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeModules() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithIsCheckTx(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the fees can be paid in ibc/atom at 2bnkt
+	feeAbsKeeper := feeabskeeper.NewKeeper(suite.app.GetKey(feeabs.StoreKey), suite.app.AppCodec(), suite.app.BankKeeper, atomPriceProvider{}, nil, authority)
+	suite.Require().NoError(feeAbsKeeper.SetParams(suite.ctx, feeabs.NewParams("bnkt", []string{"ibc/atom"}, feeabs.DefaultTWAPWindow, false)))
+
+	// the base fee of 0.001bnkt per gas requires 200bnkt for the test gas limit
+	feeMarketParams := feemarket.DefaultParams()
+	feeMarketParams.Enabled = true
+	feeMarketParams.FeeDenom = "bnkt"
+	feeMarketParams.MinBaseFee = sdk.NewDecWithPrec(1, 3)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+	suite.app.FeeMarketKeeper.SetBaseFeeAmount(suite.ctx, sdk.NewDecWithPrec(1, 3))
+
+	// the global minimum gas price of 0.002bnkt per gas requires 400bnkt
+	globalFeeParams := globalfee.DefaultParams()
+	globalFeeParams.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("bnkt", sdk.NewDecWithPrec(2, 3)))
+	suite.Require().NoError(suite.app.GlobalFeeKeeper.SetParams(suite.ctx, globalFeeParams))
+
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:        suite.app.AccountKeeper,
+		BankKeeper:           suite.app.BankKeeper,
+		SignModeHandler:      suite.clientCtx.TxConfig.SignModeHandler(),
+		SigGasConsumer:       ante.DefaultSigVerificationGasConsumer,
+		FeeAbstractionKeeper: feeAbsKeeper,
+		BaseFeeKeeper:        suite.app.FeeMarketKeeper,
+		GlobalFeeKeeper:      suite.app.GlobalFeeKeeper,
+	})
+	suite.Require().NoError(err)
+
+	accounts := suite.CreateTestAccounts(1)
+	addr := accounts[0].acc.GetAddress()
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 1000))))
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		suite.txBuilder.SetFeeAmount(fee)
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", amount)) }
+
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		simulate bool
+		bypass   bool
+		expErr   error
+	}{
+		{"below the base fee", atom(99), false, false, sdkerrors.ErrInsufficientFee},
+		{"below the global minimum gas prices", atom(150), false, false, sdkerrors.ErrInsufficientFee},
+		{"above the global minimum gas prices", atom(200), false, false, nil},
+		{"simulation without fees", nil, true, false, nil},
+		{"bypass msgs below the base fee", atom(99), false, true, sdkerrors.ErrInsufficientFee},
+		{"bypass msgs paying the base fee", atom(100), false, true, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.bypass {
+				params := globalFeeParams
+				params.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(&testdata.TestMsg{})}
+				suite.Require().NoError(suite.app.GlobalFeeKeeper.SetParams(ctx, params))
+			}
+
+			_, err := anteHandler(ctx, newTx(tc.fee), tc.simulate)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fee is deducted in the denom it was paid in
+			suite.Require().Equal(sdk.NewInt(1000).Sub(tc.fee.AmountOf("ibc/atom")), suite.app.BankKeeper.GetBalance(ctx, addr, "ibc/atom").Amount)
+		})
+	}
+}
@@ -44,6 +44,119 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 	return feeCoins, priority, nil
 }
 
+// NewBaseFeeTxFeeChecker returns a TxFeeChecker which requires the fee to
+// cover the base fee on the gas limit of the tx, i.e. ceil(baseFee * gasLimit)
+// in the base fee denom, before running the given TxFeeChecker. Unlike the
+// validator minimum gas prices, the base fee is stored in state and enforced
+// in both CheckTx and DeliverTx, so all the validators agree on it. The
+// genesis transactions are not checked, nor the simulated ones whose fee is
+// usually derived from the simulated gas.
+func NewBaseFeeTxFeeChecker(bfk BaseFeeKeeper, tfc TxFeeChecker) TxFeeChecker {
+	if tfc == nil {
+		tfc = checkTxFeeWithValidatorMinGasPrices
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		baseFee := bfk.GetBaseFee(ctx)
+		if ctx.BlockHeight() > 0 && !isSimulation(ctx) && baseFee.IsPositive() {
+			// fee = ceil(baseFee * gasLimit)
+			required := sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(sdk.NewDec(int64(feeTx.GetGas()))).Ceil().RoundInt())
+			feeCoins := feeTx.GetFee()
+			if feeCoins.AmountOf(required.Denom).LT(required.Amount) {
+				return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeCoins, required)
+			}
+		}
+
+		return tfc(ctx, tx)
+	}
+}
+
+// NewGlobalFeeTxFeeChecker returns a TxFeeChecker which enforces the global
+// minimum gas prices set by governance, e.g. by the x/globalfee module, in
+// both CheckTx and DeliverTx, before running the given TxFeeChecker. The fee
+// must be paid in the denoms of the global minimum gas prices and cover at
+// least one of them. Since the TxFeeCheckers run on the fee converted by the
+// fee abstraction keeper, if any, the fees paid in other denoms are checked by
+// their value in the native denom. The transactions only containing bypass
+// messages, within the maximum bypass gas, may pay zero fees: they are also
+// exempted from the validator minimum gas prices. The genesis transactions and
+// the simulated ones are not checked against the global minimum gas prices.
+func NewGlobalFeeTxFeeChecker(gfk GlobalFeeKeeper, tfc TxFeeChecker) TxFeeChecker {
+	if tfc == nil {
+		tfc = checkTxFeeWithValidatorMinGasPrices
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		gas := feeTx.GetGas()
+		msgs := tx.GetMsgs()
+		typeURLs := make([]string, len(msgs))
+		for i, msg := range msgs {
+			typeURLs[i] = sdk.MsgTypeURL(msg)
+		}
+
+		if gfk.IsBypassMinFee(ctx, typeURLs, gas) {
+			return tfc(ctx.WithMinGasPrices(sdk.DecCoins{}), tx)
+		}
+
+		minGasPrices := gfk.GetMinimumGasPrices(ctx)
+		if ctx.BlockHeight() == 0 || isSimulation(ctx) || len(minGasPrices) == 0 {
+			return tfc(ctx, tx)
+		}
+
+		feeCoins := feeTx.GetFee()
+		for _, coin := range feeCoins {
+			if !containsDenom(minGasPrices, coin.Denom) {
+				return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s is not allowed; allowed: %s", coin.Denom, minGasPrices)
+			}
+		}
+
+		// fee = ceil(minGasPrice * gasLimit), unlike sdk.Coins.IsAnyGTE a zero
+		// required fee is covered by any fee
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+		glDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+		for i, gp := range minGasPrices {
+			requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+		}
+		if !coversAny(feeCoins, requiredFees) {
+			return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required by the global minimum gas prices: %s", feeCoins, requiredFees)
+		}
+
+		return tfc(ctx, tx)
+	}
+}
+
+// coversAny reports whether the given fees cover any of the required fees.
+func coversAny(feeCoins, requiredFees sdk.Coins) bool {
+	for _, required := range requiredFees {
+		if feeCoins.AmountOf(required.Denom).GTE(required.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsDenom reports whether the given gas prices contain the given denom.
+func containsDenom(gasPrices sdk.DecCoins, denom string) bool {
+	for _, gp := range gasPrices {
+		if gp.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the fee
 // provided in a transaction.
 func getTxPriority(fee sdk.Coins) int64 {
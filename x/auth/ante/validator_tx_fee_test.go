@@ -0,0 +1,103 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+// mockGlobalFeeKeeper enforces the globalfee params it holds.
+type mockGlobalFeeKeeper struct {
+	params globalfee.Params
+}
+
+func (k mockGlobalFeeKeeper) GetMinimumGasPrices(sdk.Context) sdk.DecCoins {
+	return k.params.MinimumGasPrices
+}
+
+func (k mockGlobalFeeKeeper) IsBypassMinFee(_ sdk.Context, typeURLs []string, gas uint64) bool {
+	return k.params.IsBypassMsgTypes(typeURLs) && gas <= k.params.MaxTotalBypassMinFeeMsgGasUsage
+}
+
+type feeTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+	fee  sdk.Coins
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx feeTx) ValidateBasic() error       { return nil }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestGlobalFeeTxFeeChecker(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(addr, addr, nil)
+	multiSend := banktypes.NewMsgMultiSend(nil, nil)
+
+	params := globalfee.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)),
+	)
+	params.BypassMinFeeMsgTypes = []string{sdk.MsgTypeURL(send)}
+	params.MaxTotalBypassMinFeeMsgGasUsage = 200000
+
+	// the validator minimum gas prices seen by the next TxFeeChecker
+	var minGasPrices sdk.DecCoins
+	checker := ante.NewGlobalFeeTxFeeChecker(mockGlobalFeeKeeper{params: params}, func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		minGasPrices = ctx.MinGasPrices()
+		return tx.(sdk.FeeTx).GetFee(), 0, nil
+	})
+
+	localPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.OneDec()))
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(1).WithMinGasPrices(localPrices)
+	fee := func(coins ...sdk.Coin) sdk.Coins { return sdk.NewCoins(coins...) }
+
+	testCases := []struct {
+		name            string
+		ctx             sdk.Context
+		tx              feeTx
+		expErr          error
+		expMinGasPrices sdk.DecCoins
+	}{
+		{"enough fees in one denom", ctx, feeTx{[]sdk.Msg{multiSend}, 100000, fee(sdk.NewInt64Coin("atom", 1000))}, nil, localPrices},
+		{"enough fees in the other denom", ctx, feeTx{[]sdk.Msg{multiSend}, 100000, fee(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 10000))}, nil, localPrices},
+		{"insufficient fees", ctx, feeTx{[]sdk.Msg{multiSend}, 100000, fee(sdk.NewInt64Coin("atom", 999))}, sdkerrors.ErrInsufficientFee, nil},
+		{"zero fees", ctx, feeTx{[]sdk.Msg{multiSend}, 100000, nil}, sdkerrors.ErrInsufficientFee, nil},
+		{"fee denom not allowed", ctx, feeTx{[]sdk.Msg{multiSend}, 100000, fee(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("photon", 1))}, sdkerrors.ErrInvalidCoins, nil},
+		{"bypass msgs pay zero fees", ctx, feeTx{[]sdk.Msg{send, send}, 200000, nil}, nil, sdk.DecCoins{}},
+		{"bypass msgs above the max gas", ctx, feeTx{[]sdk.Msg{send}, 200001, nil}, sdkerrors.ErrInsufficientFee, nil},
+		{"bypass msgs with another msg", ctx, feeTx{[]sdk.Msg{send, multiSend}, 100000, nil}, sdkerrors.ErrInsufficientFee, nil},
+		{"genesis transaction", ctx.WithBlockHeight(0), feeTx{[]sdk.Msg{multiSend}, 100000, nil}, nil, localPrices},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minGasPrices = nil
+			checkedFee, _, err := checker(tc.ctx, tc.tx)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.tx.fee, checkedFee)
+			require.Equal(t, tc.expMinGasPrices, minGasPrices)
+		})
+	}
+
+	// no global minimum gas prices
+	checker = ante.NewGlobalFeeTxFeeChecker(mockGlobalFeeKeeper{params: globalfee.DefaultParams()}, nil)
+	_, _, err := checker(ctx.WithIsCheckTx(false), feeTx{[]sdk.Msg{multiSend}, 100000, fee(sdk.NewInt64Coin("photon", 1))})
+	require.NoError(t, err)
+}
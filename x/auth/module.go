@@ -35,6 +35,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasKVDecoders       = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -155,6 +156,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// RegisterKVDecoders registers the decoders of the auth store KV pairs for the state
+// streaming services.
+func (am AppModule) RegisterKVDecoders(registry *sdk.KVDecoderRegistry) {
+	types.RegisterKVDecoders(registry, am.accountKeeper.GetCodec())
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
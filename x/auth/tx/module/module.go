@@ -33,6 +33,13 @@ type txInputs struct {
 	AccountKeeper  ante.AccountKeeper    `key:"cosmos.auth.v1.AccountKeeper" optional:"true"`
 	BankKeeper     authtypes.BankKeeper  `key:"cosmos.bank.v1.Keeper" optional:"true"`
 	FeeGrantKeeper feegrantkeeper.Keeper `key:"cosmos.feegrant.v1.Keeper" optional:"true"`
+
+	// the optional modules checked by the ante handler are bound through its
+	// interfaces, they are nil if the app does not include them
+	CircuitBreaker       ante.CircuitBreaker       `key:"cosmos.circuit.v1.Keeper" optional:"true"`
+	FeeAbstractionKeeper ante.FeeAbstractionKeeper `key:"cosmos.feeabs.v1.Keeper" optional:"true"`
+	BaseFeeKeeper        ante.BaseFeeKeeper        `key:"cosmos.feemarket.v1.Keeper" optional:"true"`
+	GlobalFeeKeeper      ante.GlobalFeeKeeper      `key:"cosmos.globalfee.v1.Keeper" optional:"true"`
 }
 
 type txOutputs struct {
@@ -92,15 +99,20 @@ func newAnteHandler(txConfig client.TxConfig, in txInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-		},
-	)
+	options := ante.HandlerOptions{
+		AccountKeeper:   in.AccountKeeper,
+		BankKeeper:      in.BankKeeper,
+		SignModeHandler: txConfig.SignModeHandler(),
+		FeegrantKeeper:  in.FeeGrantKeeper,
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+
+		CircuitKeeper:        in.CircuitBreaker,
+		FeeAbstractionKeeper: in.FeeAbstractionKeeper,
+		BaseFeeKeeper:        in.BaseFeeKeeper,
+		GlobalFeeKeeper:      in.GlobalFeeKeeper,
+	}
+
+	anteHandler, err := ante.NewAnteHandler(options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create ante handler: %w", err)
 	}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterKVDecoders registers the decoders of the KV pairs of the auth store, for the state
+// streaming services
+func RegisterKVDecoders(registry *sdk.KVDecoderRegistry, cdc codec.BinaryCodec) {
+	registry.Register(StoreKey, AddressStoreKeyPrefix, func(_, value []byte) (proto.Message, error) {
+		var acc AccountI
+		if err := cdc.UnmarshalInterface(value, &acc); err != nil {
+			return nil, err
+		}
+		return acc, nil
+	})
+	registry.Register(StoreKey, GlobalAccountNumberKey, sdk.NewProtoKVDecoder(cdc, func() codec.ProtoMarshaler {
+		return &gogotypes.UInt64Value{}
+	}))
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestKVDecoders(t *testing.T) {
+	registry := sdk.NewKVDecoderRegistry(appCodec)
+	types.RegisterKVDecoders(registry, appCodec)
+
+	acc := types.NewBaseAccountWithAddress(sdk.AccAddress("addr1_______________"))
+	acc.Sequence = 2
+	bz, err := appCodec.MarshalInterface(acc)
+	require.NoError(t, err)
+	msg, err := registry.Decode(types.StoreKey, types.AddressStoreKey(acc.GetAddress()), bz)
+	require.NoError(t, err)
+	require.Equal(t, acc, msg)
+
+	pair := &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: types.AddressStoreKey(acc.GetAddress()), Value: bz}
+	require.NoError(t, registry.Annotate(pair))
+	require.Equal(t, "/cosmos.auth.v1beta1.BaseAccount", pair.ValueTypeUrl)
+	require.Contains(t, pair.ValueJson, acc.Address)
+
+	bz = appCodec.MustMarshal(&gogotypes.UInt64Value{Value: 5})
+	msg, err = registry.Decode(types.StoreKey, types.GlobalAccountNumberKey, bz)
+	require.NoError(t, err)
+	require.Equal(t, &gogotypes.UInt64Value{Value: 5}, msg)
+}
@@ -37,6 +37,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasKVDecoders       = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -169,6 +170,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// RegisterKVDecoders registers the decoders of the bank store KV pairs for the state
+// streaming services.
+func (am AppModule) RegisterKVDecoders(registry *sdk.KVDecoderRegistry) {
+	types.RegisterKVDecoders(registry, am.cdc)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 4 }
 
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterKVDecoders registers the decoders of the KV pairs of the bank store, for the state
+// streaming services. The balances are decoded into a Balance of a single coin, and the supplies
+// into a Coin.
+func RegisterKVDecoders(registry *sdk.KVDecoderRegistry, cdc codec.BinaryCodec) {
+	registry.Register(StoreKey, SupplyKey, func(key, value []byte) (proto.Message, error) {
+		amount, err := unmarshalAmount(value)
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.Coin{Denom: string(key[len(SupplyKey):]), Amount: amount}, nil
+	})
+	registry.Register(StoreKey, BalancesPrefix, func(key, value []byte) (proto.Message, error) {
+		addr, denom, err := AddressAndDenomFromBalancesStore(key[len(BalancesPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		amount, err := unmarshalAmount(value)
+		if err != nil {
+			return nil, err
+		}
+		return &Balance{Address: addr.String(), Coins: sdk.Coins{{Denom: denom, Amount: amount}}}, nil
+	})
+	registry.Register(StoreKey, DenomMetadataPrefix, sdk.NewProtoKVDecoder(cdc, func() codec.ProtoMarshaler {
+		return &Metadata{}
+	}))
+	registry.Register(StoreKey, SendEnabledPrefix, func(key, value []byte) (proto.Message, error) {
+		return &SendEnabled{Denom: string(key[len(SendEnabledPrefix):]), Enabled: IsTrueB(value)}, nil
+	})
+}
+
+func unmarshalAmount(bz []byte) (math.Int, error) {
+	var amount math.Int
+	err := amount.Unmarshal(bz)
+	return amount, err
+}
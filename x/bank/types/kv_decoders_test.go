@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestKVDecoders(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	registry := sdk.NewKVDecoderRegistry(cdc)
+	types.RegisterKVDecoders(registry, cdc)
+
+	addr := sdk.AccAddress("addr1_______________")
+	amount, err := sdk.NewInt(100).Marshal()
+	require.NoError(t, err)
+	metadata := types.Metadata{Base: "stake", Display: "stake", DenomUnits: []*types.DenomUnit{{Denom: "stake"}}}
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		value    []byte
+		expected interface{}
+	}{
+		{"balance", append(types.CreateAccountBalancesPrefix(addr), "stake"...), amount, &types.Balance{Address: addr.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 100)}}},
+		{"supply", append(types.SupplyKey, "stake"...), amount, &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(100)}},
+		{"metadata", append(types.DenomMetadataPrefix, "stake"...), cdc.MustMarshal(&metadata), &metadata},
+		{"send enabled", types.CreateSendEnabledKey("stake"), []byte{types.ToBoolB(true)}, &types.SendEnabled{Denom: "stake", Enabled: true}},
+		{"denom address index", append(types.CreateDenomAddressPrefix("stake"), addr...), []byte{0}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := registry.Decode(types.StoreKey, tc.key, tc.value)
+			require.NoError(t, err)
+			if tc.expected == nil {
+				require.Nil(t, msg)
+			} else {
+				require.Equal(t, tc.expected, msg)
+			}
+		})
+	}
+
+	_, err = registry.Decode(types.StoreKey, types.BalancesPrefix, amount)
+	require.Error(t, err)
+}
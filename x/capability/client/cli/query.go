@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryCapabilities(),
+		GetCmdQueryModuleCapabilities(),
+	)
+
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryCapabilities returns cmd to query all the capabilities with their
+// owners.
+func GetCmdQueryCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Args:  cobra.NoArgs,
+		Short: "Query all the capabilities with their owners",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the capabilities with their owners.
+
+Example:
+$ %s query %s capabilities
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Capabilities(cmd.Context(), &types.QueryCapabilitiesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capabilities")
+
+	return cmd
+}
+
+// GetCmdQueryModuleCapabilities returns cmd to query the capabilities owned by
+// a module.
+func GetCmdQueryModuleCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-capabilities [module]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the capabilities owned by a module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the capabilities owned by a module, with all their owners.
+
+Example:
+$ %s query %s module-capabilities ibc
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ModuleCapabilities(cmd.Context(), &types.QueryModuleCapabilitiesRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module capabilities")
+
+	return cmd
+}
@@ -10,6 +10,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytestutil "github.com/cosmos/cosmos-sdk/x/capability/testutil"
 	"github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -57,3 +58,28 @@ func (suite *CapabilityTestSuite) TestGenesis() {
 	suite.Require().True(ok, "could not get second capability after genesis on second ScopedKeeper")
 	suite.Require().Equal(*cap2, *sk2Cap2, "capability values not equal on second ScopedKeeper")
 }
+
+func (suite *CapabilityTestSuite) TestGenesisRoundTrip() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+	cap2, err := sk2.NewCapability(suite.ctx, "ica")
+	suite.Require().NoError(err)
+	cap3, err := sk1.NewCapability(suite.ctx, "released")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk1.ReleaseCapability(suite.ctx, cap3))
+
+	genState, newCtx, newKeeper := capabilitytestutil.RequireGenesisRoundTrip(suite.T(), suite.cdc, suite.ctx, *suite.keeper)
+	suite.Require().Len(genState.Owners, 2)
+
+	newSk2 := newKeeper.ScopeToModule(stakingtypes.ModuleName)
+	newCap1, ok := newSk2.GetCapability(newCtx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(*cap1, *newCap1)
+	newCap2, ok := newSk2.GetCapability(newCtx, "ica")
+	suite.Require().True(ok)
+	suite.Require().Equal(*cap2, *newCap2)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capabilities returns all the capabilities with their owners.
+func (k Keeper) Capabilities(goCtx context.Context, req *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.GenesisOwners
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var owners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &owners); err != nil {
+			return err
+		}
+
+		capabilities = append(capabilities, types.GenesisOwners{
+			Index:       types.IndexFromKey(key),
+			IndexOwners: owners,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
+
+// ModuleCapabilities returns the capabilities owned by a module.
+func (k Keeper) ModuleCapabilities(goCtx context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.ModuleCapability
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var owners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &owners); err != nil {
+			return false, err
+		}
+
+		found := false
+		for _, owner := range owners.Owners {
+			if owner.Module != req.Module {
+				continue
+			}
+			found = true
+			if accumulate {
+				capabilities = append(capabilities, types.ModuleCapability{
+					Name:   owner.Name,
+					Index:  types.IndexFromKey(key),
+					Owners: owners,
+				})
+			}
+		}
+		return found, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryCapabilities() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	_, err := suite.keeper.Capabilities(goCtx, nil)
+	suite.Require().Error(err)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer-claimed"))
+	cap2, err := sk2.NewCapability(suite.ctx, "ica")
+	suite.Require().NoError(err)
+
+	owners1 := types.CapabilityOwners{Owners: []types.Owner{
+		types.NewOwner(banktypes.ModuleName, "transfer"),
+		types.NewOwner(stakingtypes.ModuleName, "transfer-claimed"),
+	}}
+	owners2 := types.CapabilityOwners{Owners: []types.Owner{types.NewOwner(stakingtypes.ModuleName, "ica")}}
+
+	res, err := suite.keeper.Capabilities(goCtx, &types.QueryCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GenesisOwners{
+		{Index: cap1.GetIndex(), IndexOwners: owners1},
+		{Index: cap2.GetIndex(), IndexOwners: owners2},
+	}, res.Capabilities)
+
+	res, err = suite.keeper.Capabilities(goCtx, &types.QueryCapabilitiesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capabilities, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.keeper.ModuleCapabilities(goCtx, &types.QueryModuleCapabilitiesRequest{Module: " "})
+	suite.Require().Error(err)
+
+	modRes, err := suite.keeper.ModuleCapabilities(goCtx, &types.QueryModuleCapabilitiesRequest{Module: stakingtypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Name: "transfer-claimed", Index: cap1.GetIndex(), Owners: owners1},
+		{Name: "ica", Index: cap2.GetIndex(), Owners: owners2},
+	}, modRes.Capabilities)
+
+	modRes, err = suite.keeper.ModuleCapabilities(goCtx, &types.QueryModuleCapabilitiesRequest{Module: banktypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Name: "transfer", Index: cap1.GetIndex(), Owners: owners1},
+	}, modRes.Capabilities)
+
+	modRes, err = suite.keeper.ModuleCapabilities(goCtx, &types.QueryModuleCapabilitiesRequest{Module: "ibc"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(modRes.Capabilities)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// RegisterInvariants registers all capability invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "owners", OwnersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the capability module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return OwnersInvariant(k)(ctx)
+	}
+}
+
+// OwnersInvariant checks that the in-memory forward and reverse mappings of
+// the capabilities match the persisted capability owners, i.e. that the memory
+// store is what InitMemStore would rebuild from the persisted owners.
+func OwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// the memory store is initialized in the first BeginBlock or InitChain
+		if !k.IsInitialized(ctx) {
+			return sdk.FormatInvariant(types.ModuleName, "owners",
+				"\tmemory store not initialized, nothing to check\n"), false
+		}
+
+		var msg strings.Builder
+
+		// expected in-memory values by key, built from the persisted owners
+		expected := make(map[string][]byte)
+		k.IterateCapabilityOwners(ctx, func(index uint64, owners types.CapabilityOwners) bool {
+			cap := k.capMap[index]
+			if cap == nil {
+				fmt.Fprintf(&msg, "\tcapability %d is missing from the in-memory capability map\n", index)
+			}
+
+			for _, owner := range owners.Owners {
+				expected[string(types.RevCapabilityKey(owner.Module, owner.Name))] = sdk.Uint64ToBigEndian(index)
+				if cap != nil {
+					expected[string(types.FwdCapabilityKey(owner.Module, cap))] = []byte(owner.Name)
+				}
+			}
+			return false
+		})
+
+		memStore := ctx.KVStore(k.memKey)
+		iterator := memStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			if bytes.Equal(key, types.KeyMemInitialized) {
+				continue
+			}
+
+			value, ok := expected[string(key)]
+			switch {
+			case !ok:
+				fmt.Fprintf(&msg, "\tin-memory mapping %q has no persisted owner\n", key)
+			case !bytes.Equal(value, iterator.Value()):
+				fmt.Fprintf(&msg, "\tin-memory mapping %q is %X, expected %X\n", key, iterator.Value(), value)
+			}
+			delete(expected, string(key))
+		}
+
+		missing := make([]string, 0, len(expected))
+		for key := range expected {
+			missing = append(missing, key)
+		}
+		sort.Strings(missing)
+		for _, key := range missing {
+			fmt.Fprintf(&msg, "\tin-memory mapping %q of a persisted owner is missing\n", key)
+		}
+
+		broken := msg.Len() > 0
+		return sdk.FormatInvariant(types.ModuleName, "owners", msg.String()), broken
+	}
+}
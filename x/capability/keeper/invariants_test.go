@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestOwnersInvariant() {
+	invariant := keeper.OwnersInvariant(*suite.keeper)
+	suite.keeper.InitMemStore(suite.ctx)
+
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+	cap2, err := sk2.NewCapability(suite.ctx, "ica")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ReleaseCapability(suite.ctx, cap2))
+
+	msg, broken := invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	memStore := suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expMsg   string
+	}{
+		{
+			"missing reverse mapping",
+			func() { memStore.Delete(types.RevCapabilityKey(banktypes.ModuleName, "transfer")) },
+			`in-memory mapping "bank/rev/transfer" of a persisted owner is missing`,
+		},
+		{
+			"wrong reverse mapping",
+			func() {
+				memStore.Set(types.RevCapabilityKey(banktypes.ModuleName, "transfer"), types.IndexToKey(cap2.GetIndex()))
+			},
+			`in-memory mapping "bank/rev/transfer" is`,
+		},
+		{
+			"wrong forward mapping",
+			func() { memStore.Set(types.FwdCapabilityKey(stakingtypes.ModuleName, cap1), []byte("other")) },
+			`in-memory mapping "staking/fwd/`,
+		},
+		{
+			"dangling mapping",
+			func() {
+				memStore.Set(types.RevCapabilityKey(stakingtypes.ModuleName, "ica"), types.IndexToKey(cap2.GetIndex()))
+			},
+			`in-memory mapping "staking/rev/ica" has no persisted owner`,
+		},
+		{
+			"owners not in memory",
+			func() {
+				suite.keeper.SetOwners(suite.ctx, 1000, types.CapabilityOwners{Owners: []types.Owner{types.NewOwner("ibc", "port")}})
+			},
+			"capability 1000 is missing from the in-memory capability map",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx
+			suite.ctx, _ = ctx.CacheContext()
+			memStore = suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+			defer func() { suite.ctx = ctx }()
+
+			tc.malleate()
+			msg, broken := invariant(suite.ctx)
+			suite.Require().True(broken)
+			suite.Require().Contains(msg, tc.expMsg)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// flag for circuit module
+const (
+	FlagLimitTypeURLs = "limit-type-urls"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        circuit.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		Long:                       "Trip and reset the circuit breaker of Msg type URLs, and grant circuit breaker permissions",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdAuthorizeCircuitBreaker(),
+		NewCmdTripCircuitBreaker(),
+		NewCmdResetCircuitBreaker(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdAuthorizeCircuitBreaker returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewCmdAuthorizeCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level]",
+		Short: "Grant circuit breaker permissions to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant circuit breaker permissions to an account. The sender must be a super admin.
+The level is one of: none (revokes the permissions), some-msgs, all-msgs, super-admin.
+With the some-msgs level, the Msg type URLs the grantee can trip and reset the circuit
+breaker for must be given with --%s.
+
+Examples:
+$ %s tx %s authorize cosmos1skjw... some-msgs --%s /cosmos.bank.v1beta1.MsgSend,/cosmos.authz.v1beta1.MsgExec --from mykey
+$ %s tx %s authorize cosmos1skjw... none --from mykey
+`, FlagLimitTypeURLs, version.AppName, circuit.ModuleName, FlagLimitTypeURLs, version.AppName, circuit.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			limitTypeURLs, err := cmd.Flags().GetStringSlice(FlagLimitTypeURLs)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgAuthorizeCircuitBreaker(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				circuit.Permissions{Level: level, LimitTypeUrls: limitTypeURLs},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagLimitTypeURLs, []string{}, "Comma-separated list of the Msg type URLs the grantee can trip and reset the circuit breaker for, with the some-msgs level")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdTripCircuitBreaker returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg_type_url]...",
+		Short: "Disable the execution of the given Msg type URLs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the execution of the given Msg type URLs, both in transactions and when
+dispatched by other messages, e.g. by authz or group.
+
+Example:
+$ %s tx %s trip /cosmos.authz.v1beta1.MsgExec /cosmos.nft.v1beta1.MsgSend --from mykey
+`, version.AppName, circuit.ModuleName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdResetCircuitBreaker returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg_type_url]...",
+		Short: "Enable again the execution of the given Msg type URLs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable again the execution of the given Msg type URLs disabled by the circuit breaker.
+
+Example:
+$ %s tx %s reset /cosmos.authz.v1beta1.MsgExec --from mykey
+`, version.AppName, circuit.ModuleName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseLevel parses a permission level from its CLI name, e.g. some-msgs, or
+// its proto enum name, e.g. LEVEL_SOME_MSGS.
+func parseLevel(s string) (circuit.Permissions_Level, error) {
+	switch strings.ToLower(s) {
+	case "none":
+		return circuit.LevelNone, nil
+	case "some-msgs":
+		return circuit.LevelSomeMsgs, nil
+	case "all-msgs":
+		return circuit.LevelAllMsgs, nil
+	case "super-admin":
+		return circuit.LevelSuperAdmin, nil
+	}
+
+	if level, ok := circuit.Permissions_Level_value[strings.ToUpper(s)]; ok {
+		return circuit.Permissions_Level(level), nil
+	}
+	return circuit.LevelNone, fmt.Errorf("invalid permission level %q, expected one of none, some-msgs, all-msgs, super-admin", s)
+}
@@ -0,0 +1,14 @@
+package circuit
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 2, "invalid permissions")
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 3, "invalid msg type url")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 4, "not authorized to trip or reset the circuit breaker")
+	ErrDisabled           = sdkerrors.Register(ModuleName, 5, "msg type url is disabled by the circuit breaker")
+	ErrNotDisabled        = sdkerrors.Register(ModuleName, 6, "msg type url is not disabled by the circuit breaker")
+)
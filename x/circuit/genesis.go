@@ -0,0 +1,40 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesisState returns the default genesis state of the circuit module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.AccountPermissions))
+	for _, ap := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(ap.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+		}
+		if seen[ap.Address] {
+			return ErrInvalidPermissions.Wrapf("duplicate permissions for account %s", ap.Address)
+		}
+		seen[ap.Address] = true
+
+		if ap.Permissions.Level == LevelNone {
+			return ErrInvalidPermissions.Wrapf("account %s has no permissions", ap.Address)
+		}
+		if err := ap.Permissions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(data.DisabledTypeUrls) > 0 {
+		if err := ValidateMsgTypeURLs(data.DisabledTypeUrls); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
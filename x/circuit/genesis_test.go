@@ -0,0 +1,92 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name    string
+		genesis circuit.GenesisState
+		expErr  bool
+	}{
+		{"default", *circuit.DefaultGenesisState(), false},
+		{
+			"valid",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelSomeMsgs, LimitTypeUrls: []string{msgSend}}},
+				},
+				DisabledTypeUrls: []string{msgSend},
+			},
+			false,
+		},
+		{
+			"invalid address",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: "invalid", Permissions: circuit.Permissions{Level: circuit.LevelAllMsgs}},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate account",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelAllMsgs}},
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelSuperAdmin}},
+				},
+			},
+			true,
+		},
+		{
+			"no permissions",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelNone}},
+				},
+			},
+			true,
+		},
+		{
+			"some msgs without limit type urls",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelSomeMsgs}},
+				},
+			},
+			true,
+		},
+		{
+			"limit type urls with all msgs",
+			circuit.GenesisState{
+				AccountPermissions: []circuit.AccountPermissions{
+					{Address: addr.String(), Permissions: circuit.Permissions{Level: circuit.LevelAllMsgs, LimitTypeUrls: []string{msgSend}}},
+				},
+			},
+			true,
+		},
+		{"duplicate disabled type url", circuit.GenesisState{DisabledTypeUrls: []string{msgSend, msgSend}}, true},
+		{"invalid disabled type url", circuit.GenesisState{DisabledTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"}}, true},
+		{"circuit msg disabled", circuit.GenesisState{DisabledTypeUrls: []string{"/cosmos.circuit.v1.MsgResetCircuitBreaker"}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := circuit.ValidateGenesis(tc.genesis)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *circuit.GenesisState) {
+	for _, ap := range data.AccountPermissions {
+		k.SetPermissions(ctx, sdk.MustAccAddressFromBech32(ap.Address), ap.Permissions)
+	}
+	for _, typeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *circuit.GenesisState {
+	var accountPermissions []circuit.AccountPermissions
+	k.IterateAccountPermissions(ctx, func(addr sdk.AccAddress, perms circuit.Permissions) bool {
+		accountPermissions = append(accountPermissions, circuit.AccountPermissions{
+			Address:     addr.String(),
+			Permissions: perms,
+		})
+		return false
+	})
+
+	return &circuit.GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   k.GetDisabledMsgs(ctx),
+	}
+}
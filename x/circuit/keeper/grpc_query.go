@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ circuit.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account.
+func (k Keeper) Account(goCtx context.Context, req *circuit.QueryAccountRequest) (*circuit.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &circuit.QueryAccountResponse{Permissions: k.GetPermissions(ctx, addr)}, nil
+}
+
+// Accounts returns all the accounts with circuit breaker permissions.
+func (k Keeper) Accounts(goCtx context.Context, req *circuit.QueryAccountsRequest) (*circuit.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), AccountPermissionsKey)
+
+	var accounts []circuit.AccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var perms circuit.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+
+		// the key is the length-prefixed account address
+		accounts = append(accounts, circuit.AccountPermissions{
+			Address:     sdk.AccAddress(key[1:]).String(),
+			Permissions: perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &circuit.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the disabled Msg type URLs.
+func (k Keeper) DisabledList(goCtx context.Context, req *circuit.QueryDisabledListRequest) (*circuit.QueryDisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &circuit.QueryDisabledListResponse{DisabledList: k.GetDisabledMsgs(ctx)}, nil
+}
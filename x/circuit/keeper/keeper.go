@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	router    *baseapp.MsgServiceRouter
+	authority string
+}
+
+var (
+	_ ante.CircuitBreaker    = Keeper{}
+	_ baseapp.CircuitBreaker = Keeper{}
+)
+
+// NewKeeper creates a new circuit Keeper instance. The authority, usually the
+// gov module account, can always trip and reset the circuit breaker and grant
+// permissions to other accounts.
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, router *baseapp.MsgServiceRouter, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		router:    router,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", circuit.ModuleName))
+}
+
+// IsAllowed returns whether the execution of the given Msg type URL is
+// allowed, i.e. the circuit breaker is not tripped for it.
+func (k Keeper) IsAllowed(ctx sdk.Context, typeURL string) bool {
+	return !ctx.KVStore(k.storeKey).Has(disabledTypeURLStoreKey(typeURL))
+}
+
+// DisableMsg disables the execution of the given Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Set(disabledTypeURLStoreKey(typeURL), Placeholder)
+}
+
+// EnableMsg enables again the execution of the given Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Delete(disabledTypeURLStoreKey(typeURL))
+}
+
+// GetDisabledMsgs returns the disabled Msg type URLs.
+func (k Keeper) GetDisabledMsgs(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DisabledTypeURLKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var typeURLs []string
+	for ; iterator.Valid(); iterator.Next() {
+		typeURLs = append(typeURLs, string(iterator.Key()))
+	}
+	return typeURLs
+}
+
+// GetPermissions returns the circuit breaker permissions of an account.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) circuit.Permissions {
+	bz := ctx.KVStore(k.storeKey).Get(accountPermissionsStoreKey(addr))
+	if bz == nil {
+		return circuit.Permissions{Level: circuit.LevelNone}
+	}
+
+	var perms circuit.Permissions
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms
+}
+
+// SetPermissions sets the circuit breaker permissions of an account. The
+// LevelNone level removes the permissions of the account.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms circuit.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == circuit.LevelNone {
+		store.Delete(accountPermissionsStoreKey(addr))
+		return
+	}
+	store.Set(accountPermissionsStoreKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IterateAccountPermissions iterates over the permissions of all the accounts
+// and calls the provided callback. The iteration stops when the callback
+// returns true.
+func (k Keeper) IterateAccountPermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms circuit.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), AccountPermissionsKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var perms circuit.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+		// the key is the length-prefixed account address
+		addr := sdk.AccAddress(iterator.Key()[1:])
+		if cb(addr, perms) {
+			break
+		}
+	}
+}
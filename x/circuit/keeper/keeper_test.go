@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	msgSendTypeURL  = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiTypeURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	msgServer   circuit.MsgServer
+	queryClient circuit.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	circuit.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.msgServer = keeper.NewMsgServerImpl(app.CircuitKeeper)
+	s.queryClient = circuit.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestAuthorizeCircuitBreaker() {
+	admin, user, other := s.addrs[0].String(), s.addrs[1].String(), s.addrs[2].String()
+	someMsgs := circuit.Permissions{Level: circuit.LevelSomeMsgs, LimitTypeUrls: []string{msgSendTypeURL}}
+
+	// only the authority or a super admin can grant permissions
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(admin, user, someMsgs))
+	s.Require().ErrorIs(err, circuit.ErrUnauthorized)
+
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.authority, admin, circuit.Permissions{Level: circuit.LevelSuperAdmin}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(admin, user, someMsgs))
+	s.Require().NoError(err)
+
+	// a LEVEL_SOME_MSGS account cannot grant permissions
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(user, other, someMsgs))
+	s.Require().ErrorIs(err, circuit.ErrUnauthorized)
+
+	res, err := s.queryClient.Account(s.ctx, &circuit.QueryAccountRequest{Address: user})
+	s.Require().NoError(err)
+	s.Require().Equal(someMsgs, res.Permissions)
+
+	accounts, err := s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(accounts.Accounts, 2)
+
+	// the LEVEL_NONE_UNSPECIFIED level revokes the permissions
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(admin, user, circuit.Permissions{}))
+	s.Require().NoError(err)
+	res, err = s.queryClient.Account(s.ctx, &circuit.QueryAccountRequest{Address: user})
+	s.Require().NoError(err)
+	s.Require().Equal(circuit.LevelNone, res.Permissions.Level)
+
+	accounts, err = s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]circuit.AccountPermissions{
+		{Address: admin, Permissions: circuit.Permissions{Level: circuit.LevelSuperAdmin}},
+	}, accounts.Accounts)
+}
+
+func (s *TestSuite) TestTripAndResetCircuitBreaker() {
+	user, other := s.addrs[0].String(), s.addrs[1].String()
+	s.app.CircuitKeeper.SetPermissions(s.ctx, s.addrs[0], circuit.Permissions{Level: circuit.LevelSomeMsgs, LimitTypeUrls: []string{msgSendTypeURL}})
+
+	testCases := []struct {
+		name   string
+		msg    *circuit.MsgTripCircuitBreaker
+		expErr error
+	}{
+		{"no permissions", circuit.NewMsgTripCircuitBreaker(other, []string{msgSendTypeURL}), circuit.ErrUnauthorized},
+		{"not in the limit type urls", circuit.NewMsgTripCircuitBreaker(user, []string{msgSendTypeURL, msgMultiTypeURL}), circuit.ErrUnauthorized},
+		{"unknown msg", circuit.NewMsgTripCircuitBreaker(s.authority, []string{"/cosmos.bank.v1beta1.MsgUnknown"}), circuit.ErrInvalidMsgTypeURL},
+		{"limited account", circuit.NewMsgTripCircuitBreaker(user, []string{msgSendTypeURL}), nil},
+		{"already disabled", circuit.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}), circuit.ErrDisabled},
+		{"authority", circuit.NewMsgTripCircuitBreaker(s.authority, []string{msgMultiTypeURL}), nil},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.TripCircuitBreaker(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendTypeURL))
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgMultiTypeURL))
+	res, err := s.queryClient.DisabledList(s.ctx, &circuit.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{msgSendTypeURL, msgMultiTypeURL}, res.DisabledList)
+
+	// the limited account can only reset its own msg type urls
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(user, []string{msgMultiTypeURL}))
+	s.Require().ErrorIs(err, circuit.ErrUnauthorized)
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(user, []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.authority, []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, circuit.ErrNotDisabled)
+
+	s.Require().True(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendTypeURL))
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgMultiTypeURL))
+}
+
+func (s *TestSuite) TestDispatchedMsgs() {
+	from, to := s.addrs[0], s.addrs[1]
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+
+	// messages dispatched by authz are executed through the msg service router
+	_, err := s.app.AuthzKeeper.DispatchActions(s.ctx, from, []sdk.Msg{msg})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+
+	_, err = s.app.AuthzKeeper.DispatchActions(s.ctx, from, []sdk.Msg{msg})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestGenesis() {
+	genesis := &circuit.GenesisState{
+		AccountPermissions: []circuit.AccountPermissions{
+			{Address: s.addrs[0].String(), Permissions: circuit.Permissions{Level: circuit.LevelSuperAdmin}},
+			{Address: s.addrs[1].String(), Permissions: circuit.Permissions{Level: circuit.LevelSomeMsgs, LimitTypeUrls: []string{msgSendTypeURL}}},
+		},
+		DisabledTypeUrls: []string{msgMultiTypeURL, msgSendTypeURL},
+	}
+	s.Require().NoError(circuit.ValidateGenesis(*genesis))
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendTypeURL))
+
+	exported := s.app.CircuitKeeper.ExportGenesis(s.ctx)
+	s.Require().ElementsMatch(genesis.AccountPermissions, exported.AccountPermissions)
+	s.Require().Equal(genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+}
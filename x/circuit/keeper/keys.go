@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var (
+	AccountPermissionsKey = []byte{0x01}
+	DisabledTypeURLKey    = []byte{0x02}
+
+	Placeholder = []byte{0x01}
+)
+
+// StoreKey is the store key string for circuit
+const StoreKey = circuit.ModuleName
+
+// accountPermissionsStoreKey returns the byte representation of the
+// permissions key of an account.
+func accountPermissionsStoreKey(addr []byte) []byte {
+	return append(append([]byte{}, AccountPermissionsKey...), address.MustLengthPrefix(addr)...)
+}
+
+// disabledTypeURLStoreKey returns the byte representation of the key of a
+// disabled Msg type URL.
+func disabledTypeURLStoreKey(typeURL string) []byte {
+	return append(append([]byte{}, DisabledTypeURLKey...), typeURL...)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) circuit.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ circuit.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker Msg service.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *circuit.MsgAuthorizeCircuitBreaker) (*circuit.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Granter != k.authority && k.GetPermissions(ctx, granter).Level != circuit.LevelSuperAdmin {
+		return nil, sdkerrors.Wrapf(circuit.ErrUnauthorized, "%s is neither the module authority nor a super admin", msg.Granter)
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetPermissions(ctx, grantee, msg.Permissions)
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventAuthorizeCircuitBreaker{
+		Granter:     msg.Granter,
+		Grantee:     msg.Grantee,
+		Permissions: msg.Permissions,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &circuit.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker Msg service.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *circuit.MsgTripCircuitBreaker) (*circuit.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCanTrip(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if k.router.HandlerByTypeURL(typeURL) == nil {
+			return nil, sdkerrors.Wrapf(circuit.ErrInvalidMsgTypeURL, "no message handler for %s", typeURL)
+		}
+		if !k.IsAllowed(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrDisabled, typeURL)
+		}
+		k.DisableMsg(ctx, typeURL)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventTripCircuitBreaker{
+		Authority:   msg.Authority,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &circuit.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker Msg service.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *circuit.MsgResetCircuitBreaker) (*circuit.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCanTrip(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if k.IsAllowed(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrNotDisabled, typeURL)
+		}
+		k.EnableMsg(ctx, typeURL)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventResetCircuitBreaker{
+		Authority:   msg.Authority,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &circuit.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// checkCanTrip checks that the given account is the module authority or has
+// the permissions to trip and reset the circuit breaker for all the given Msg
+// type URLs.
+func (k Keeper) checkCanTrip(ctx sdk.Context, authority string, msgTypeURLs []string) error {
+	if authority == k.authority {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		return err
+	}
+
+	perms := k.GetPermissions(ctx, addr)
+	for _, typeURL := range msgTypeURLs {
+		if !perms.CanTrip(typeURL) {
+			return sdkerrors.Wrapf(circuit.ErrUnauthorized, "%s cannot trip or reset the circuit breaker for %s", authority, typeURL)
+		}
+	}
+
+	return nil
+}
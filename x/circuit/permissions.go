@@ -0,0 +1,74 @@
+package circuit
+
+import (
+	"strings"
+)
+
+// msgTypeURLPrefix is the type URL prefix of the x/circuit messages, which can
+// never be disabled so that a tripped circuit breaker can always be reset.
+const msgTypeURLPrefix = "/cosmos.circuit.v1."
+
+// ValidateMsgTypeURLs checks that the given Msg type URLs are not empty, have
+// no duplicates and do not target the x/circuit messages.
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return ErrInvalidMsgTypeURL.Wrap("msg type urls cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		if err := validateMsgTypeURL(url); err != nil {
+			return err
+		}
+		if seen[url] {
+			return ErrInvalidMsgTypeURL.Wrapf("duplicate msg type url %s", url)
+		}
+		seen[url] = true
+	}
+
+	return nil
+}
+
+func validateMsgTypeURL(url string) error {
+	if !strings.HasPrefix(url, "/") || len(url) == 1 {
+		return ErrInvalidMsgTypeURL.Wrapf("%q must start with /, e.g. /cosmos.bank.v1beta1.MsgSend", url)
+	}
+	if strings.HasPrefix(url, msgTypeURLPrefix) {
+		return ErrInvalidMsgTypeURL.Wrapf("%s cannot be disabled", url)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the permissions.
+func (p Permissions) Validate() error {
+	switch p.Level {
+	case LevelSomeMsgs:
+		if err := ValidateMsgTypeURLs(p.LimitTypeUrls); err != nil {
+			return ErrInvalidPermissions.Wrapf("invalid limit type urls: %s", err)
+		}
+	case LevelNone, LevelAllMsgs, LevelSuperAdmin:
+		if len(p.LimitTypeUrls) != 0 {
+			return ErrInvalidPermissions.Wrapf("limit type urls can only be set with the %s level", LevelSomeMsgs)
+		}
+	default:
+		return ErrInvalidPermissions.Wrapf("unknown level %d", p.Level)
+	}
+
+	return nil
+}
+
+// CanTrip returns whether the permissions allow to trip and reset the circuit
+// breaker for the given Msg type URL.
+func (p Permissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case LevelAllMsgs, LevelSuperAdmin:
+		return true
+	case LevelSomeMsgs:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
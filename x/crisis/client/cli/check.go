@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+const (
+	// FlagSkipGenesisInvariants skips the invariants check when initializing
+	// the chain from genesis.
+	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+
+	FlagGenesis = "genesis"
+	FlagRoutes  = "routes"
+)
+
+// InvariantsApp defines the application interface required to check the
+// invariants offline.
+type InvariantsApp interface {
+	abci.Application
+
+	// CheckInvariants runs the invariants with the given full routes, or all
+	// the registered invariants if none is given, on the latest committed
+	// state.
+	CheckInvariants(routes []string) ([]types.InvariantResult, error)
+}
+
+// InvariantsAppCreator creates an application whose state is loaded at the
+// given height, or at the latest height if height is -1.
+type InvariantsAppCreator func(logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions) (InvariantsApp, error)
+
+// CheckInvariantsCmd returns a command checking the invariants without a
+// running node, either against an exported genesis file or against the
+// application state at a given height.
+func CheckInvariantsCmd(appCreator InvariantsAppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check the invariants against an exported state or a historical height",
+		Long: `Check the registered invariants without a running node. The node must be stopped.
+
+By default, the invariants are checked against the application state at the
+given height, or the latest height, of the node at --home. With --genesis, the
+invariants are checked against the state of the given genesis file instead,
+e.g. a state exported with the export command.
+
+The command exits with an error if any invariant is broken.
+`,
+		Example: fmt.Sprintf(`$ %[1]s check-invariants --height 1000
+$ %[1]s check-invariants --genesis exported.json --routes bank/total-supply,staking/module-accounts`, "<appd>"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			genesisFile, _ := cmd.Flags().GetString(FlagGenesis)
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			routes, _ := cmd.Flags().GetStringSlice(FlagRoutes)
+			output, _ := cmd.Flags().GetString(tmcli.OutputFlag)
+
+			// The invariants are reported below, they must not panic while
+			// initializing the chain from genesis.
+			appOpts := skipGenesisInvariantsOptions{serverCtx.Viper}
+
+			var app InvariantsApp
+			if genesisFile != "" {
+				genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+				if err != nil {
+					return err
+				}
+
+				app, err = appCreator(serverCtx.Logger, dbm.NewMemDB(), -1, appOpts)
+				if err != nil {
+					return err
+				}
+
+				consensusParams := genDoc.ConsensusParams.ToProto()
+				app.InitChain(abci.RequestInitChain{
+					Time:            genDoc.GenesisTime,
+					ChainId:         genDoc.ChainID,
+					ConsensusParams: &consensusParams,
+					AppStateBytes:   genDoc.AppState,
+					InitialHeight:   genDoc.InitialHeight,
+				})
+				app.Commit()
+			} else {
+				home, _ := cmd.Flags().GetString(flags.FlagHome)
+				db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				app, err = appCreator(serverCtx.Logger, db, height, appOpts)
+				if err != nil {
+					return err
+				}
+			}
+
+			results, err := app.CheckInvariants(routes)
+			if err != nil {
+				return err
+			}
+
+			broken := 0
+			for _, res := range results {
+				if res.Broken {
+					broken++
+				}
+			}
+
+			if output == "json" {
+				bz, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else {
+				for _, res := range results {
+					status := "ok"
+					if res.Broken {
+						status = "BROKEN"
+					}
+					cmd.Printf("%-6s %s\n", status, res.Route)
+					if res.Broken {
+						cmd.Println(res.Message)
+					}
+				}
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d out of %d invariants broken", broken, len(results))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Check the invariants at a particular height (-1 means latest height)")
+	cmd.Flags().String(FlagGenesis, "", "Check the invariants against the state of the given genesis file instead of the application database")
+	cmd.Flags().StringSlice(FlagRoutes, []string{}, "Comma-separated list of invariant routes to check, e.g. bank/total-supply (all invariants if empty)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// skipGenesisInvariantsOptions overrides the given application options so that
+// the invariants are not asserted when initializing the chain from genesis.
+type skipGenesisInvariantsOptions struct {
+	servertypes.AppOptions
+}
+
+func (o skipGenesisInvariantsOptions) Get(key string) interface{} {
+	if key == FlagSkipGenesisInvariants {
+		return true
+	}
+	return o.AppOptions.Get(key)
+}
@@ -8,10 +8,14 @@ import (
 // new crisis genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	k.SetConstantFee(ctx, data.ConstantFee)
+	k.SetInvariantCheckPeriods(ctx, data.InvariantCheckPeriods)
+	k.SetInvariantCheckMode(ctx, data.InvariantCheckMode)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	constantFee := k.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	checkPeriods := k.GetInvariantCheckPeriods(ctx)
+	checkMode := k.GetInvariantCheckMode(ctx)
+	return types.NewGenesisState(constantFee, checkPeriods, checkMode)
 }
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InvariantRoutes returns the registered invariant routes with their check period.
+func (k Keeper) InvariantRoutes(goCtx context.Context, _ *types.QueryInvariantRoutesRequest) (*types.QueryInvariantRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	periods := k.invariantCheckPeriods(ctx)
+
+	routes := make([]types.InvariantRouteInfo, len(k.routes))
+	for i, ir := range k.routes {
+		routes[i] = types.InvariantRouteInfo{
+			ModuleName:  ir.ModuleName,
+			Route:       ir.Route,
+			CheckPeriod: periods[ir.FullRoute()],
+		}
+	}
+
+	return &types.QueryInvariantRoutesResponse{
+		Routes:    routes,
+		CheckMode: k.GetInvariantCheckMode(ctx),
+	}, nil
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -67,26 +70,118 @@ func (k Keeper) Invariants() []sdk.Invariant {
 }
 
 // AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// the method panics, unless the invariant check mode is alert.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	k.assertInvariants(ctx, k.Routes())
+}
+
+// AssertScheduledInvariants asserts the registered invariants whose check
+// period divides the current block height. If any invariant fails, the method
+// panics, unless the invariant check mode is alert.
+func (k Keeper) AssertScheduledInvariants(ctx sdk.Context) {
+	periods := k.invariantCheckPeriods(ctx)
+
+	var invarRoutes []types.InvarRoute
+	for _, ir := range k.Routes() {
+		period := periods[ir.FullRoute()]
+		if period == 0 || ctx.BlockHeight()%int64(period) != 0 {
+			continue
+		}
+		invarRoutes = append(invarRoutes, ir)
+	}
+
+	if len(invarRoutes) == 0 {
+		return
+	}
+	k.assertInvariants(ctx, invarRoutes)
+}
+
+func (k Keeper) assertInvariants(ctx sdk.Context, invarRoutes []types.InvarRoute) {
 	logger := k.Logger(ctx)
 
 	start := time.Now()
-	invarRoutes := k.Routes()
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
 		if res, stop := ir.Invar(ctx); stop {
-			// TODO: Include app name as part of context to allow for this to be
-			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+			k.handleBrokenInvariant(ctx, ir, res)
 		}
 	}
 
 	diff := time.Since(start)
-	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+	logger.Info("asserted invariants", "count", n, "duration", diff, "height", ctx.BlockHeight())
+}
+
+// handleBrokenInvariant panics with the broken invariant message in halt mode.
+// In alert mode, it logs the message, increments the broken invariants
+// counter and emits an event instead.
+func (k Keeper) handleBrokenInvariant(ctx sdk.Context, ir types.InvarRoute, res string) {
+	if k.GetInvariantCheckMode(ctx) != types.InvariantCheckModeAlert {
+		// TODO: Include app name as part of context to allow for this to be
+		// variable.
+		panic(fmt.Errorf("invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+	}
+
+	k.Logger(ctx).Error("invariant broken", "name", ir.FullRoute(), "height", ctx.BlockHeight(), "msg", res)
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "invariant_broken"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+			sdk.NewAttribute(types.AttributeKeyMessage, res),
+		),
+	)
+}
+
+// CheckInvariants runs the invariants with the given full routes, or all the
+// registered invariants if none is given, and returns their results. Unlike
+// AssertInvariants, it never panics on a broken invariant.
+func (k Keeper) CheckInvariants(ctx sdk.Context, fullRoutes ...string) ([]types.InvariantResult, error) {
+	invarRoutes := k.Routes()
+	if len(fullRoutes) > 0 {
+		byRoute := make(map[string]types.InvarRoute, len(invarRoutes))
+		for _, ir := range invarRoutes {
+			byRoute[ir.FullRoute()] = ir
+		}
+
+		invarRoutes = make([]types.InvarRoute, len(fullRoutes))
+		for i, route := range fullRoutes {
+			ir, ok := byRoute[route]
+			if !ok {
+				return nil, sdkerrors.Wrap(types.ErrUnknownInvariant, route)
+			}
+			invarRoutes[i] = ir
+		}
+	}
+
+	results := make([]types.InvariantResult, len(invarRoutes))
+	for i, ir := range invarRoutes {
+		res, broken := ir.Invar(ctx)
+		results[i] = types.InvariantResult{Route: ir.FullRoute(), Broken: broken, Message: res}
+	}
+	return results, nil
+}
+
+// invariantCheckPeriods returns the check period of each registered invariant
+// by full route: the period set in the InvariantCheckPeriods param if any, or
+// the node's invariant check period.
+func (k Keeper) invariantCheckPeriods(ctx sdk.Context) map[string]uint64 {
+	periods := make(map[string]uint64, len(k.routes))
+	for _, ir := range k.routes {
+		periods[ir.FullRoute()] = uint64(k.invCheckPeriod)
+	}
+	for _, p := range k.GetInvariantCheckPeriods(ctx) {
+		if _, ok := periods[p.Route]; ok {
+			periods[p.Route] = p.Period
+		}
+	}
+	return periods
 }
 
 // InvCheckPeriod returns the invariant checks period.
@@ -48,3 +48,88 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestAssertScheduledInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", true })
+	app.CrisisKeeper.SetInvariantCheckPeriods(ctx, []types.InvariantCheckPeriod{{Route: "testModule/testRoute", Period: 3}})
+
+	// the other invariants are checked with the node's period of 5
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertScheduledInvariants(ctx.WithBlockHeight(5)) })
+	require.Panics(t, func() { app.CrisisKeeper.AssertScheduledInvariants(ctx.WithBlockHeight(6)) })
+
+	// a period of 0 disables the scheduled checks
+	app.CrisisKeeper.SetInvariantCheckPeriods(ctx, []types.InvariantCheckPeriod{{Route: "testModule/testRoute", Period: 0}})
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertScheduledInvariants(ctx.WithBlockHeight(6)) })
+}
+
+func TestAssertInvariantsAlertMode(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{}).WithEventManager(sdk.NewEventManager())
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+	app.CrisisKeeper.SetInvariantCheckMode(ctx, types.InvariantCheckModeAlert)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeInvariantBroken, events[0].Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: types.AttributeKeyRoute, Value: "testModule/testRoute"},
+		{Key: types.AttributeKeyMessage, Value: "broken"},
+	}, events[0].Attributes)
+}
+
+func TestCheckInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+
+	results, err := app.CrisisKeeper.CheckInvariants(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, len(app.CrisisKeeper.Routes()))
+
+	results, err = app.CrisisKeeper.CheckInvariants(ctx, "testModule/testRoute2", "testModule/testRoute1")
+	require.NoError(t, err)
+	require.Equal(t, []types.InvariantResult{
+		{Route: "testModule/testRoute2", Broken: true, Message: "broken"},
+		{Route: "testModule/testRoute1", Broken: false, Message: ""},
+	}, results)
+
+	_, err = app.CrisisKeeper.CheckInvariants(ctx, "testModule/unknown")
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+}
+
+func TestGRPCQueryInvariantRoutes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.SetInvariantCheckPeriods(ctx, []types.InvariantCheckPeriod{{Route: "testModule/testRoute", Period: 10}})
+	app.CrisisKeeper.SetInvariantCheckMode(ctx, types.InvariantCheckModeAlert)
+
+	res, err := app.CrisisKeeper.InvariantRoutes(sdk.WrapSDKContext(ctx), &types.QueryInvariantRoutesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.InvariantCheckModeAlert, res.CheckMode)
+	require.Len(t, res.Routes, len(app.CrisisKeeper.Routes()))
+	for _, r := range res.Routes {
+		if r.ModuleName == "testModule" {
+			require.Equal(t, uint64(10), r.CheckPeriod)
+		} else {
+			require.Equal(t, uint64(app.CrisisKeeper.InvCheckPeriod()), r.CheckPeriod)
+		}
+	}
+}
@@ -29,9 +29,11 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 
 	var res string
 	var stop bool
+	var route types.InvarRoute
 	for _, invarRoute := range k.Routes() {
 		if invarRoute.FullRoute() == msgFullRoute {
 			res, stop = invarRoute.Invar(cacheCtx)
+			route = invarRoute
 			found = true
 
 			break
@@ -43,11 +45,16 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 	}
 
 	if stop {
-		// Currently, because the chain halts here, this transaction will never be included in the
-		// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
+		if k.GetInvariantCheckMode(ctx) != types.InvariantCheckModeAlert {
+			// Currently, because the chain halts here, this transaction will never be included in the
+			// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
 
-		// TODO replace with circuit breaker
-		panic(res)
+			// TODO replace with circuit breaker
+			panic(res)
+		}
+
+		// In alert mode, the broken invariant is reported and the transaction succeeds.
+		k.handleBrokenInvariant(ctx, route, res)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
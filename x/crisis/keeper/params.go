@@ -15,3 +15,28 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetInvariantCheckPeriods returns the per invariant route check periods from the paramSpace
+func (k Keeper) GetInvariantCheckPeriods(ctx sdk.Context) (checkPeriods []types.InvariantCheckPeriod) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyInvariantCheckPeriods, &checkPeriods)
+	return
+}
+
+// SetInvariantCheckPeriods sets the per invariant route check periods in the paramSpace
+func (k Keeper) SetInvariantCheckPeriods(ctx sdk.Context, checkPeriods []types.InvariantCheckPeriod) {
+	if checkPeriods == nil {
+		checkPeriods = []types.InvariantCheckPeriod{}
+	}
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantCheckPeriods, checkPeriods)
+}
+
+// GetInvariantCheckMode returns the invariant check mode from the paramSpace
+func (k Keeper) GetInvariantCheckMode(ctx sdk.Context) (checkMode types.InvariantCheckMode) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyInvariantCheckMode, &checkMode)
+	return
+}
+
+// SetInvariantCheckMode sets the invariant check mode in the paramSpace
+func (k Keeper) SetInvariantCheckMode(ctx sdk.Context, checkMode types.InvariantCheckMode) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantCheckMode, checkMode)
+}
@@ -0,0 +1,22 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore migrates the x/crisis params from consensus version 1 to 2 by
+// adding the per-route InvariantCheckPeriods, empty by default so that every
+// route keeps the node-wide check period, and the InvariantCheckMode, which
+// halts the chain on a broken invariant by default.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyInvariantCheckPeriods, types.DefaultInvariantCheckPeriods)
+	paramstore.Set(ctx, types.ParamStoreKeyInvariantCheckMode, types.DefaultInvariantCheckMode)
+
+	return nil
+}
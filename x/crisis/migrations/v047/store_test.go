@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047crisis "github.com/cosmos/cosmos-sdk/x/crisis/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey("params")
+	tParamsKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, tParamsKey, "crisis")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyInvariantCheckPeriods))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyInvariantCheckMode))
+
+	// Run migrations.
+	err := v047crisis.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set to their defaults.
+	var checkPeriods []types.InvariantCheckPeriod
+	var checkMode types.InvariantCheckMode
+	paramstore.Get(ctx, types.ParamStoreKeyInvariantCheckPeriods, &checkPeriods)
+	paramstore.Get(ctx, types.ParamStoreKeyInvariantCheckMode, &checkMode)
+	require.Empty(t, checkPeriods)
+	require.Equal(t, types.InvariantCheckModeHalt, checkMode)
+}
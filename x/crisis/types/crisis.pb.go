@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/crisis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantCheckMode defines what happens when an invariant is broken.
+type InvariantCheckMode int32
+
+const (
+	// INVARIANT_CHECK_MODE_HALT halts the chain when an invariant is broken.
+	InvariantCheckModeHalt InvariantCheckMode = 0
+	// INVARIANT_CHECK_MODE_ALERT emits an event, logs an error and increments a
+	// metric when an invariant is broken, without halting the chain.
+	InvariantCheckModeAlert InvariantCheckMode = 1
+)
+
+var InvariantCheckMode_name = map[int32]string{
+	0: "INVARIANT_CHECK_MODE_HALT",
+	1: "INVARIANT_CHECK_MODE_ALERT",
+}
+
+var InvariantCheckMode_value = map[string]int32{
+	"INVARIANT_CHECK_MODE_HALT":  0,
+	"INVARIANT_CHECK_MODE_ALERT": 1,
+}
+
+func (x InvariantCheckMode) String() string {
+	return proto.EnumName(InvariantCheckMode_name, int32(x))
+}
+
+func (InvariantCheckMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+
+// InvariantCheckPeriod defines the number of blocks between two checks of an
+// invariant route, overriding the node's invariant check period.
+type InvariantCheckPeriod struct {
+	// route is the full invariant route, i.e. `{module_name}/{invariant_route}`.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// period is the number of blocks between two checks of the invariant. A zero
+	// period disables the invariant check.
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *InvariantCheckPeriod) Reset()         { *m = InvariantCheckPeriod{} }
+func (m *InvariantCheckPeriod) String() string { return proto.CompactTextString(m) }
+func (*InvariantCheckPeriod) ProtoMessage()    {}
+func (*InvariantCheckPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+func (m *InvariantCheckPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantCheckPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantCheckPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantCheckPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantCheckPeriod.Merge(m, src)
+}
+func (m *InvariantCheckPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantCheckPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantCheckPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantCheckPeriod proto.InternalMessageInfo
+
+func (m *InvariantCheckPeriod) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantCheckPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// InvariantRouteInfo describes a registered invariant route.
+type InvariantRouteInfo struct {
+	// module_name is the name of the module registering the invariant.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// route is the route of the invariant within its module.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// check_period is the number of blocks between two checks of the invariant,
+	// zero if the invariant is not checked in EndBlock.
+	CheckPeriod uint64 `protobuf:"varint,3,opt,name=check_period,json=checkPeriod,proto3" json:"check_period,omitempty"`
+}
+
+func (m *InvariantRouteInfo) Reset()         { *m = InvariantRouteInfo{} }
+func (m *InvariantRouteInfo) String() string { return proto.CompactTextString(m) }
+func (*InvariantRouteInfo) ProtoMessage()    {}
+func (*InvariantRouteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{1}
+}
+func (m *InvariantRouteInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantRouteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantRouteInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantRouteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantRouteInfo.Merge(m, src)
+}
+func (m *InvariantRouteInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantRouteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantRouteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantRouteInfo proto.InternalMessageInfo
+
+func (m *InvariantRouteInfo) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantRouteInfo) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantRouteInfo) GetCheckPeriod() uint64 {
+	if m != nil {
+		return m.CheckPeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.crisis.v1beta1.InvariantCheckMode", InvariantCheckMode_name, InvariantCheckMode_value)
+	proto.RegisterType((*InvariantCheckPeriod)(nil), "cosmos.crisis.v1beta1.InvariantCheckPeriod")
+	proto.RegisterType((*InvariantRouteInfo)(nil), "cosmos.crisis.v1beta1.InvariantRouteInfo")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crisis/v1beta1/crisis.proto", fileDescriptor_4563994d65183ad5)
+}
+
+var fileDescriptor_4563994d65183ad5 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x41, 0x4f, 0xf2, 0x40,
+	0x14, 0xec, 0xf2, 0xf1, 0x91, 0xb8, 0x78, 0x20, 0x1b, 0x44, 0xac, 0xc9, 0x5a, 0x39, 0x11, 0x8d,
+	0x34, 0xc4, 0x93, 0xf1, 0x54, 0xa1, 0x09, 0x8d, 0x80, 0xa6, 0x21, 0x1e, 0xbc, 0x34, 0xa5, 0x5d,
+	0xa1, 0x81, 0xf6, 0x91, 0xee, 0x42, 0xf4, 0x1f, 0x18, 0x4e, 0xc6, 0x3b, 0x27, 0xff, 0x8c, 0x47,
+	0x8e, 0x1e, 0x0d, 0xfc, 0x11, 0x43, 0x5b, 0x44, 0x83, 0xa7, 0xdd, 0x79, 0x6f, 0xe6, 0xcd, 0x24,
+	0x83, 0x4b, 0x0e, 0x70, 0x1f, 0xb8, 0xea, 0x84, 0x1e, 0xf7, 0xb8, 0x3a, 0xa9, 0x76, 0x99, 0xb0,
+	0xab, 0x09, 0xac, 0x8c, 0x42, 0x10, 0x40, 0xf6, 0x62, 0x4e, 0x25, 0x19, 0x26, 0x1c, 0x39, 0xdf,
+	0x83, 0x1e, 0x44, 0x0c, 0x75, 0xf5, 0x8b, 0xc9, 0xa5, 0x3a, 0xce, 0x1b, 0xc1, 0xc4, 0x0e, 0x3d,
+	0x3b, 0x10, 0xb5, 0x3e, 0x73, 0x06, 0xb7, 0x2c, 0xf4, 0xc0, 0x25, 0x79, 0xfc, 0x3f, 0x84, 0xb1,
+	0x60, 0x45, 0xa4, 0xa0, 0xf2, 0x8e, 0x19, 0x03, 0x52, 0xc0, 0x99, 0x51, 0xb4, 0x2f, 0xa6, 0x14,
+	0x54, 0x4e, 0x9b, 0x09, 0x2a, 0x05, 0x98, 0x7c, 0x5f, 0x31, 0x57, 0x4c, 0x23, 0x78, 0x00, 0x72,
+	0x84, 0xb3, 0x3e, 0xb8, 0xe3, 0x21, 0xb3, 0x02, 0xdb, 0x5f, 0x5f, 0xc2, 0xf1, 0xa8, 0x6d, 0xfb,
+	0x6c, 0x63, 0x92, 0xfa, 0x69, 0x72, 0x8c, 0x77, 0x9d, 0x55, 0x12, 0x2b, 0xb1, 0xfa, 0x17, 0x59,
+	0x65, 0x9d, 0x4d, 0xba, 0x93, 0x57, 0x84, 0xc9, 0xef, 0xd8, 0x2d, 0x70, 0x19, 0xb9, 0xc0, 0x07,
+	0x46, 0xfb, 0x4e, 0x33, 0x0d, 0xad, 0xdd, 0xb1, 0x6a, 0x0d, 0xbd, 0x76, 0x6d, 0xb5, 0x6e, 0xea,
+	0xba, 0xd5, 0xd0, 0x9a, 0x9d, 0x9c, 0x24, 0xcb, 0xd3, 0x99, 0x52, 0xd8, 0x96, 0x35, 0xec, 0xa1,
+	0x20, 0x97, 0x58, 0xfe, 0x53, 0xaa, 0x35, 0x75, 0xb3, 0x93, 0x43, 0xf2, 0xe1, 0x74, 0xa6, 0xec,
+	0x6f, 0x6b, 0xb5, 0x21, 0x0b, 0x85, 0x9c, 0x7e, 0x7e, 0xa3, 0xd2, 0x95, 0xfe, 0xbe, 0xa0, 0x68,
+	0xbe, 0xa0, 0xe8, 0x73, 0x41, 0xd1, 0xcb, 0x92, 0x4a, 0xf3, 0x25, 0x95, 0x3e, 0x96, 0x54, 0xba,
+	0x3f, 0xed, 0x79, 0xa2, 0x3f, 0xee, 0x56, 0x1c, 0xf0, 0xd5, 0x75, 0x81, 0xd1, 0x73, 0xc6, 0xdd,
+	0x81, 0xfa, 0xb8, 0x6e, 0x53, 0x3c, 0x8d, 0x18, 0xef, 0x66, 0xa2, 0x62, 0xce, 0xbf, 0x06, 0x00,
+	0xc3, 0x69, 0xb8, 0xf0, 0xeb, 0x01, 0x00, 0x00,
+}
+
+func (m *InvariantCheckPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantCheckPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantCheckPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantRouteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantRouteInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantRouteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckPeriod != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.CheckPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrisis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrisis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantCheckPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovCrisis(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *InvariantRouteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.CheckPeriod != 0 {
+		n += 1 + sovCrisis(uint64(m.CheckPeriod))
+	}
+	return n
+}
+
+func sovCrisis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrisis(x uint64) (n int) {
+	return sovCrisis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantCheckPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantCheckPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantCheckPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantRouteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantRouteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantRouteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckPeriod", wireType)
+			}
+			m.CheckPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrisis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrisis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrisis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrisis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrisis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrisis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrisis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,16 +7,20 @@ import (
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) *GenesisState {
+func NewGenesisState(constantFee sdk.Coin, checkPeriods []InvariantCheckPeriod, checkMode InvariantCheckMode) *GenesisState {
 	return &GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:           constantFee,
+		InvariantCheckPeriods: checkPeriods,
+		InvariantCheckMode:    checkMode,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		InvariantCheckPeriods: DefaultInvariantCheckPeriods,
+		InvariantCheckMode:    DefaultInvariantCheckMode,
 	}
 }
 
@@ -25,5 +29,8 @@ func ValidateGenesis(data *GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
-	return nil
+	if err := validateInvariantCheckPeriods(data.InvariantCheckPeriods); err != nil {
+		return err
+	}
+	return validateInvariantCheckMode(data.InvariantCheckMode)
 }
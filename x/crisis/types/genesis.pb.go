@@ -29,6 +29,11 @@ type GenesisState struct {
 	// constant_fee is the fee used to verify the invariant in the crisis
 	// module.
 	ConstantFee types.Coin `protobuf:"bytes,3,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee"`
+	// invariant_check_periods overrides the node's invariant check period for
+	// the given invariant routes.
+	InvariantCheckPeriods []InvariantCheckPeriod `protobuf:"bytes,4,rep,name=invariant_check_periods,json=invariantCheckPeriods,proto3" json:"invariant_check_periods"`
+	// invariant_check_mode defines what happens when an invariant is broken.
+	InvariantCheckMode InvariantCheckMode `protobuf:"varint,5,opt,name=invariant_check_mode,json=invariantCheckMode,proto3,enum=cosmos.crisis.v1beta1.InvariantCheckMode" json:"invariant_check_mode,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +76,20 @@ func (m *GenesisState) GetConstantFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *GenesisState) GetInvariantCheckPeriods() []InvariantCheckPeriod {
+	if m != nil {
+		return m.InvariantCheckPeriods
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInvariantCheckMode() InvariantCheckMode {
+	if m != nil {
+		return m.InvariantCheckMode
+	}
+	return InvariantCheckModeHalt
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.crisis.v1beta1.GenesisState")
 }
@@ -80,22 +99,27 @@ func init() {
 }
 
 var fileDescriptor_7a9c2781aa8a27ae = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0xa0, 0x26, 0x26, 0x25, 0x16, 0xa7, 0xc2, 0xcd,
-	0x4b, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0x05, 0x71, 0xf1, 0xb8, 0x43, 0x4c, 0x0f, 0x2e, 0x49,
-	0x2c, 0x49, 0x15, 0x72, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x2b, 0x2e, 0x49, 0xcc, 0x2b, 0x89, 0x4f,
-	0x4b, 0x4d, 0x95, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0xda, 0x09, 0x32, 0x06,
-	0x66, 0xa3, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xdc, 0x30,
-	0x4d, 0x6e, 0xa9, 0xa9, 0x4e, 0xae, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0xa5, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf3, 0x2a, 0x98,
-	0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0xaf, 0x80, 0xf9, 0xbb, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0xec, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xae, 0x6d, 0xf5, 0x15, 0x01, 0x00,
-	0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0x5b, 0x41, 0x0f, 0x85, 0x78, 0x68, 0x20, 0x22, 0x87, 0x95, 0xe0, 0x05, 0x43, 0xdc,
+	0x06, 0x7c, 0x03, 0x88, 0x1a, 0x0f, 0x26, 0x06, 0x6f, 0x7a, 0x20, 0xed, 0x76, 0x2c, 0x13, 0x42,
+	0x87, 0x74, 0x56, 0xa2, 0x6f, 0xe1, 0xd9, 0x27, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0, 0x22, 0xa6,
+	0xed, 0xd6, 0x83, 0xf6, 0xe0, 0x69, 0x37, 0x3b, 0xdf, 0xff, 0xff, 0xb3, 0xbf, 0x73, 0xaa, 0x88,
+	0x17, 0xc4, 0x9e, 0x4a, 0x90, 0x91, 0xbd, 0xd5, 0x20, 0x00, 0xed, 0x0f, 0xbc, 0x08, 0x62, 0x60,
+	0x64, 0xb9, 0x4c, 0x48, 0x93, 0xdb, 0xcc, 0x21, 0x99, 0x43, 0xd2, 0x40, 0xed, 0x46, 0x44, 0x11,
+	0x65, 0x84, 0x97, 0xde, 0x72, 0xb8, 0x2d, 0x8c, 0x63, 0xe0, 0x33, 0xfc, 0xf8, 0x29, 0xc2, 0xd8,
+	0xcc, 0xbb, 0xe5, 0x89, 0xc6, 0x3b, 0x63, 0xba, 0xef, 0x7b, 0x4e, 0xfd, 0x3a, 0x5f, 0xe1, 0x5e,
+	0xfb, 0x1a, 0xdc, 0x91, 0x53, 0x57, 0x14, 0xb3, 0xf6, 0x63, 0x3d, 0x7d, 0x02, 0x68, 0x55, 0x3a,
+	0x76, 0xaf, 0x36, 0x3c, 0x96, 0x66, 0xb1, 0x34, 0xab, 0x58, 0x4b, 0x8e, 0x09, 0xe3, 0x51, 0x75,
+	0xfd, 0x79, 0x62, 0x4d, 0x6a, 0x85, 0xe8, 0x0a, 0xc0, 0x45, 0xe7, 0x08, 0xe3, 0x95, 0x9f, 0x60,
+	0x6a, 0xa2, 0x66, 0xa0, 0xe6, 0xd3, 0x25, 0x24, 0x48, 0x21, 0xb7, 0xaa, 0x9d, 0x4a, 0xaf, 0x36,
+	0xec, 0xcb, 0xd2, 0x7f, 0xca, 0x9b, 0x42, 0x35, 0x4e, 0x45, 0x77, 0x99, 0xc6, 0x04, 0x34, 0xb1,
+	0x64, 0xc6, 0xee, 0xa3, 0xd3, 0xf8, 0x1d, 0xb5, 0xa0, 0x10, 0x5a, 0xfb, 0x1d, 0xbb, 0x77, 0x38,
+	0x3c, 0xfb, 0x57, 0xce, 0x2d, 0x85, 0x30, 0x71, 0xf1, 0xcf, 0xdb, 0xe8, 0x72, 0xbd, 0x15, 0xf6,
+	0x66, 0x2b, 0xec, 0xaf, 0xad, 0xb0, 0xdf, 0x76, 0xc2, 0xda, 0xec, 0x84, 0xf5, 0xb1, 0x13, 0xd6,
+	0x43, 0x3f, 0x42, 0x3d, 0x7b, 0x0e, 0xa4, 0xa2, 0x85, 0x57, 0xb4, 0x9c, 0x1d, 0xe7, 0x1c, 0xce,
+	0xbd, 0x97, 0xa2, 0x72, 0xfd, 0xba, 0x04, 0x0e, 0x0e, 0xb2, 0xaa, 0x2f, 0xbe, 0x07, 0x00, 0x35,
+	0xe9, 0x63, 0x1a, 0x02, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -118,6 +142,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InvariantCheckMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InvariantCheckMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InvariantCheckPeriods) > 0 {
+		for iNdEx := len(m.InvariantCheckPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvariantCheckPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.ConstantFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -150,6 +193,15 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.ConstantFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InvariantCheckPeriods) > 0 {
+		for _, e := range m.InvariantCheckPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InvariantCheckMode != 0 {
+		n += 1 + sovGenesis(uint64(m.InvariantCheckMode))
+	}
 	return n
 }
 
@@ -221,6 +273,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantCheckPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantCheckPeriods = append(m.InvariantCheckPeriods, InvariantCheckPeriod{})
+			if err := m.InvariantCheckPeriods[len(m.InvariantCheckPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantCheckMode", wireType)
+			}
+			m.InvariantCheckMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvariantCheckMode |= InvariantCheckMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,18 +2,34 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// ParamStoreKeyConstantFee is the constant fee parameter
-var ParamStoreKeyConstantFee = []byte("ConstantFee")
+// Parameter store keys
+var (
+	// ParamStoreKeyConstantFee is the constant fee parameter
+	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// ParamStoreKeyInvariantCheckPeriods is the per invariant route check periods parameter
+	ParamStoreKeyInvariantCheckPeriods = []byte("InvariantCheckPeriods")
+	// ParamStoreKeyInvariantCheckMode is the invariant check mode parameter
+	ParamStoreKeyInvariantCheckMode = []byte("InvariantCheckMode")
+)
+
+// Default parameter values
+var (
+	DefaultInvariantCheckPeriods = []InvariantCheckPeriod{}
+	DefaultInvariantCheckMode    = InvariantCheckModeHalt
+)
 
 // type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyInvariantCheckPeriods, []InvariantCheckPeriod{}, validateInvariantCheckPeriods),
+		paramtypes.NewParamSetPair(ParamStoreKeyInvariantCheckMode, InvariantCheckModeHalt, validateInvariantCheckMode),
 	)
 }
 
@@ -29,3 +45,37 @@ func validateConstantFee(i interface{}) error {
 
 	return nil
 }
+
+func validateInvariantCheckPeriods(i interface{}) error {
+	v, ok := i.([]InvariantCheckPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	routes := make(map[string]bool, len(v))
+	for _, p := range v {
+		moduleName, route, found := strings.Cut(p.Route, "/")
+		if !found || moduleName == "" || route == "" {
+			return fmt.Errorf("invalid invariant route, expected {module_name}/{invariant_route}: %s", p.Route)
+		}
+		if routes[p.Route] {
+			return fmt.Errorf("duplicate invariant check period for route %s", p.Route)
+		}
+		routes[p.Route] = true
+	}
+
+	return nil
+}
+
+func validateInvariantCheckMode(i interface{}) error {
+	v, ok := i.(InvariantCheckMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InvariantCheckMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid invariant check mode: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryInvariantRoutesRequest is the request type for the Query/InvariantRoutes RPC method.
+type QueryInvariantRoutesRequest struct {
+}
+
+func (m *QueryInvariantRoutesRequest) Reset()         { *m = QueryInvariantRoutesRequest{} }
+func (m *QueryInvariantRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantRoutesRequest) ProtoMessage()    {}
+func (*QueryInvariantRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *QueryInvariantRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantRoutesRequest.Merge(m, src)
+}
+func (m *QueryInvariantRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantRoutesRequest proto.InternalMessageInfo
+
+// QueryInvariantRoutesResponse is the response type for the Query/InvariantRoutes RPC method.
+type QueryInvariantRoutesResponse struct {
+	// routes are the registered invariant routes.
+	Routes []InvariantRouteInfo `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// check_mode defines what happens when an invariant is broken.
+	CheckMode InvariantCheckMode `protobuf:"varint,2,opt,name=check_mode,json=checkMode,proto3,enum=cosmos.crisis.v1beta1.InvariantCheckMode" json:"check_mode,omitempty"`
+}
+
+func (m *QueryInvariantRoutesResponse) Reset()         { *m = QueryInvariantRoutesResponse{} }
+func (m *QueryInvariantRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantRoutesResponse) ProtoMessage()    {}
+func (*QueryInvariantRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *QueryInvariantRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantRoutesResponse.Merge(m, src)
+}
+func (m *QueryInvariantRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantRoutesResponse) GetRoutes() []InvariantRouteInfo {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryInvariantRoutesResponse) GetCheckMode() InvariantCheckMode {
+	if m != nil {
+		return m.CheckMode
+	}
+	return InvariantCheckModeHalt
+}
+
+func init() {
+	proto.RegisterType((*QueryInvariantRoutesRequest)(nil), "cosmos.crisis.v1beta1.QueryInvariantRoutesRequest")
+	proto.RegisterType((*QueryInvariantRoutesResponse)(nil), "cosmos.crisis.v1beta1.QueryInvariantRoutesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xb3, 0xfd, 0xff, 0x2d, 0xb8, 0x82, 0x42, 0x50, 0x28, 0xb5, 0xc6, 0x9a, 0x8b, 0x2d,
+	0x62, 0x96, 0xb6, 0x6f, 0x50, 0x11, 0xed, 0xc1, 0x83, 0x39, 0x7a, 0x29, 0xdb, 0xed, 0x9a, 0x2e,
+	0xb5, 0x3b, 0x69, 0x76, 0x53, 0xec, 0xd5, 0x27, 0x10, 0x7c, 0x0c, 0x4f, 0x1e, 0x7c, 0x87, 0x1e,
+	0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x3e, 0x88, 0x74, 0x93, 0x0a, 0x4a, 0x54, 0x3c, 0xed, 0xb0, 0xf3,
+	0xfb, 0xbe, 0x19, 0xbe, 0xc1, 0x7b, 0x0c, 0xd4, 0x00, 0x14, 0x61, 0x91, 0x50, 0x42, 0x91, 0x51,
+	0xad, 0xc3, 0x35, 0xad, 0x91, 0x61, 0xcc, 0xa3, 0xb1, 0x17, 0x46, 0xa0, 0xc1, 0xde, 0x4a, 0x10,
+	0x2f, 0x41, 0xbc, 0x14, 0x29, 0x6e, 0x06, 0x10, 0x80, 0x21, 0xc8, 0xa2, 0x4a, 0xe0, 0x62, 0x29,
+	0x00, 0x08, 0xae, 0x38, 0xa1, 0xa1, 0x20, 0x54, 0x4a, 0xd0, 0x54, 0x0b, 0x90, 0x2a, 0xed, 0xba,
+	0xd9, 0xd3, 0x52, 0x67, 0xc3, 0xb8, 0x3b, 0x78, 0xfb, 0x7c, 0x31, 0xbd, 0x25, 0x47, 0x34, 0x12,
+	0x54, 0x6a, 0x1f, 0x62, 0xcd, 0x95, 0xcf, 0x87, 0x31, 0x57, 0xda, 0x7d, 0x40, 0xb8, 0x94, 0xdd,
+	0x57, 0x21, 0x48, 0xc5, 0xed, 0x13, 0x9c, 0x8f, 0xcc, 0x4f, 0x01, 0x95, 0xff, 0x55, 0xd6, 0xea,
+	0x55, 0x2f, 0x73, 0x7f, 0xef, 0xb3, 0xbe, 0x25, 0x2f, 0xa1, 0xf9, 0x7f, 0xf2, 0xb2, 0x6b, 0xf9,
+	0xa9, 0xdc, 0x3e, 0xc5, 0x98, 0xf5, 0x38, 0xeb, 0xb7, 0x07, 0xd0, 0xe5, 0x85, 0x5c, 0x19, 0x55,
+	0xd6, 0x7f, 0x37, 0x3b, 0x5a, 0x28, 0xce, 0xa0, 0xcb, 0xfd, 0x55, 0xb6, 0x2c, 0xeb, 0x8f, 0x08,
+	0xaf, 0x98, 0x9d, 0xed, 0x7b, 0x84, 0x37, 0xbe, 0x2c, 0x6e, 0xd7, 0xbf, 0xf1, 0xfc, 0x21, 0x85,
+	0x62, 0xe3, 0x4f, 0x9a, 0x24, 0x19, 0x97, 0xdc, 0x3c, 0xbd, 0xdd, 0xe5, 0xaa, 0xf6, 0x3e, 0xc9,
+	0x3e, 0x83, 0x58, 0xea, 0xda, 0x49, 0x02, 0xcd, 0xe3, 0xc9, 0xcc, 0x41, 0xd3, 0x99, 0x83, 0x5e,
+	0x67, 0x0e, 0xba, 0x9d, 0x3b, 0xd6, 0x74, 0xee, 0x58, 0xcf, 0x73, 0xc7, 0xba, 0x38, 0x08, 0x84,
+	0xee, 0xc5, 0x1d, 0x8f, 0xc1, 0xe0, 0xc3, 0xcc, 0x3c, 0x87, 0xaa, 0xdb, 0x27, 0xd7, 0x4b, 0x67,
+	0x3d, 0x0e, 0xb9, 0xea, 0xe4, 0xcd, 0x61, 0x1b, 0xef, 0x03, 0x00, 0xa3, 0x02, 0xb8, 0xbd, 0x6c,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// InvariantRoutes returns the registered invariant routes, along with their
+	// check period and the invariant check mode.
+	InvariantRoutes(ctx context.Context, in *QueryInvariantRoutesRequest, opts ...grpc.CallOption) (*QueryInvariantRoutesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) InvariantRoutes(ctx context.Context, in *QueryInvariantRoutesRequest, opts ...grpc.CallOption) (*QueryInvariantRoutesResponse, error) {
+	out := new(QueryInvariantRoutesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/InvariantRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InvariantRoutes returns the registered invariant routes, along with their
+	// check period and the invariant check mode.
+	InvariantRoutes(context.Context, *QueryInvariantRoutesRequest) (*QueryInvariantRoutesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) InvariantRoutes(ctx context.Context, req *QueryInvariantRoutesRequest) (*QueryInvariantRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvariantRoutes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_InvariantRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvariantRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/InvariantRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvariantRoutes(ctx, req.(*QueryInvariantRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvariantRoutes",
+			Handler:    _Query_InvariantRoutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *QueryInvariantRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInvariantRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CheckMode != 0 {
+		n += 1 + sovQuery(uint64(m.CheckMode))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInvariantRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, InvariantRouteInfo{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckMode", wireType)
+			}
+			m.CheckMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckMode |= InvariantCheckMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -24,3 +24,10 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
 }
+
+// InvariantResult is the result of an invariant check.
+type InvariantResult struct {
+	Route   string `json:"route" yaml:"route"`
+	Broken  bool   `json:"broken" yaml:"broken"`
+	Message string `json:"message" yaml:"message"`
+}
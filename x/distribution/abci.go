@@ -35,4 +35,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay out the continuous community pool funds that are due
+	k.DistributeContinuousFunds(ctx)
+
+	// restake the rewards of auto-compounding delegations
+	k.AutoCompound(ctx)
 }
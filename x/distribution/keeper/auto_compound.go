@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// autoCompoundBatchSize is the number of auto-compounding delegations read
+// from the store at once while processing a run.
+const autoCompoundBatchSize = 100
+
+// IsAutoCompound returns true if the delegation of delAddr to valAddr is
+// auto-compounding.
+func (k Keeper) IsAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// SetAutoCompound marks the delegation of delAddr to valAddr as
+// auto-compounding.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorAutoCompoundKey(delAddr, valAddr), []byte{0x01})
+}
+
+// DeleteAutoCompound disables auto-compounding for the delegation of delAddr
+// to valAddr.
+func (k Keeper) DeleteAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// IterateAutoCompounds iterates over all auto-compounding delegations.
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(delAddr, valAddr) {
+			break
+		}
+	}
+}
+
+// GetDelegatorAutoCompoundValidators returns the validators a delegator
+// auto-compounds its delegation with.
+func (k Keeper) GetDelegatorAutoCompoundValidators(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoCompoundPrefix(delAddr))
+	defer iter.Close()
+
+	var validators []sdk.ValAddress
+	for ; iter.Valid(); iter.Next() {
+		_, valAddr := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		validators = append(validators, valAddr)
+	}
+	return validators
+}
+
+// CompoundDelegationRewards withdraws the rewards of a delegation and delegates
+// the bond denom part of them back to the same validator. Rewards in other
+// denoms stay with the delegator. It returns the restaked amount.
+func (k Keeper) CompoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	// rewards must end up in the delegator's account to be restaked
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return sdk.Coin{}, types.ErrAutoCompoundWithdrawAddr
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
+
+// AutoCompound restakes the rewards of all auto-compounding delegations. A run
+// starts every AutoCompoundEpoch blocks and may consume at most
+// AutoCompoundMaxGas gas per block; delegations that do not fit in the budget
+// of a block are compounded in the following blocks. A delegation that cannot
+// be compounded is skipped and retried in the next run; it never halts the
+// chain.
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	if params.AutoCompoundEpoch == 0 {
+		// auto-compounding has been disabled, drop any unfinished run
+		store.Delete(types.AutoCompoundCursorKey)
+		return
+	}
+
+	cursor := store.Get(types.AutoCompoundCursorKey)
+	if cursor == nil {
+		if ctx.BlockHeight()%int64(params.AutoCompoundEpoch) != 0 {
+			return
+		}
+		cursor = types.DelegatorAutoCompoundPrefix
+	}
+
+	gasMeter := sdk.NewGasMeter(params.AutoCompoundMaxGas)
+	budgetCtx := ctx.WithGasMeter(gasMeter)
+	processed := 0
+
+	for {
+		keys := k.autoCompoundKeys(ctx, cursor, autoCompoundBatchSize)
+		if len(keys) == 0 {
+			// the run is complete
+			store.Delete(types.AutoCompoundCursorKey)
+			return
+		}
+
+		for _, key := range keys {
+			if gasMeter.IsOutOfGas() {
+				store.Set(types.AutoCompoundCursorKey, key)
+				return
+			}
+
+			delAddr, valAddr := types.GetDelegatorAutoCompoundAddresses(key)
+			if !k.compoundDelegation(ctx, budgetCtx, delAddr, valAddr) {
+				if processed == 0 {
+					// a single delegation exceeds the budget of a whole block,
+					// skip it to not stall the run
+					k.Logger(ctx).Error("auto-compounding exceeds the per block gas budget", "delegator", delAddr.String(), "validator", valAddr.String())
+					key = nextKey(key)
+				}
+				store.Set(types.AutoCompoundCursorKey, key)
+				return
+			}
+			processed++
+		}
+
+		cursor = nextKey(keys[len(keys)-1])
+	}
+}
+
+// compoundDelegation compounds a single delegation in a cached context of
+// budgetCtx so a failure leaves no partial state behind. It returns false if
+// the gas budget ran out before the delegation was compounded.
+func (k Keeper) compoundDelegation(ctx, budgetCtx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (ok bool) {
+	cacheCtx, write := budgetCtx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			ok = false
+		}
+	}()
+
+	amount, err := k.CompoundDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto-compound delegation rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+		return true
+	}
+	write()
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return true
+}
+
+// autoCompoundKeys returns at most limit auto-compounding delegation keys
+// starting from start. The keys are collected up front to not mutate the store
+// while iterating over it.
+func (k Keeper) autoCompoundKeys(ctx sdk.Context, start []byte, limit int) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.DelegatorAutoCompoundPrefix))
+	defer iter.Close()
+
+	keys := make([][]byte, 0, limit)
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+// nextKey returns the smallest key that sorts after key.
+func nextKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(key)+1), key...), 0x00)
+}
+
+// validateAutoCompound checks that the delegation of delAddr to valAddr can be
+// auto-compounded.
+func (k Keeper) validateAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		return sdkerrors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", delAddr, valAddr)
+	}
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+	return nil
+}
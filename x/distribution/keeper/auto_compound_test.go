@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupAutoCompound creates a validator with 50% commission, delegates to it
+// from a second account and enables auto-compounding for both delegations.
+func setupAutoCompound(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddr := sdk.ValAddress(addr[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddr, valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addr[1], valAddr, sdk.NewInt(100))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	for _, delAddr := range addr {
+		_, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(delAddr, valAddr, true))
+		require.NoError(t, err)
+	}
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundEpoch = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// allocate rewards, each delegator is owed a quarter of them
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+
+	return app, ctx, addr, valAddr
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddr := sdk.ValAddress(addr[0])
+
+	// there is no delegation yet
+	_, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], valAddr, true))
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, valConsPk1, sdk.NewInt(100), true)
+
+	// rewards must be withdrawn to the delegator
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], valAddr, true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[0]))
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], valAddr, true))
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.IsAutoCompound(ctx, addr[0], valAddr))
+	require.Equal(t, []sdk.ValAddress{valAddr}, app.DistrKeeper.GetDelegatorAutoCompoundValidators(ctx, addr[0]))
+
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], valAddr, false))
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.IsAutoCompound(ctx, addr[0], valAddr))
+}
+
+func TestAutoCompound(t *testing.T) {
+	app, ctx, addr, valAddr := setupAutoCompound(t)
+
+	// nothing happens outside of an epoch
+	ctx = ctx.WithBlockHeight(9)
+	app.DistrKeeper.AutoCompound(ctx)
+	require.Equal(t, sdk.NewInt(200), app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.AutoCompound(ctx)
+
+	// both delegators restaked their rewards
+	require.Equal(t, sdk.NewInt(220), app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	for _, delAddr := range addr {
+		del := app.StakingKeeper.Delegation(ctx, delAddr, valAddr)
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		require.Equal(t, sdk.NewDec(110), val.TokensFromShares(del.GetShares()))
+	}
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+
+	// removing a delegation disables auto-compounding for it
+	_, err := app.StakingKeeper.Undelegate(ctx, addr[1], valAddr, app.StakingKeeper.Delegation(ctx, addr[1], valAddr).GetShares())
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.IsAutoCompound(ctx, addr[1], valAddr))
+}
+
+func TestAutoCompoundGasBudget(t *testing.T) {
+	app, ctx, _, valAddr := setupAutoCompound(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	// measure the gas needed to compound both delegations in store order
+	var delegators []sdk.AccAddress
+	app.DistrKeeper.IterateAutoCompounds(ctx, func(delAddr sdk.AccAddress, _ sdk.ValAddress) (stop bool) {
+		delegators = append(delegators, delAddr)
+		return false
+	})
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, delAddr := range delegators {
+		_, err := app.DistrKeeper.CompoundDelegationRewards(cacheCtx, delAddr, valAddr)
+		require.NoError(t, err)
+	}
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundMaxGas = cacheCtx.GasMeter().GasConsumed() - 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// only the first delegation fits in the budget of the epoch block
+	app.DistrKeeper.AutoCompound(ctx)
+	require.Equal(t, sdk.NewInt(210), app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.NotNil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+
+	// the run continues in the next block
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.AutoCompound(ctx)
+	require.Equal(t, sdk.NewInt(220), app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+
+	// a delegation exceeding the budget of a whole block is skipped, so the
+	// run still completes
+	params.AutoCompoundMaxGas = 1
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20)
+	app.DistrKeeper.AutoCompound(ctx)
+	require.NotNil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+	ctx = ctx.WithBlockHeight(21)
+	app.DistrKeeper.AutoCompound(ctx)
+	require.NotNil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+	ctx = ctx.WithBlockHeight(22)
+	app.DistrKeeper.AutoCompound(ctx)
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.AutoCompoundCursorKey))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(recipient))
+	if b == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshal(b, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets the continuous fund of its recipient.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&fund)
+	store.Set(types.GetContinuousFundKey(recipient), b)
+}
+
+// DeleteContinuousFund deletes the continuous fund of a recipient.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(recipient))
+}
+
+// IterateContinuousFunds iterates over all continuous funds.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// GetAllContinuousFunds returns all continuous funds.
+func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) []types.ContinuousFund {
+	funds := make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+	return funds
+}
+
+// DistributeContinuousFunds pays out every continuous fund that is due at the
+// current block height and removes the funds that have expired. A payout that
+// cannot be made, for example because the community pool is short of funds,
+// is skipped and retried at the next payout height; it never halts the chain.
+func (k Keeper) DistributeContinuousFunds(ctx sdk.Context) {
+	var expired []types.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		if fund.IsExpired(ctx.BlockTime()) {
+			expired = append(expired, fund)
+			return false
+		}
+		if !fund.IsPayoutHeight(ctx.BlockHeight()) {
+			return false
+		}
+
+		recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+
+		// pay out in a cached context so a failed transfer leaves no partial
+		// state behind
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DistributeFromFeePool(cacheCtx, fund.Amount, recipient); err != nil {
+			k.Logger(ctx).Error("failed to pay out continuous fund", "recipient", fund.Recipient, "amount", fund.Amount.String(), "err", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeContinuousFundPayoutFail,
+					sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Amount.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			return false
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundPayout,
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Amount.String()),
+			),
+		)
+		return false
+	})
+
+	// funds are deleted outside of the iteration to not mutate the store
+	// while iterating over it
+	for _, fund := range expired {
+		k.DeleteContinuousFund(ctx, sdk.MustAccAddressFromBech32(fund.Recipient))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundExpired,
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			),
+		)
+	}
+}
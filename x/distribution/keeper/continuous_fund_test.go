@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr[0], amount))
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, amount, addr[0]))
+
+	spend := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	// only the authority may spend the community pool
+	_, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addr[0], addr[1], spend))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addr[1], spend))
+	require.NoError(t, err)
+	require.Equal(t, spend, app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount.Sub(spend...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the pool cannot be overspent
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addr[1], amount))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+}
+
+func TestContinuousFunds(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.ZeroInt())
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr[0], pool))
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, addr[0]))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	expiry := time.Unix(2000, 0)
+
+	// only the authority may create a fund
+	_, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(addr[0], addr[1], amount, 2, nil))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addr[1], amount, 2, &expiry))
+	require.NoError(t, err)
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addr[2], amount, 1, nil))
+	require.NoError(t, err)
+	require.Len(t, app.DistrKeeper.GetAllContinuousFunds(ctx), 2)
+
+	// a recipient can only have one fund
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addr[1], amount, 1, nil))
+	require.ErrorIs(t, err, types.ErrContinuousFundExists)
+
+	// nothing is paid out at the creation height
+	app.DistrKeeper.DistributeContinuousFunds(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[1]).IsZero())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[2]).IsZero())
+
+	// addr[2] is paid every block, addr[1] every other block
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.DistributeContinuousFunds(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[1]).IsZero())
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, addr[2]))
+
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.DistributeContinuousFunds(ctx)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	require.Equal(t, amount.Add(amount...), app.BankKeeper.GetAllBalances(ctx, addr[2]))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 70)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// expired funds are removed without paying out
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(expiry)
+	app.DistrKeeper.DistributeContinuousFunds(ctx)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, addr[1])
+	require.False(t, found)
+
+	// cancelled funds stop paying out
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, addr[2]))
+	require.NoError(t, err)
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, addr[2]))
+	require.ErrorIs(t, err, types.ErrNoContinuousFund)
+	require.Empty(t, app.DistrKeeper.GetAllContinuousFunds(ctx))
+}
+
+func TestContinuousFundInsufficientPool(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	app.DistrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addr[0], amount, 1, 1, nil))
+
+	// an empty pool skips the payout but keeps the fund
+	ctx = ctx.WithBlockHeight(2)
+	app.DistrKeeper.DistributeContinuousFunds(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[0]).IsZero())
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, addr[0])
+	require.True(t, found)
+}
@@ -76,6 +76,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+
+	for _, record := range data.AutoCompoundRecords {
+		delAddr := sdk.MustAccAddressFromBech32(record.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoCompound(ctx, delAddr, valAddr)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +193,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.ContinuousFunds = k.GetAllContinuousFunds(ctx)
+
+	autoCompounds := make([]types.DelegatorAutoCompoundRecord, 0)
+	k.IterateAutoCompounds(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool) {
+		autoCompounds = append(autoCompounds, types.DelegatorAutoCompoundRecord{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		return false
+	})
+	gs.AutoCompoundRecords = autoCompounds
+
+	return gs
 }
@@ -246,3 +246,52 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFunds queries all continuous community pool funds
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	funds := make([]types.ContinuousFund, 0)
+	fundsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContinuousFundPrefix)
+
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(key []byte, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{Funds: funds, Pagination: pageRes}, nil
+}
+
+// DelegatorAutoCompound queries the validators a delegator auto-compounds with
+func (k Keeper) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := make([]string, 0)
+	for _, valAddr := range k.GetDelegatorAutoCompoundValidators(ctx, delAdr) {
+		validators = append(validators, valAddr.String())
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Validators: validators}, nil
+}
@@ -0,0 +1,21 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore migrates the x/distribution params from consensus version 2 to 3
+// by adding the auto-compounding params, AutoCompoundEpoch and AutoCompoundMaxGas,
+// at their default values. The delegators still opt in to auto-compounding.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundEpoch, types.DefaultAutoCompoundEpoch)
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundMaxGas, types.DefaultAutoCompoundMaxGas)
+
+	return nil
+}
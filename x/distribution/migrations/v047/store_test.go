@@ -0,0 +1,37 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey("distribution")
+	tDistrKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, tDistrKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distrKey, tDistrKey, "distribution")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundEpoch))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundMaxGas))
+
+	// Run migrations.
+	err := v047distribution.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var epoch, maxGas uint64
+	paramstore.Get(ctx, types.ParamStoreKeyAutoCompoundEpoch, &epoch)
+	paramstore.Get(ctx, types.ParamStoreKeyAutoCompoundMaxGas, &maxGas)
+	require.Equal(t, types.DefaultAutoCompoundEpoch, epoch)
+	require.Equal(t, types.DefaultAutoCompoundMaxGas, maxGas)
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -18,6 +19,8 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	AutoCompoundEpoch   = "auto_compound_epoch"
+	AutoCompoundMaxGas  = "auto_compound_max_gas"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundEpoch returns a randomized AutoCompoundEpoch parameter.
+func GenAutoCompoundEpoch(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenAutoCompoundMaxGas returns a randomized AutoCompoundMaxGas parameter.
+func GenAutoCompoundMaxGas(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100_000, 10_000_000))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +79,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundEpoch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundEpoch, &autoCompoundEpoch, simState.Rand,
+		func(r *rand.Rand) { autoCompoundEpoch = GenAutoCompoundEpoch(r) },
+	)
+
+	var autoCompoundMaxGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundMaxGas, &autoCompoundMaxGas, simState.Rand,
+		func(r *rand.Rand) { autoCompoundMaxGas = GenAutoCompoundMaxGas(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +98,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			AutoCompoundEpoch:   autoCompoundEpoch,
+			AutoCompoundMaxGas:  autoCompoundMaxGas,
 		},
 	}
 
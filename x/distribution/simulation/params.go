@@ -16,6 +16,8 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyAutoCompoundEpoch   = "autocompoundepoch"
+	keyAutoCompoundMaxGas  = "autocompoundmaxgas"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +39,15 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyAutoCompoundEpoch,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAutoCompoundEpoch(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyAutoCompoundMaxGas,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAutoCompoundMaxGas(r))
+			},
+		),
 	}
 }
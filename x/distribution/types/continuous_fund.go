@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewContinuousFund creates a new ContinuousFund instance.
+//
+//nolint:interfacer
+func NewContinuousFund(recipient sdk.AccAddress, amount sdk.Coins, period uint64, startHeight int64, expiry *time.Time) ContinuousFund {
+	return ContinuousFund{
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		Period:      period,
+		StartHeight: startHeight,
+		Expiry:      expiry,
+	}
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (cf ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cf.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !cf.Amount.IsValid() || cf.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, cf.Amount.String())
+	}
+	if cf.Period == 0 {
+		return ErrInvalidContinuousFund.Wrap("period must be positive")
+	}
+	if cf.StartHeight < 0 {
+		return ErrInvalidContinuousFund.Wrapf("negative start height %d", cf.StartHeight)
+	}
+	return nil
+}
+
+// IsExpired returns true if the fund has an expiry and blockTime is at or
+// after it.
+func (cf ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return cf.Expiry != nil && !blockTime.Before(*cf.Expiry)
+}
+
+// IsPayoutHeight returns true if the fund pays out at the given height, that
+// is, every period blocks after its start height.
+func (cf ContinuousFund) IsPayoutHeight(height int64) bool {
+	if height <= cf.StartHeight || cf.Period == 0 {
+		return false
+	}
+	return uint64(height-cf.StartHeight)%cf.Period == 0
+}
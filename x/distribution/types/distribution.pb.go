@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,13 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch is the number of blocks between two auto-compounding
+	// runs. Zero disables auto-compounding.
+	AutoCompoundEpoch uint64 `protobuf:"varint,5,opt,name=auto_compound_epoch,json=autoCompoundEpoch,proto3" json:"auto_compound_epoch,omitempty"`
+	// auto_compound_max_gas is the maximum amount of gas an auto-compounding run
+	// may consume per block. Delegations left over are compounded in the next
+	// blocks.
+	AutoCompoundMaxGas uint64 `protobuf:"varint,6,opt,name=auto_compound_max_gas,json=autoCompoundMaxGas,proto3" json:"auto_compound_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +84,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxGas() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxGas
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +105,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -464,6 +490,56 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// ContinuousFund defines a recurring payment from the community pool to a
+// recipient. It is paid out in BeginBlock every period blocks, counted from
+// the creation height, until it expires or is cancelled by governance.
+type ContinuousFund struct {
+	// recipient is the address receiving the funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid out every period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the number of blocks between two payouts.
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// start_height is the height at which the fund was created.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// expiry is the time after which the fund stops paying out. If unset, the
+	// fund pays out until it is cancelled.
+	Expiry *time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{9}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
 // DelegatorStartingInfo represents the starting info for a delegator reward
 // period. It tracks the previous validator period, the delegation's amount of
 // staking token, and the creation height (to check later on if any slashes have
@@ -480,7 +556,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +610,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +653,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,6 +692,7 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
@@ -626,65 +703,75 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
+	// 1087 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	0x17, 0xf7, 0x24, 0x8e, 0x93, 0x4c, 0xd2, 0xe4, 0xdb, 0x89, 0x93, 0x3a, 0x6e, 0x65, 0xe7, 0x6b,
+	0x09, 0x08, 0xaa, 0xb2, 0x6e, 0x52, 0x09, 0xa1, 0x88, 0x4b, 0xed, 0xa4, 0x94, 0x03, 0x6a, 0xb4,
+	0xa9, 0x00, 0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0xe3, 0x9c,
+	0xb9, 0x14, 0x4e, 0x95, 0xb8, 0x54, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xec, 0x85, 0x23, 0xb7, 0x1e,
+	0x4b, 0x2f, 0x20, 0x0e, 0x29, 0x4a, 0x84, 0x84, 0xfa, 0x57, 0xa0, 0xd9, 0x19, 0xaf, 0x1d, 0x30,
+	0xa5, 0x87, 0x58, 0x9c, 0xec, 0x79, 0xef, 0xcd, 0x7b, 0x9f, 0xf7, 0xd9, 0xf7, 0x63, 0xa0, 0xe3,
+	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x12, 0x45, 0x39, 0xab, 0x77, 0x37, 0x5b,
+	0x44, 0xe1, 0xcd, 0x0b, 0x42, 0x27, 0x16, 0x5c, 0x71, 0x74, 0xdd, 0xd8, 0x3b, 0x17, 0x54, 0xd6,
+	0xbe, 0x5c, 0x6c, 0xf3, 0x36, 0x4f, 0xed, 0xea, 0xfa, 0x9f, 0xb9, 0x52, 0xae, 0xd8, 0x10, 0x2d,
+	0x2c, 0x49, 0xe6, 0xda, 0xe7, 0xd4, 0xba, 0x2c, 0xaf, 0x1a, 0xbd, 0x67, 0x2e, 0x5a, 0xff, 0x46,
+	0x55, 0x6d, 0x73, 0xde, 0x0e, 0x49, 0x3d, 0x3d, 0xb5, 0x92, 0x83, 0xba, 0xa2, 0x11, 0x91, 0x0a,
+	0x47, 0xb1, 0x31, 0xa8, 0xbd, 0x9c, 0x84, 0x85, 0x3d, 0x2c, 0x70, 0x24, 0x11, 0x86, 0x57, 0x7c,
+	0x1e, 0x45, 0x09, 0xa3, 0xea, 0xd8, 0x53, 0xb8, 0x57, 0x02, 0x6b, 0x60, 0x7d, 0xb6, 0xf1, 0xde,
+	0xd3, 0xd3, 0x6a, 0xee, 0xd7, 0xd3, 0xea, 0x9b, 0x6d, 0xaa, 0x3a, 0x49, 0xcb, 0xf1, 0x79, 0x64,
+	0x63, 0xd8, 0x9f, 0x0d, 0x19, 0x1c, 0xd6, 0xd5, 0x71, 0x4c, 0xa4, 0xb3, 0x43, 0xfc, 0xe7, 0x4f,
+	0x36, 0xa0, 0x85, 0xb0, 0x43, 0x7c, 0x77, 0x3e, 0x73, 0xf9, 0x00, 0xf7, 0x10, 0x83, 0x45, 0x9d,
+	0x84, 0x46, 0x1a, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x11, 0x16, 0x41, 0x69, 0xe2, 0x12, 0x22, 0x21,
+	0xed, 0x79, 0xcf, 0x3a, 0x76, 0x53, 0xbf, 0x28, 0x86, 0xcb, 0x2d, 0xce, 0x12, 0xf9, 0xb7, 0x80,
+	0x93, 0x97, 0x10, 0x70, 0x29, 0x75, 0xfd, 0x97, 0x88, 0x5b, 0x70, 0xf9, 0x88, 0xaa, 0x4e, 0x20,
+	0xf0, 0x91, 0x87, 0x83, 0x40, 0x78, 0x84, 0xe1, 0x56, 0x48, 0x82, 0x52, 0x7e, 0x0d, 0xac, 0xcf,
+	0xb8, 0x4b, 0x7d, 0xe5, 0x9d, 0x20, 0x10, 0xbb, 0x46, 0x85, 0x1c, 0xb8, 0x84, 0x13, 0xc5, 0x3d,
+	0x9f, 0x47, 0x31, 0x4f, 0x58, 0xe0, 0x91, 0x98, 0xfb, 0x9d, 0xd2, 0xd4, 0x1a, 0x58, 0xcf, 0xbb,
+	0x57, 0xb5, 0xaa, 0x69, 0x35, 0xbb, 0x5a, 0x81, 0x36, 0xe1, 0xf2, 0x45, 0xfb, 0x08, 0xf7, 0xbc,
+	0x36, 0x96, 0xa5, 0x42, 0x7a, 0x03, 0x0d, 0xdf, 0xf8, 0x10, 0xf7, 0xde, 0xc7, 0x72, 0x3b, 0xff,
+	0xf8, 0xa4, 0x9a, 0xab, 0xfd, 0x04, 0x60, 0xf9, 0x23, 0x1c, 0xd2, 0x00, 0x2b, 0x2e, 0xee, 0x51,
+	0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0x74, 0x89, 0xbe, 0x00, 0xf0, 0x9a, 0x9f, 0x44, 0x49, 0x88,
+	0x15, 0xed, 0x12, 0x4b, 0x95, 0x27, 0xb0, 0xa2, 0xbc, 0x04, 0xd6, 0x26, 0xd7, 0xe7, 0xb6, 0x6e,
+	0xd8, 0x6a, 0x77, 0x34, 0xd7, 0xfd, 0xaa, 0xd5, 0x64, 0x34, 0x39, 0x65, 0x8d, 0xdb, 0x9a, 0xce,
+	0xef, 0x5e, 0x54, 0x6f, 0xbe, 0x1e, 0x9d, 0xfa, 0x8e, 0x74, 0x97, 0x07, 0x11, 0x0d, 0x0e, 0x57,
+	0xc7, 0x43, 0x6f, 0xc1, 0x45, 0x41, 0x0e, 0x88, 0x20, 0xcc, 0x27, 0x9e, 0xcf, 0x13, 0xa6, 0xd2,
+	0x22, 0xb9, 0xe2, 0x2e, 0x64, 0xe2, 0xa6, 0x96, 0xd6, 0xbe, 0x01, 0xf0, 0x5a, 0x96, 0x53, 0x33,
+	0x11, 0x82, 0x30, 0xd5, 0x4f, 0xe8, 0x10, 0x4e, 0x9b, 0x24, 0xe4, 0xf8, 0xf0, 0xf7, 0x23, 0xa0,
+	0x15, 0x58, 0x88, 0x89, 0xa0, 0xdc, 0x54, 0x73, 0xde, 0xb5, 0xa7, 0xda, 0x57, 0x00, 0x56, 0x32,
+	0x80, 0x77, 0x7c, 0x9b, 0x2e, 0x09, 0x9a, 0x3c, 0x8a, 0xa8, 0x94, 0x94, 0x33, 0xf4, 0x19, 0x84,
+	0x7e, 0x76, 0x1a, 0x1f, 0xd4, 0xa1, 0x20, 0xb5, 0x2f, 0x01, 0xbc, 0x9e, 0xa1, 0xba, 0x9f, 0x28,
+	0xa9, 0x30, 0x0b, 0x28, 0x6b, 0xff, 0x17, 0xd4, 0xd5, 0xbe, 0x06, 0x70, 0x29, 0x03, 0xb3, 0x1f,
+	0x62, 0xd9, 0xd9, 0xed, 0x12, 0xa6, 0xd0, 0xdb, 0xf0, 0x7f, 0xdd, 0xbe, 0xd8, 0xb3, 0xe4, 0x82,
+	0x94, 0xdc, 0xc5, 0x4c, 0xbe, 0x97, 0x8a, 0xd1, 0x27, 0x70, 0xe6, 0x40, 0x60, 0x5f, 0x4f, 0xd3,
+	0x4b, 0x99, 0x26, 0x99, 0x37, 0xcd, 0x54, 0x71, 0x04, 0x38, 0x89, 0x42, 0xb8, 0x32, 0x40, 0x27,
+	0xb5, 0xc2, 0x23, 0xa9, 0xc6, 0x32, 0x76, 0xcb, 0x79, 0xc5, 0xa8, 0x77, 0x46, 0xb8, 0x6c, 0xe4,
+	0x35, 0x64, 0xb7, 0xd8, 0x1d, 0x11, 0xcd, 0x76, 0xf0, 0xe7, 0x00, 0x4e, 0xdf, 0x25, 0x64, 0x8f,
+	0xf3, 0x10, 0xf5, 0xe0, 0xc2, 0x60, 0x5e, 0xc7, 0x9c, 0x87, 0xe3, 0xfb, 0x52, 0x83, 0xc5, 0xa0,
+	0x23, 0xd7, 0x7e, 0x07, 0xb0, 0xdc, 0x1c, 0x96, 0xec, 0xc7, 0x84, 0x05, 0x66, 0x12, 0xe2, 0x10,
+	0x15, 0xe1, 0x94, 0xa2, 0x2a, 0x24, 0x66, 0x81, 0xb8, 0xe6, 0x80, 0xd6, 0xe0, 0x5c, 0x40, 0xa4,
+	0x2f, 0x68, 0x3c, 0xf8, 0x48, 0xee, 0xb0, 0x08, 0xdd, 0x80, 0xb3, 0x82, 0xf8, 0x34, 0xa6, 0x84,
+	0x29, 0x33, 0xa1, 0xdd, 0x81, 0x00, 0xf9, 0xb0, 0x80, 0xa3, 0x74, 0x10, 0xe4, 0xd3, 0x34, 0x57,
+	0x47, 0xa6, 0x99, 0xe6, 0x78, 0xcb, 0xe6, 0xb8, 0xfe, 0x1a, 0x39, 0x9a, 0x04, 0xad, 0xeb, 0xed,
+	0xf9, 0x87, 0x27, 0xd5, 0x9c, 0x66, 0xfa, 0x0f, 0xcd, 0xf6, 0xf7, 0x13, 0x70, 0xa1, 0xc9, 0x99,
+	0xa2, 0x2c, 0xe1, 0x89, 0xbc, 0x9b, 0xb0, 0x00, 0xbd, 0x33, 0x8c, 0xd1, 0x2c, 0xc8, 0xd2, 0xf3,
+	0x27, 0x1b, 0x45, 0x8b, 0x45, 0x8f, 0x75, 0x22, 0xe5, 0xbe, 0x12, 0xba, 0x97, 0x46, 0xa2, 0x9f,
+	0x18, 0x1b, 0xfa, 0xa1, 0x11, 0x34, 0x39, 0x3c, 0x82, 0xd0, 0xff, 0xe1, 0xbc, 0x54, 0x58, 0x28,
+	0xaf, 0x43, 0x68, 0xbb, 0xa3, 0xd2, 0x5d, 0x34, 0xe9, 0xce, 0xa5, 0xb2, 0x7b, 0xa9, 0x08, 0xbd,
+	0x0b, 0x0b, 0xa4, 0x17, 0x53, 0x71, 0x9c, 0xae, 0x9d, 0xb9, 0xad, 0xb2, 0x63, 0x5e, 0x0e, 0x4e,
+	0xff, 0xe5, 0xe0, 0x3c, 0xe8, 0xbf, 0x1c, 0x1a, 0xf9, 0x47, 0x2f, 0xaa, 0xc0, 0xb5, 0xf6, 0xdb,
+	0x33, 0x0f, 0xfb, 0x74, 0xfd, 0x08, 0xe0, 0xf2, 0x0e, 0x09, 0x49, 0x3b, 0xad, 0x5d, 0xed, 0x9c,
+	0xb2, 0xf6, 0x07, 0xec, 0x20, 0x9d, 0xe6, 0xb1, 0x20, 0x5d, 0xca, 0xf5, 0x2a, 0x1e, 0xee, 0xe3,
+	0x85, 0xbe, 0xd8, 0xb6, 0xb1, 0x0b, 0xa7, 0xa4, 0xc2, 0x87, 0xe4, 0x52, 0x7a, 0xd8, 0xb8, 0x42,
+	0x37, 0x61, 0xc1, 0xe6, 0x9d, 0xb2, 0xd2, 0x58, 0x7a, 0x79, 0x5a, 0x5d, 0xf4, 0x05, 0xd1, 0x7b,
+	0x86, 0x59, 0x4a, 0x5c, 0x6b, 0x52, 0xfb, 0x19, 0xc0, 0x55, 0x9b, 0x03, 0xe5, 0x2c, 0xcb, 0xc6,
+	0x6e, 0xf7, 0x5d, 0x78, 0x75, 0xd0, 0xf2, 0xd8, 0x7c, 0xeb, 0x7f, 0xad, 0x82, 0xc1, 0x0c, 0xb3,
+	0x72, 0x44, 0x61, 0x21, 0x7b, 0xf8, 0x8c, 0xa9, 0x63, 0x6d, 0x00, 0xf3, 0x75, 0x1e, 0x9f, 0x54,
+	0x41, 0xed, 0x07, 0x00, 0xdf, 0xf8, 0xe7, 0xa6, 0xfd, 0x98, 0xaa, 0xce, 0x0e, 0x89, 0xb9, 0xa4,
+	0x6a, 0x4c, 0xfd, 0xbb, 0x32, 0xd4, 0xbf, 0x5a, 0x65, 0x4f, 0xa8, 0x04, 0xa7, 0x03, 0x13, 0x38,
+	0x2d, 0xbd, 0x59, 0xb7, 0x7f, 0x1c, 0x60, 0x6f, 0xdc, 0xff, 0xf6, 0xac, 0x02, 0x9e, 0x9e, 0x55,
+	0xc0, 0xb3, 0xb3, 0x0a, 0xf8, 0xed, 0xac, 0x02, 0x1e, 0x9d, 0x57, 0x72, 0xcf, 0xce, 0x2b, 0xb9,
+	0x5f, 0xce, 0x2b, 0xb9, 0x4f, 0x37, 0x5f, 0x49, 0x4c, 0xef, 0xe2, 0xd3, 0x3c, 0xe5, 0xa9, 0x55,
+	0x48, 0xcb, 0xfa, 0xf6, 0x9f, 0x03, 0x00, 0xd5, 0x23, 0xf9, 0xd1, 0xbe, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +805,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpoch != that1.AutoCompoundEpoch {
+		return false
+	}
+	if this.AutoCompoundMaxGas != that1.AutoCompoundMaxGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1045,6 +1138,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxGas != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundEpoch != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1416,6 +1519,70 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintDistribution(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1587,6 +1754,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundEpoch != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundEpoch))
+	}
+	if m.AutoCompoundMaxGas != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxGas))
+	}
 	return n
 }
 
@@ -1727,6 +1900,35 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1949,6 +2151,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpoch", wireType)
+			}
+			m.AutoCompoundEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGas", wireType)
+			}
+			m.AutoCompoundMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2795,6 +3035,196 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,8 +9,22 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeCommunityPoolSpend       = "community_pool_spend"
+	EventTypeCreateContinuousFund     = "create_continuous_fund"
+	EventTypeCancelContinuousFund     = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout     = "continuous_fund_payout"
+	EventTypeContinuousFundExpired    = "continuous_fund_expired"
+	EventTypeContinuousFundPayoutFail = "continuous_fund_payout_failed"
+
+	EventTypeSetAutoCompound = "set_auto_compound"
+	EventTypeAutoCompound    = "auto_compound"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyError           = "error"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
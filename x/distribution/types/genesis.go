@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +39,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		ContinuousFunds:                 []ContinuousFund{},
+		AutoCompoundRecords:             []DelegatorAutoCompoundRecord{},
 	}
 }
 
@@ -45,5 +49,26 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenFunds := make(map[string]bool, len(gs.ContinuousFunds))
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if seenFunds[fund.Recipient] {
+			return fmt.Errorf("duplicate continuous fund for recipient %s", fund.Recipient)
+		}
+		seenFunds[fund.Recipient] = true
+	}
+
+	for _, record := range gs.AutoCompoundRecords {
+		if _, err := sdk.AccAddressFromBech32(record.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-compound delegator address: %w", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-compound validator address: %w", err)
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
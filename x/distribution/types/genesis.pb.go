@@ -326,6 +326,48 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
 // GenesisState defines the distribution module's genesis state.
+// DelegatorAutoCompoundRecord is used for import / export via genesis json. It
+// marks the delegation of a delegator to a validator as auto-compounding.
+type DelegatorAutoCompoundRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *DelegatorAutoCompoundRecord) Reset()         { *m = DelegatorAutoCompoundRecord{} }
+func (m *DelegatorAutoCompoundRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAutoCompoundRecord) ProtoMessage()    {}
+func (*DelegatorAutoCompoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *DelegatorAutoCompoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorAutoCompoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorAutoCompoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorAutoCompoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAutoCompoundRecord.Merge(m, src)
+}
+func (m *DelegatorAutoCompoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorAutoCompoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAutoCompoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorAutoCompoundRecord proto.InternalMessageInfo
+
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -347,13 +389,17 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// continuous_funds defines the continuous community pool funds at genesis.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,11,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// auto_compound_records defines the auto-compounding delegations at genesis.
+	AutoCompoundRecords []DelegatorAutoCompoundRecord `protobuf:"bytes,12,rep,name=auto_compound_records,json=autoCompoundRecords,proto3" json:"auto_compound_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,6 +436,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*DelegatorAutoCompoundRecord)(nil), "cosmos.distribution.v1beta1.DelegatorAutoCompoundRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -398,63 +445,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0x21, 0x4d, 0xc7, 0x41, 0x0d, 0xdb, 0x24, 0x6c, 0xd2, 0xb2, 0x4e, 0x4b, 0x0f,
+	0x45, 0x55, 0xd7, 0x24, 0x45, 0x80, 0x8a, 0x40, 0xb2, 0xdd, 0x14, 0x38, 0x35, 0xb2, 0x11, 0x95,
+	0x10, 0x68, 0x35, 0xde, 0x1d, 0xaf, 0x07, 0xec, 0x99, 0xd5, 0xcc, 0xec, 0xa6, 0x48, 0x9c, 0x90,
+	0x90, 0x7a, 0x44, 0x82, 0x0f, 0xd0, 0x23, 0x42, 0x42, 0xe2, 0x00, 0x5f, 0x01, 0xf5, 0x58, 0x71,
+	0xe2, 0x80, 0x00, 0x39, 0x1c, 0xf8, 0x0a, 0xdc, 0xd0, 0xce, 0xce, 0xfe, 0x93, 0x37, 0x5b, 0xbb,
+	0xa4, 0x52, 0x4f, 0xc9, 0xce, 0xbc, 0x3f, 0xbf, 0xdf, 0xef, 0xbd, 0x7d, 0x6f, 0x0d, 0x5e, 0x71,
+	0x28, 0x9f, 0x50, 0xde, 0x72, 0x31, 0x17, 0x0c, 0x0f, 0x02, 0x81, 0x29, 0x69, 0x85, 0x7b, 0x03,
+	0x24, 0xe0, 0x5e, 0xcb, 0x43, 0x04, 0x71, 0xcc, 0x2d, 0x9f, 0x51, 0x41, 0xf5, 0x0b, 0xb1, 0xa9,
+	0x95, 0x37, 0xb5, 0x94, 0xe9, 0xce, 0x86, 0x47, 0x3d, 0x2a, 0xed, 0x5a, 0xd1, 0x7f, 0xb1, 0xcb,
+	0x8e, 0xa9, 0xa2, 0x0f, 0x20, 0x47, 0x69, 0x54, 0x87, 0x62, 0xa2, 0xee, 0xad, 0xaa, 0xec, 0x85,
+	0x3c, 0xb1, 0xfd, 0x76, 0x6c, 0x6f, 0xc7, 0x89, 0x14, 0x1e, 0xf9, 0x70, 0xf9, 0x07, 0x0d, 0x6c,
+	0xde, 0x42, 0x63, 0xe4, 0x41, 0x41, 0xd9, 0x5d, 0x2c, 0x46, 0x2e, 0x83, 0x47, 0xef, 0x93, 0x21,
+	0xd5, 0x0f, 0xc0, 0x0b, 0x6e, 0x72, 0x61, 0x43, 0xd7, 0x65, 0x88, 0x73, 0x43, 0xdb, 0xd5, 0xae,
+	0x9e, 0xed, 0x18, 0xbf, 0xfe, 0x74, 0x7d, 0x43, 0x85, 0x69, 0xc7, 0x37, 0x7d, 0xc1, 0x30, 0xf1,
+	0x7a, 0xeb, 0xa9, 0x8b, 0x3a, 0xd7, 0xbb, 0x60, 0xfd, 0x48, 0x85, 0x4d, 0xa3, 0xd4, 0x1f, 0x13,
+	0xe5, 0x5c, 0xe2, 0xa1, 0x8e, 0x6f, 0xae, 0xde, 0x7f, 0xd0, 0xac, 0xfd, 0xf3, 0xa0, 0x59, 0xbb,
+	0xfc, 0xaf, 0x06, 0x2e, 0x7d, 0x08, 0xc7, 0xd8, 0x8d, 0x72, 0xdc, 0x09, 0x04, 0x17, 0x90, 0xb8,
+	0x91, 0x0f, 0x3a, 0x82, 0xcc, 0xe5, 0x3d, 0xe4, 0x50, 0xe6, 0x46, 0xd8, 0xc3, 0xc4, 0x68, 0x7e,
+	0xec, 0xa9, 0x4b, 0x82, 0xfd, 0x4b, 0x0d, 0x9c, 0xa7, 0x59, 0x0e, 0x9b, 0xc5, 0x49, 0x8c, 0xfa,
+	0xee, 0xd2, 0xd5, 0xc6, 0xfe, 0x45, 0x55, 0x06, 0x2b, 0x2a, 0x53, 0x52, 0x51, 0xeb, 0x16, 0x72,
+	0xba, 0x14, 0x93, 0xce, 0x8d, 0x87, 0x7f, 0x34, 0x6b, 0xdf, 0xff, 0xd9, 0xbc, 0xe6, 0x61, 0x31,
+	0x0a, 0x06, 0x96, 0x43, 0x27, 0x4a, 0x79, 0xf5, 0xe7, 0x3a, 0x77, 0x3f, 0x6b, 0x89, 0xcf, 0x7d,
+	0xc4, 0x13, 0x1f, 0xde, 0xd3, 0xe9, 0x0c, 0xa3, 0x1c, 0xf7, 0xdf, 0x35, 0x70, 0x25, 0xe5, 0xde,
+	0x76, 0x9c, 0x60, 0x12, 0x8c, 0xa1, 0x40, 0x6e, 0x97, 0x4e, 0x26, 0x98, 0x73, 0x4c, 0xc9, 0xe9,
+	0xd2, 0x77, 0x40, 0x03, 0x66, 0x59, 0x64, 0xd5, 0x1a, 0xfb, 0x6f, 0x59, 0x15, 0xfd, 0x6c, 0x55,
+	0xc3, 0xeb, 0x2c, 0x47, 0xa2, 0xf4, 0xf2, 0x51, 0x73, 0xf4, 0xfe, 0xd6, 0xc0, 0x6e, 0xea, 0xff,
+	0x1e, 0xe6, 0x82, 0x32, 0xec, 0xc0, 0xf1, 0x53, 0xa9, 0xec, 0x16, 0x58, 0xf1, 0x11, 0xc3, 0x34,
+	0x66, 0xb5, 0xdc, 0x53, 0x4f, 0xfa, 0x5d, 0x70, 0x26, 0x29, 0xf2, 0x92, 0xa4, 0xfb, 0xc6, 0x7c,
+	0x74, 0x67, 0xe0, 0x2a, 0xaa, 0x49, 0xb4, 0x1c, 0xcd, 0x5f, 0x34, 0xf0, 0x52, 0xea, 0xd7, 0x0d,
+	0x18, 0x43, 0x44, 0x3c, 0x15, 0x8e, 0x1f, 0x64, 0x5c, 0xe2, 0xd2, 0xbd, 0x36, 0x1f, 0x97, 0x22,
+	0xa6, 0x93, 0x89, 0x7c, 0x5b, 0x07, 0x17, 0xd2, 0xd1, 0xd1, 0x17, 0x90, 0x09, 0x4c, 0xbc, 0x68,
+	0x74, 0x64, 0x34, 0x4e, 0x63, 0x80, 0x94, 0xaa, 0x51, 0x5f, 0x58, 0x8d, 0x4f, 0xc0, 0xf3, 0x5c,
+	0x61, 0xb4, 0x31, 0x19, 0x52, 0x55, 0xdf, 0xfd, 0x4a, 0x4d, 0x4a, 0xe9, 0x29, 0x45, 0xd6, 0x78,
+	0xee, 0x2c, 0x27, 0xcb, 0xfd, 0x3a, 0xd8, 0x4e, 0xb5, 0xec, 0x8f, 0x21, 0x1f, 0x1d, 0x84, 0x52,
+	0xce, 0x53, 0xee, 0xdf, 0x11, 0xc2, 0xde, 0x48, 0x24, 0xfd, 0x1b, 0x3f, 0xe5, 0xfa, 0x7a, 0xa9,
+	0xd0, 0xd7, 0x9f, 0x82, 0xcd, 0x2c, 0x2d, 0x8f, 0x40, 0xd9, 0x28, 0x42, 0x65, 0x2c, 0x4b, 0x15,
+	0x5e, 0x9d, 0xaf, 0x33, 0x32, 0x36, 0x4a, 0x83, 0xf3, 0xe1, 0xec, 0x55, 0x4e, 0x8a, 0x9f, 0xb5,
+	0x5c, 0x87, 0xb4, 0x03, 0x41, 0xbb, 0x74, 0xe2, 0xd3, 0x80, 0xb8, 0xcf, 0x62, 0x87, 0xe4, 0x70,
+	0xff, 0x08, 0xc0, 0xda, 0xbb, 0xf1, 0x12, 0xef, 0x0b, 0x28, 0x90, 0xde, 0x06, 0x2b, 0x3e, 0x64,
+	0x70, 0x12, 0xa3, 0x6b, 0xec, 0xbf, 0x5c, 0xa9, 0xd7, 0xa1, 0x34, 0x55, 0x12, 0x29, 0x47, 0xfd,
+	0x00, 0xac, 0x0e, 0x11, 0xb2, 0x7d, 0x4a, 0xc7, 0xea, 0x75, 0xbc, 0x52, 0x19, 0xe4, 0x36, 0x42,
+	0x87, 0x94, 0x8e, 0x93, 0xd7, 0x6f, 0x18, 0x3f, 0xea, 0x0c, 0x18, 0x99, 0x64, 0xe9, 0x62, 0x8d,
+	0x1a, 0x3a, 0x9a, 0x58, 0x4b, 0xf3, 0x77, 0x74, 0x7e, 0xd7, 0xab, 0x24, 0x5b, 0x6e, 0xd9, 0xa5,
+	0xd4, 0xd7, 0x67, 0x28, 0xc4, 0x34, 0x90, 0x9f, 0x10, 0x3e, 0xe5, 0x88, 0x19, 0xcb, 0x8f, 0xd3,
+	0x37, 0x71, 0x39, 0x54, 0x1e, 0x7a, 0x50, 0xbe, 0x4c, 0x9f, 0x93, 0xa8, 0xdf, 0x99, 0xaf, 0x03,
+	0x4f, 0xda, 0xf8, 0x8a, 0x41, 0xc9, 0xfe, 0xd4, 0xbf, 0xd1, 0xc0, 0xa5, 0x5c, 0x7b, 0x64, 0xab,
+	0xc7, 0x76, 0xd2, 0xc5, 0xc4, 0x8d, 0x15, 0x89, 0xa2, 0xfd, 0x3f, 0x96, 0x5b, 0x01, 0x48, 0x33,
+	0xac, 0xb4, 0xe5, 0xfa, 0x57, 0x1a, 0xb8, 0x98, 0xa1, 0x1a, 0xa5, 0xeb, 0x23, 0x95, 0xe5, 0x8c,
+	0x04, 0xf4, 0xf6, 0x13, 0xae, 0x9f, 0x02, 0x98, 0x9d, 0xf0, 0x44, 0x3b, 0xfd, 0x0b, 0xb0, 0x9d,
+	0xc1, 0x70, 0xe2, 0xc9, 0x9f, 0x62, 0x58, 0x95, 0x18, 0x6e, 0x3e, 0xc9, 0xda, 0x28, 0x00, 0x78,
+	0x31, 0x2c, 0x37, 0xd2, 0xef, 0xe5, 0xbb, 0xb9, 0x30, 0x9e, 0xb9, 0x71, 0x56, 0x26, 0x7f, 0x73,
+	0xf1, 0xf9, 0x5c, 0x48, 0xbd, 0xe5, 0x96, 0x99, 0x70, 0x9d, 0x81, 0xad, 0xd2, 0x81, 0xc8, 0x0d,
+	0x20, 0xf3, 0xbe, 0xbe, 0xe8, 0x44, 0x2c, 0x64, 0xdd, 0x28, 0x99, 0x8b, 0x5c, 0xff, 0x18, 0xac,
+	0x3b, 0x94, 0x08, 0x4c, 0x82, 0xe8, 0x4d, 0x1a, 0x06, 0xc4, 0xe5, 0x46, 0x43, 0x66, 0xbb, 0x56,
+	0x99, 0xad, 0x9b, 0x3a, 0xdd, 0x0e, 0x48, 0x92, 0xe2, 0x9c, 0x53, 0x38, 0x8d, 0x18, 0x6d, 0xc2,
+	0x40, 0x50, 0xdb, 0x51, 0x33, 0xd6, 0x66, 0x12, 0x11, 0x37, 0xd6, 0x16, 0x11, 0x72, 0x76, 0x4a,
+	0x27, 0xa3, 0x1e, 0xce, 0xdc, 0xe4, 0x46, 0x66, 0xe7, 0xce, 0x77, 0x53, 0x53, 0x7b, 0x38, 0x35,
+	0xb5, 0x47, 0x53, 0x53, 0xfb, 0x6b, 0x6a, 0x6a, 0x5f, 0x1f, 0x9b, 0xb5, 0x47, 0xc7, 0x66, 0xed,
+	0xb7, 0x63, 0xb3, 0xf6, 0xd1, 0x5e, 0xe5, 0x47, 0xf0, 0xbd, 0xe2, 0x0f, 0x19, 0xf9, 0x4d, 0x3c,
+	0x58, 0x91, 0xbf, 0x4f, 0x6e, 0xfc, 0x37, 0x00, 0x08, 0xa5, 0x84, 0xf0, 0x6a, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -760,6 +812,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorAutoCompoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAutoCompoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAutoCompoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +869,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundRecords) > 0 {
+		for iNdEx := len(m.AutoCompoundRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1043,6 +1160,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorAutoCompoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1233,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundRecords) > 0 {
+		for _, e := range m.AutoCompoundRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2002,6 +2148,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorAutoCompoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorAutoCompoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorAutoCompoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2367,6 +2627,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundRecords = append(m.AutoCompoundRecords, DelegatorAutoCompoundRecord{})
+			if err := m.AutoCompoundRecords[len(m.AutoCompoundRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,12 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes>: ContinuousFund
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{0x01}
+//
+// - 0x0B: []byte (key of the next auto-compounding delegation to process)
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +59,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	ContinuousFundPrefix                 = []byte{0x09} // key for continuous community pool funds
+	DelegatorAutoCompoundPrefix          = []byte{0x0A} // key for auto-compounding delegations
+	AutoCompoundCursorKey                = []byte{0x0B} // key for the progress of the current auto-compounding run
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -152,6 +161,35 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetContinuousFundAddress creates the recipient address from a continuous fund key.
+func GetContinuousFundAddress(key []byte) (recipient sdk.AccAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// GetDelegatorAutoCompoundAddresses creates the addresses from a delegator auto-compound key.
+func GetDelegatorAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -213,3 +251,18 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetContinuousFundKey creates the key for a recipient's continuous fund.
+func GetContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// GetDelegatorAutoCompoundPrefix creates the prefix key for a delegator's auto-compounding delegations.
+func GetDelegatorAutoCompoundPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorAutoCompoundKey creates the key marking a delegation as auto-compounding.
+func GetDelegatorAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoCompoundPrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
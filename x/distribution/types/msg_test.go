@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -93,3 +94,100 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCommunityPoolSpend
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("ubnkt", 1000)), true},
+		{emptyDelAddr, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("ubnkt", 1000)), false},
+		{delAddr1, emptyDelAddr, sdk.NewCoins(sdk.NewInt64Coin("ubnkt", 1000)), false},
+		{delAddr1, delAddr2, sdk.Coins{}, false},
+		{delAddr1, delAddr2, sdk.Coins{sdk.NewInt64Coin("ubnkt", 10), sdk.NewInt64Coin("ubnkt", 10)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCreateContinuousFund
+func TestMsgCreateContinuousFund(t *testing.T) {
+	expiry := time.Unix(1000, 0)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ubnkt", 1000))
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		period     uint64
+		expiry     *time.Time
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, amount, 1, nil, true},
+		{delAddr1, delAddr2, amount, 100, &expiry, true},
+		{emptyDelAddr, delAddr2, amount, 1, nil, false},
+		{delAddr1, emptyDelAddr, amount, 1, nil, false},
+		{delAddr1, delAddr2, sdk.Coins{}, 1, nil, false},
+		{delAddr1, delAddr2, amount, 0, nil, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCreateContinuousFund(tc.authority, tc.recipient, tc.amount, tc.period, tc.expiry)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelContinuousFund
+func TestMsgCancelContinuousFund(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, true},
+		{emptyDelAddr, delAddr2, false},
+		{delAddr1, emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCancelContinuousFund(tc.authority, tc.recipient)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -15,8 +15,18 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoCompoundEpoch   = []byte("autocompoundepoch")
+	ParamStoreKeyAutoCompoundMaxGas  = []byte("autocompoundmaxgas")
 )
 
+// Default parameter values
+const (
+	DefaultAutoCompoundEpoch  uint64 = 1000
+	DefaultAutoCompoundMaxGas uint64 = 10_000_000
+)
+
+var _ paramtypes.ParamSetValidator = (*Params)(nil)
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -29,6 +39,8 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		AutoCompoundEpoch:   DefaultAutoCompoundEpoch,
+		AutoCompoundMaxGas:  DefaultAutoCompoundMaxGas,
 	}
 }
 
@@ -44,6 +56,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundEpoch, &p.AutoCompoundEpoch, validateAutoCompoundEpoch),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundMaxGas, &p.AutoCompoundMaxGas, validateAutoCompoundMaxGas),
 	}
 }
 
@@ -69,10 +83,19 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.AutoCompoundEpoch > 0 && p.AutoCompoundMaxGas == 0 {
+		return fmt.Errorf("auto-compound max gas must be positive when auto-compounding is enabled")
+	}
 
 	return nil
 }
 
+// ValidateParamSet implements paramtypes.ParamSetValidator, so that parameter
+// change proposals cannot leave auto-compounding enabled without any gas.
+func (p Params) ValidateParamSet() error {
+	return p.ValidateBasic()
+}
+
 func validateCommunityTax(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -138,3 +161,21 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundEpoch(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundMaxGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -19,11 +23,78 @@ import (
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
-//
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
 func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
-	logger := k.Logger(ctx)
+	k.handleABCIEvidence(ctx, evidence, evidence.GetTime())
+}
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. Tendermint reports every validator that took part in the attack
+// separately, each one is slashed, jailed and tombstoned the same way as for an
+// equivocation. The evidence height is the last height the light client had in
+// common with the chain, which is used to look up the stake distribution to
+// slash.
+//
+// The evidence is considered invalid under the same conditions as for
+// HandleEquivocationEvidence.
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	k.handleABCIEvidence(ctx, evidence, evidence.GetTime())
+}
+
+// HandleDoubleSignEvidence implements a handler for user submitted DoubleSign
+// evidence. The signatures of both votes are verified against the validator's
+// consensus public key before the validator is slashed, jailed and tombstoned.
+// The votes may have been signed for another chain than this one, in which case
+// the evidence height is meaningless on this chain and only the evidence time
+// is used to determine its age.
+//
+// An error is returned if:
+// - the validator's public key is unknown or a signature is invalid
+// - the votes are from this chain and signed for a future height
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - is already tombstoned
+func (k Keeper) HandleDoubleSignEvidence(ctx sdk.Context, evidence *types.DoubleSign) error {
+	consAddr := evidence.GetConsensusAddress()
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrNoValidatorExists, "unknown consensus address %s", consAddr)
+	}
+
+	if !pubKey.VerifySignature(tmtypes.VoteSignBytes(evidence.ChainId, evidence.VoteA), evidence.VoteA.Signature) {
+		return sdkerrors.Wrap(types.ErrInvalidSignature, "vote a")
+	}
+	if !pubKey.VerifySignature(tmtypes.VoteSignBytes(evidence.ChainId, evidence.VoteB), evidence.VoteB.Signature) {
+		return sdkerrors.Wrap(types.ErrInvalidSignature, "vote b")
+	}
+
+	sameChain := evidence.ChainId == ctx.ChainID()
+	if sameChain && evidence.GetHeight() > ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "votes signed for future height %d", evidence.GetHeight())
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, consAddr.String())
+	}
+
+	// The power is not part of the evidence, it is the validator's current power
+	// as this is what is at stake.
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+
+	// Heights of another chain cannot be used to look up the stake distribution
+	// of this chain, so only delegations that are still bonded are slashed.
+	distributionHeight := ctx.BlockHeight()
+	if sameChain {
+		distributionHeight = evidence.GetHeight() - sdk.ValidatorUpdateDelay
+	}
+
+	return k.handleInfraction(ctx, evidence, evidence.GetTime(), power, distributionHeight, sameChain)
+}
+
+// handleABCIEvidence handles validator evidence reported by Tendermint. Evidence
+// that cannot be handled is ignored.
+func (k Keeper) handleABCIEvidence(ctx sdk.Context, evidence exported.ValidatorEvidence, infractionTime time.Time) {
 	consAddr := evidence.GetConsensusAddress()
 
 	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
@@ -39,9 +110,36 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	// Note, that this *can* result in a negative "distributionHeight", up to
+	// -ValidatorUpdateDelay, i.e. at the end of the
+	// pre-genesis block (none) = at the beginning of the genesis block.
+	// That's fine since this is just used to filter unbonding delegations & redelegations.
+	distributionHeight := evidence.GetHeight() - sdk.ValidatorUpdateDelay
+
+	// The `power` is the int64 power of the validator as provided to/by
+	// Tendermint. This value is validator.Tokens as sent to Tendermint via ABCI,
+	// and now received as evidence.
+	if err := k.handleInfraction(ctx, evidence, infractionTime, evidence.GetValidatorPower(), distributionHeight, true); err != nil {
+		return
+	}
+
+	k.SetEvidence(ctx, evidence)
+}
+
+// handleInfraction slashes, jails and tombstones the validator that committed
+// the infraction described by evidence. If checkHeight is false, only the
+// infraction time is used to determine whether the evidence is too old.
+func (k Keeper) handleInfraction(
+	ctx sdk.Context, evidence exported.ValidatorEvidence, infractionTime time.Time,
+	power, distributionHeight int64, checkHeight bool,
+) error {
+	logger := k.Logger(ctx)
+	consAddr := evidence.GetConsensusAddress()
+
 	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
-	infractionTime := evidence.GetTime()
 	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
 	ageBlocks := ctx.BlockHeader().Height - infractionHeight
 
@@ -50,16 +148,16 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// parameters defined.
 	cp := ctx.ConsensusParams()
 	if cp != nil && cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+		if ageDuration > cp.Evidence.MaxAgeDuration && (!checkHeight || ageBlocks > cp.Evidence.MaxAgeNumBlocks) {
 			logger.Info(
-				"ignored equivocation; evidence too old",
+				fmt.Sprintf("ignored %s; evidence too old", evidence.Type()),
 				"validator", consAddr,
 				"infraction_height", infractionHeight,
 				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
 				"infraction_time", infractionTime,
 				"max_age_duration", cp.Evidence.MaxAgeDuration,
 			)
-			return
+			return sdkerrors.Wrapf(types.ErrEvidenceTooOld, "infraction time %s", infractionTime)
 		}
 	}
 
@@ -67,7 +165,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// Tendermint might break this assumption at some point.
-		return
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, consAddr.String())
 	}
 
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
@@ -77,38 +175,28 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// ignore if the validator is already tombstoned
 	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
 		logger.Info(
-			"ignored equivocation; validator already tombstoned",
+			fmt.Sprintf("ignored %s; validator already tombstoned", evidence.Type()),
 			"validator", consAddr,
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
 		)
-		return
+		return sdkerrors.Wrap(types.ErrValidatorTombstoned, consAddr.String())
 	}
 
 	logger.Info(
-		"confirmed equivocation",
+		fmt.Sprintf("confirmed %s", evidence.Type()),
 		"validator", consAddr,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
 	)
 
-	// We need to retrieve the stake distribution which signed the block, so we
-	// subtract ValidatorUpdateDelay from the evidence height.
-	// Note, that this *can* result in a negative "distributionHeight", up to
-	// -ValidatorUpdateDelay, i.e. at the end of the
-	// pre-genesis block (none) = at the beginning of the genesis block.
-	// That's fine since this is just used to filter unbonding delegations & redelegations.
-	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
-
-	// Slash validator. The `power` is the int64 power of the validator as provided
-	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
-	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
+	// Slash validator. The fraction is passed in to separately to slash
+	// unbonding and rebonding delegations.
 	k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 	)
 
 	// Jail the validator if not already jailed. This will begin unbonding the
@@ -119,5 +207,17 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
-	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// NewDoubleSignHandler returns an evidence Handler for DoubleSign evidence to be
+// registered on the evidence Router under types.RouteDoubleSign.
+func NewDoubleSignHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.DoubleSign)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type: %T", e)
+		}
+		return k.HandleDoubleSignEvidence(ctx, evidence)
+	}
 }
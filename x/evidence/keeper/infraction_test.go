@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -111,3 +119,103 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.LightClientAttack{
+		Height:           0,
+		Time:             time.Unix(0, 0).UTC(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+		TotalPower:       power,
+	}
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// should be jailed, tombstoned and slashed
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	res, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.True(ok)
+	suite.Equal(evidence, res)
+}
+
+func (suite *KeeperTestSuite) TestHandleDoubleSignEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(10).WithChainID("test-chain")
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, privKey := valAddresses[1], ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, privKey.PubKey(), power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, privKey.PubKey().Address(), selfDelegation.Int64(), true)
+
+	handler := keeper.NewDoubleSignHandler(suite.app.EvidenceKeeper)
+
+	// votes signed by another key are rejected
+	otherKey := ed25519.GenPrivKey()
+	evidence := types.NewDoubleSign("test-chain", signVote(otherKey, "test-chain", 5, 0x01), signVote(otherKey, "test-chain", 5, 0x02))
+	evidence.VoteA.ValidatorAddress, evidence.VoteB.ValidatorAddress = consAddr, consAddr
+	suite.ErrorIs(handler(ctx, evidence), types.ErrInvalidSignature)
+
+	// votes signed for another chain than the evidence claims are rejected
+	evidence = types.NewDoubleSign("test-chain", signVote(privKey, "other-chain", 5, 0x01), signVote(privKey, "test-chain", 5, 0x02))
+	suite.ErrorIs(handler(ctx, evidence), types.ErrInvalidSignature)
+
+	// votes for a future height of this chain are rejected
+	evidence = types.NewDoubleSign("test-chain", signVote(privKey, "test-chain", 11, 0x01), signVote(privKey, "test-chain", 11, 0x02))
+	suite.ErrorIs(handler(ctx, evidence), types.ErrInvalidEvidence)
+
+	// votes for the same height of another chain are accepted
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence = types.NewDoubleSign("other-chain", signVote(privKey, "other-chain", 100, 0x01), signVote(privKey, "other-chain", 100, 0x02))
+	suite.NoError(handler(ctx, evidence))
+
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	// the validator cannot be slashed twice
+	evidence = types.NewDoubleSign("test-chain", signVote(privKey, "test-chain", 5, 0x01), signVote(privKey, "test-chain", 5, 0x02))
+	suite.ErrorIs(handler(ctx, evidence), types.ErrValidatorTombstoned)
+}
+
+// signVote returns a precommit for the block identified by blockByte signed
+// with privKey for chainID.
+func signVote(privKey cryptotypes.PrivKey, chainID string, height int64, blockByte byte) *tmproto.Vote {
+	vote := &tmproto.Vote{
+		Type:   tmproto.PrecommitType,
+		Height: height,
+		BlockID: tmproto.BlockID{
+			Hash:          bytes.Repeat([]byte{blockByte}, tmhash.Size),
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{blockByte}, tmhash.Size)},
+		},
+		Timestamp:        time.Now().UTC(),
+		ValidatorAddress: privKey.PubKey().Address(),
+	}
+
+	sig, err := privKey.Sign(tmtypes.VoteSignBytes(chainID, vote))
+	if err != nil {
+		panic(err)
+	}
+	vote.Signature = sig
+
+	return vote
+}
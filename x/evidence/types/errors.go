@@ -11,4 +11,8 @@ var (
 	ErrInvalidEvidence         = sdkerrors.Register(ModuleName, 3, "invalid evidence")
 	ErrNoEvidenceExists        = sdkerrors.Register(ModuleName, 4, "evidence does not exist")
 	ErrEvidenceExists          = sdkerrors.Register(ModuleName, 5, "evidence already exists")
+	ErrInvalidSignature        = sdkerrors.Register(ModuleName, 6, "invalid evidence signature")
+	ErrEvidenceTooOld          = sdkerrors.Register(ModuleName, 7, "evidence too old")
+	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 8, "validator does not exist or is unbonded")
+	ErrValidatorTombstoned     = sdkerrors.Register(ModuleName, 9, "validator already tombstoned")
 )
@@ -1,12 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +18,19 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "lightclientattack"
+	RouteDoubleSign        = "doublesign"
+	TypeDoubleSign         = "doublesign"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.ValidatorEvidence = &Equivocation{}
+	_ exported.ValidatorEvidence = &LightClientAttack{}
+	_ exported.ValidatorEvidence = &DoubleSign{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +112,193 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// attack.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the common height of the light client and the chain at
+// time of the attack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time of the common height at time of the attack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the attack.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at time of the
+// attack.
+func (e LightClientAttack) GetTotalPower() int64 {
+	return e.TotalPower
+}
+
+// LightClientAttackFromABCIEvidence converts a Tendermint light client attack
+// Evidence type to SDK Evidence using LightClientAttack as the concrete type.
+func LightClientAttackFromABCIEvidence(e abci.Evidence) exported.Evidence {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return &LightClientAttack{
+		Height:           e.Height,
+		Power:            e.Validator.Power,
+		ConsensusAddress: consAddr,
+		Time:             e.Time,
+		TotalPower:       e.TotalVotingPower,
+	}
+}
+
+// NewDoubleSign returns a new DoubleSign evidence for two conflicting votes
+// signed for chainID.
+func NewDoubleSign(chainID string, voteA, voteB *tmproto.Vote) *DoubleSign {
+	return &DoubleSign{
+		ChainId: chainID,
+		VoteA:   voteA,
+		VoteB:   voteB,
+	}
+}
+
+// Route returns the Evidence Handler route for a DoubleSign type.
+func (e *DoubleSign) Route() string { return RouteDoubleSign }
+
+// Type returns the Evidence Handler type for a DoubleSign type.
+func (e *DoubleSign) Type() string { return TypeDoubleSign }
+
+func (e *DoubleSign) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a DoubleSign object. The votes are hashed in a
+// canonical order so the same pair of votes always results in the same hash.
+func (e *DoubleSign) Hash() tmbytes.HexBytes {
+	canonical := *e
+	if e.VoteA != nil && e.VoteB != nil && bytes.Compare(e.VoteA.BlockID.Hash, e.VoteB.BlockID.Hash) > 0 {
+		canonical.VoteA, canonical.VoteB = e.VoteB, e.VoteA
+	}
+
+	bz, err := canonical.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a DoubleSign
+// object. The signatures are verified by the evidence handler as it needs the
+// validator's public key.
+func (e *DoubleSign) ValidateBasic() error {
+	if e.ChainId == "" {
+		return fmt.Errorf("invalid double sign chain-id: %s", e.ChainId)
+	}
+	if e.VoteA == nil || e.VoteB == nil {
+		return fmt.Errorf("invalid double sign votes: both votes must be set")
+	}
+
+	voteA, err := tmtypes.VoteFromProto(e.VoteA)
+	if err != nil {
+		return fmt.Errorf("invalid double sign vote a: %w", err)
+	}
+	voteB, err := tmtypes.VoteFromProto(e.VoteB)
+	if err != nil {
+		return fmt.Errorf("invalid double sign vote b: %w", err)
+	}
+
+	if voteA.Height < 1 {
+		return fmt.Errorf("invalid double sign height: %d", voteA.Height)
+	}
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return fmt.Errorf(
+			"invalid double sign votes: height, round and type must match, got %d/%d/%s and %d/%d/%s",
+			voteA.Height, voteA.Round, voteA.Type, voteB.Height, voteB.Round, voteB.Type,
+		)
+	}
+	if !bytes.Equal(voteA.ValidatorAddress, voteB.ValidatorAddress) {
+		return fmt.Errorf(
+			"invalid double sign votes: validator addresses do not match, got %s and %s",
+			voteA.ValidatorAddress, voteB.ValidatorAddress,
+		)
+	}
+	if voteA.BlockID.Equals(voteB.BlockID) {
+		return fmt.Errorf("invalid double sign votes: votes are for the same block %s", voteA.BlockID)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator that
+// signed the votes.
+func (e DoubleSign) GetConsensusAddress() sdk.ConsAddress {
+	return sdk.ConsAddress(e.VoteA.ValidatorAddress)
+}
+
+// GetHeight returns the height the votes were signed at.
+func (e DoubleSign) GetHeight() int64 {
+	return e.VoteA.Height
+}
+
+// GetTime returns the time the first vote was signed at.
+func (e DoubleSign) GetTime() time.Time {
+	return e.VoteA.Timestamp
+}
+
+// GetValidatorPower is a no-op for the DoubleSign type as the power is looked
+// up when the evidence is handled.
+func (e DoubleSign) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the DoubleSign type.
+func (e DoubleSign) GetTotalPower() int64 { return 0 }
@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -88,3 +91,100 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000, addr.String(), 3000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000, addr.String(), 3000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000, addr.String(), 3000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr.String(), 3000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000, addr.String(), 999}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000, "", 3000}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestLightClientAttackFromABCIEvidence(t *testing.T) {
+	tmEvidence := abci.Evidence{
+		Type: abci.EvidenceType_LIGHT_CLIENT_ATTACK,
+		Validator: abci.Validator{
+			Address: []byte("foo_________________"),
+			Power:   100,
+		},
+		Height:           10,
+		Time:             time.Now(),
+		TotalVotingPower: 300,
+	}
+
+	evidence := types.LightClientAttackFromABCIEvidence(tmEvidence).(*types.LightClientAttack)
+	require.Equal(t, tmEvidence.Validator.Address, evidence.GetConsensusAddress().Bytes())
+	require.Equal(t, int64(100), evidence.GetValidatorPower())
+	require.Equal(t, int64(300), evidence.GetTotalPower())
+	require.Equal(t, int64(10), evidence.GetHeight())
+	require.Equal(t, types.RouteLightClientAttack, evidence.Route())
+	require.NoError(t, evidence.ValidateBasic())
+}
+
+func TestDoubleSignValidateBasic(t *testing.T) {
+	addr := []byte("foo_________________")
+	newVote := func(blockByte byte) *tmproto.Vote {
+		return &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: 10,
+			BlockID: tmproto.BlockID{
+				Hash:          bytes.Repeat([]byte{blockByte}, tmhash.Size),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{blockByte}, tmhash.Size)},
+			},
+			Timestamp:        time.Now().UTC(),
+			ValidatorAddress: addr,
+			Signature:        []byte("signature"),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(e *types.DoubleSign)
+		expectErr bool
+	}{
+		{"valid", func(e *types.DoubleSign) {}, false},
+		{"missing chain-id", func(e *types.DoubleSign) { e.ChainId = "" }, true},
+		{"missing vote", func(e *types.DoubleSign) { e.VoteB = nil }, true},
+		{"missing signature", func(e *types.DoubleSign) { e.VoteA.Signature = nil }, true},
+		{"different heights", func(e *types.DoubleSign) { e.VoteB.Height = 11 }, true},
+		{"different rounds", func(e *types.DoubleSign) { e.VoteB.Round = 1 }, true},
+		{"different types", func(e *types.DoubleSign) { e.VoteB.Type = tmproto.PrevoteType }, true},
+		{"different validators", func(e *types.DoubleSign) { e.VoteB.ValidatorAddress = []byte("bar_________________") }, true},
+		{"same block", func(e *types.DoubleSign) { e.VoteB.BlockID = e.VoteA.BlockID }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := types.NewDoubleSign("test-chain", newVote(0x01), newVote(0x02))
+			tc.malleate(e)
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+		})
+	}
+
+	// the hash does not depend on the order of the votes
+	voteA, voteB := newVote(0x01), newVote(0x02)
+	e := types.NewDoubleSign("test-chain", voteA, voteB)
+	require.Equal(t, e.Hash(), types.NewDoubleSign("test-chain", voteB, voteA).Hash())
+	require.Equal(t, sdk.ConsAddress(addr), e.GetConsensusAddress())
+	require.Equal(t, int64(10), e.GetHeight())
+	require.Equal(t, types.RouteDoubleSign, e.Route())
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	feeabsQueryCmd := &cobra.Command{
+		Use:                        feeabs.ModuleName,
+		Short:                      "Querying commands for the feeabs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeabsQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryPrice(),
+	)
+
+	return feeabsQueryCmd
+}
+
+// GetCmdQueryParams returns cmd to query the feeabs parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the fee abstraction parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee abstraction parameters, including the denoms allowed to pay the fees.
+
+Example:
+$ %s query %s params
+`, version.AppName, feeabs.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeabs.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &feeabs.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrice returns cmd to query the time-weighted average price of an
+// allowed denom.
+func GetCmdQueryPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price of a denom allowed to pay the fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of a denom allowed to pay the fees,
+expressed as the amount of native denom per unit of the given denom.
+
+Example:
+$ %s query %s price ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`, version.AppName, feeabs.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeabs.NewQueryClient(clientCtx)
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Price(cmd.Context(), &feeabs.QueryPriceRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
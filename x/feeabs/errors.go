@@ -0,0 +1,13 @@
+package feeabs
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feeabs module sentinel errors
+var (
+	ErrInvalidParams    = sdkerrors.Register(ModuleName, 2, "invalid params")
+	ErrDenomNotAllowed  = sdkerrors.Register(ModuleName, 3, "denom is not allowed to pay fees")
+	ErrPriceUnavailable = sdkerrors.Register(ModuleName, 4, "price is unavailable")
+	ErrNoSwapper        = sdkerrors.Register(ModuleName, 5, "no fee swapper configured")
+)
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feeabs/v1/events.proto
+
+package feeabs
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventConvertFee is emitted when the fee of a transaction paid in an allowed
+// denom is converted into the native denom.
+type EventConvertFee struct {
+	// fee is the fee paid by the transaction.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// converted_fee is the value of the fee in the native denom.
+	ConvertedFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=converted_fee,json=convertedFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_fee"`
+}
+
+func (m *EventConvertFee) Reset()         { *m = EventConvertFee{} }
+func (m *EventConvertFee) String() string { return proto.CompactTextString(m) }
+func (*EventConvertFee) ProtoMessage()    {}
+func (*EventConvertFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d51b2be4a46bb4, []int{0}
+}
+func (m *EventConvertFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertFee.Merge(m, src)
+}
+func (m *EventConvertFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertFee proto.InternalMessageInfo
+
+func (m *EventConvertFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *EventConvertFee) GetConvertedFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConvertedFee
+	}
+	return nil
+}
+
+// EventSwapFees is emitted when the fees collected in an allowed denom are
+// swapped into the native denom.
+type EventSwapFees struct {
+	// fees is the collected fees which were swapped.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// proceeds is the amount of native denom received for the fees.
+	Proceeds types.Coin `protobuf:"bytes,2,opt,name=proceeds,proto3" json:"proceeds"`
+}
+
+func (m *EventSwapFees) Reset()         { *m = EventSwapFees{} }
+func (m *EventSwapFees) String() string { return proto.CompactTextString(m) }
+func (*EventSwapFees) ProtoMessage()    {}
+func (*EventSwapFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d51b2be4a46bb4, []int{1}
+}
+func (m *EventSwapFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapFees.Merge(m, src)
+}
+func (m *EventSwapFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapFees proto.InternalMessageInfo
+
+func (m *EventSwapFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *EventSwapFees) GetProceeds() types.Coin {
+	if m != nil {
+		return m.Proceeds
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EventConvertFee)(nil), "cosmos.feeabs.v1.EventConvertFee")
+	proto.RegisterType((*EventSwapFees)(nil), "cosmos.feeabs.v1.EventSwapFees")
+}
+
+func init() { proto.RegisterFile("cosmos/feeabs/v1/events.proto", fileDescriptor_55d51b2be4a46bb4) }
+
+var fileDescriptor_55d51b2be4a46bb4 = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x9b, 0x6d, 0x88, 0x44, 0x87, 0x52, 0x3c, 0xcc, 0x81, 0xd9, 0x18, 0x08, 0xbb, 0x98,
+	0x58, 0x3d, 0x7a, 0x10, 0x36, 0xdc, 0x03, 0xcc, 0x9b, 0x20, 0xa3, 0xed, 0xbe, 0xd5, 0x22, 0xeb,
+	0x57, 0xfa, 0xc5, 0xaa, 0x6f, 0xe1, 0x73, 0x08, 0xbe, 0xc7, 0x8e, 0x3b, 0x7a, 0x52, 0x69, 0x5f,
+	0x44, 0xd2, 0x94, 0x1d, 0x77, 0xd2, 0x53, 0x02, 0xff, 0x7c, 0xbf, 0x5f, 0xfe, 0x24, 0xfc, 0x24,
+	0x44, 0x5a, 0x22, 0xa9, 0x05, 0x80, 0x1f, 0x90, 0xca, 0x3d, 0x05, 0x39, 0x24, 0x9a, 0x64, 0x9a,
+	0xa1, 0x46, 0xf7, 0xd0, 0xc6, 0xd2, 0xc6, 0x32, 0xf7, 0xba, 0x47, 0x11, 0x46, 0x58, 0x85, 0xca,
+	0xec, 0xec, 0xb9, 0xae, 0xa8, 0x31, 0x81, 0x4f, 0xa0, 0x72, 0x2f, 0x00, 0xed, 0x7b, 0x2a, 0xc4,
+	0x38, 0xb1, 0xf9, 0xa0, 0x60, 0xfc, 0xe0, 0xc6, 0x80, 0xc7, 0x98, 0xe4, 0x90, 0xe9, 0x09, 0x80,
+	0x7b, 0xcf, 0x9b, 0x0b, 0x80, 0x0e, 0xeb, 0x37, 0x87, 0x7b, 0x17, 0xc7, 0xb2, 0x36, 0x19, 0x82,
+	0xac, 0x09, 0x72, 0x8c, 0x71, 0x32, 0x3a, 0x5f, 0x7d, 0xf5, 0x9c, 0xf7, 0xef, 0xde, 0x30, 0x8a,
+	0xf5, 0xc3, 0x53, 0x20, 0x43, 0x5c, 0xaa, 0x5a, 0x67, 0x97, 0x33, 0x9a, 0x3f, 0x2a, 0xfd, 0x9a,
+	0x02, 0x55, 0x03, 0x34, 0x35, 0x5c, 0x37, 0xe5, 0xed, 0xd0, 0xca, 0x60, 0x3e, 0x33, 0xa2, 0xc6,
+	0xdf, 0x8b, 0xf6, 0x37, 0x86, 0x09, 0xc0, 0xe0, 0x83, 0xf1, 0x76, 0x55, 0xf2, 0xf6, 0xd9, 0x4f,
+	0x27, 0x00, 0xe4, 0xce, 0x78, 0x6b, 0x01, 0x40, 0xff, 0xd1, 0xb1, 0x02, 0xbb, 0x57, 0x7c, 0x37,
+	0xcd, 0x30, 0x04, 0x98, 0x53, 0xa7, 0xd1, 0x67, 0xdb, 0x25, 0x2d, 0x23, 0x99, 0x6e, 0x06, 0x46,
+	0xd7, 0xab, 0x42, 0xb0, 0x75, 0x21, 0xd8, 0x4f, 0x21, 0xd8, 0x5b, 0x29, 0x9c, 0x75, 0x29, 0x9c,
+	0xcf, 0x52, 0x38, 0x77, 0xa7, 0x5b, 0xaf, 0xf1, 0x52, 0xff, 0x96, 0x60, 0xa7, 0x7a, 0xdc, 0xcb,
+	0xdf, 0x01, 0x00, 0x77, 0xd3, 0xdb, 0xf4, 0x45, 0x02, 0x00, 0x00,
+}
+
+func (m *EventConvertFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConvertedFee) > 0 {
+		for iNdEx := len(m.ConvertedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwapFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventConvertFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ConvertedFee) > 0 {
+		for _, e := range m.ConvertedFee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSwapFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Proceeds.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventConvertFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedFee = append(m.ConvertedFee, types.Coin{})
+			if err := m.ConvertedFee[len(m.ConvertedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwapFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwapFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwapFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
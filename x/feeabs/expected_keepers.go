@@ -0,0 +1,29 @@
+package feeabs
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceProvider supplies the prices used to convert the fees paid in an
+// allowed denom into the native denom, e.g. an oracle or a DEX module.
+type PriceProvider interface {
+	// GetTWAP returns the time-weighted average price of the base denom over
+	// the given window, expressed as the amount of quote denom per unit of
+	// base denom.
+	GetTWAP(ctx sdk.Context, baseDenom, quoteDenom string, window time.Duration) (sdk.Dec, error)
+}
+
+// FeeSwapper swaps the fees collected in an allowed denom into the native
+// denom, e.g. through a DEX module.
+type FeeSwapper interface {
+	// SwapExactIn sells the given coins held by the module account for the
+	// given denom. The proceeds are credited to the same module account.
+	SwapExactIn(ctx sdk.Context, moduleName string, coins sdk.Coins, denomOut string) (sdk.Coin, error)
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
@@ -0,0 +1,16 @@
+package feeabs
+
+// NewGenesisState creates a new genesis state for the feeabs module.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{Params: params}
+}
+
+// DefaultGenesisState returns the default genesis state of the feeabs module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues.
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
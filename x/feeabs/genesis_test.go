@@ -0,0 +1,40 @@
+package feeabs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	native := sdk.DefaultBondDenom
+
+	testCases := []struct {
+		name   string
+		params feeabs.Params
+		expErr bool
+	}{
+		{"default", feeabs.DefaultParams(), false},
+		{"valid", feeabs.NewParams(native, []string{"ibc/atom", "ibc/osmo"}, time.Hour, true), false},
+		{"invalid native denom", feeabs.NewParams("", nil, time.Hour, false), true},
+		{"invalid allowed denom", feeabs.NewParams(native, []string{"1atom"}, time.Hour, false), true},
+		{"native denom allowed", feeabs.NewParams(native, []string{native}, time.Hour, false), true},
+		{"duplicate allowed denom", feeabs.NewParams(native, []string{"ibc/atom", "ibc/atom"}, time.Hour, false), true},
+		{"zero twap window", feeabs.NewParams(native, []string{"ibc/atom"}, 0, false), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := feeabs.ValidateGenesis(*feeabs.NewGenesisState(tc.params))
+			if tc.expErr {
+				require.ErrorIs(t, err, feeabs.ErrInvalidParams)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
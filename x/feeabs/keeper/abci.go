@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker swaps the fees collected in the allowed denoms during the block
+// into the native denom, before they are distributed by x/distribution at the
+// beginning of the next block. A failed swap is not fatal: the fees are then
+// kept in the fee collector as is and distributed in their own denoms.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SwapFees(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to swap fees", "err", err)
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+// InitGenesis initializes the feeabs module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feeabs.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the feeabs module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feeabs.GenesisState {
+	return feeabs.NewGenesisState(k.GetParams(ctx))
+}
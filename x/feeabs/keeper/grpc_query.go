@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+var _ feeabs.QueryServer = Keeper{}
+
+// Params returns the feeabs parameters.
+func (k Keeper) Params(goCtx context.Context, req *feeabs.QueryParamsRequest) (*feeabs.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feeabs.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Price returns the time-weighted average price of an allowed denom.
+func (k Keeper) Price(goCtx context.Context, req *feeabs.QueryPriceRequest) (*feeabs.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	price, err := k.GetPrice(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feeabs.QueryPriceResponse{Price: price}, nil
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+// Keeper of the feeabs store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	bankKeeper    feeabs.BankKeeper
+	priceProvider feeabs.PriceProvider
+	swapper       feeabs.FeeSwapper
+	authority     string
+}
+
+var _ ante.FeeAbstractionKeeper = Keeper{}
+
+// NewKeeper creates a new feeabs Keeper instance. The price provider is used
+// to convert the fees paid in an allowed denom; without it, only the native
+// denom can pay the fees. The swapper is optional: without it, the fees paid
+// in an allowed denom are kept in the fee collector as is.
+func NewKeeper(
+	key storetypes.StoreKey, cdc codec.BinaryCodec, bk feeabs.BankKeeper,
+	priceProvider feeabs.PriceProvider, swapper feeabs.FeeSwapper, authority string,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		bankKeeper:    bk,
+		priceProvider: priceProvider,
+		swapper:       swapper,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the x/feeabs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feeabs.ModuleName))
+}
+
+// GetParams returns the feeabs parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feeabs.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the feeabs parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params feeabs.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(ParamsKey, bz)
+	return nil
+}
+
+// GetPrice returns the time-weighted average price of an allowed denom,
+// expressed as the amount of native denom per unit of the given denom.
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedDenom(denom) {
+		return sdk.Dec{}, feeabs.ErrDenomNotAllowed.Wrap(denom)
+	}
+	if k.priceProvider == nil {
+		return sdk.Dec{}, feeabs.ErrPriceUnavailable.Wrap("no price provider configured")
+	}
+
+	price, err := k.priceProvider.GetTWAP(ctx, denom, params.NativeDenom, params.TwapWindow)
+	if err != nil {
+		return sdk.Dec{}, feeabs.ErrPriceUnavailable.Wrapf("%s: %s", denom, err)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return sdk.Dec{}, feeabs.ErrPriceUnavailable.Wrapf("%s: non-positive price %s", denom, price)
+	}
+
+	return price, nil
+}
+
+// ConvertFee returns the value of the given fee where the coins in an allowed
+// denom are replaced by their value in the native denom, truncated. The other
+// coins are returned unchanged. The returned boolean reports whether any coin
+// was converted.
+func (k Keeper) ConvertFee(ctx sdk.Context, fee sdk.Coins) (sdk.Coins, bool, error) {
+	params := k.GetParams(ctx)
+
+	converted := sdk.NewCoins()
+	hasConverted := false
+	for _, coin := range fee {
+		if !params.IsAllowedDenom(coin.Denom) {
+			converted = converted.Add(coin)
+			continue
+		}
+
+		price, err := k.GetPrice(ctx, coin.Denom)
+		if err != nil {
+			return nil, false, err
+		}
+
+		value := price.MulInt(coin.Amount).TruncateInt()
+		converted = converted.Add(sdk.NewCoin(params.NativeDenom, value))
+		hasConverted = true
+	}
+
+	if !hasConverted {
+		return fee, false, nil
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&feeabs.EventConvertFee{
+		Fee:          fee,
+		ConvertedFee: converted,
+	}); err != nil {
+		return nil, false, err
+	}
+
+	return converted, true, nil
+}
+
+// SwapFees swaps the fees collected in the allowed denoms by the fee
+// collector into the native denom. It does nothing if fee swapping is
+// disabled.
+func (k Keeper) SwapFees(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.SwapFees || len(params.AllowedDenoms) == 0 {
+		return nil
+	}
+	if k.swapper == nil {
+		return feeabs.ErrNoSwapper
+	}
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins()
+	for _, denom := range params.AllowedDenoms {
+		fees = fees.Add(k.bankKeeper.GetBalance(ctx, feeCollector, denom))
+	}
+	if fees.IsZero() {
+		return nil
+	}
+
+	proceeds, err := k.swapper.SwapExactIn(ctx, authtypes.FeeCollectorName, fees, params.NativeDenom)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&feeabs.EventSwapFees{
+		Fees:     fees,
+		Proceeds: proceeds,
+	})
+}
@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	nativeDenom = sdk.DefaultBondDenom
+	atomDenom   = "ibc/atom"
+	osmoDenom   = "ibc/osmo"
+)
+
+// mockPriceProvider returns fixed prices, in native denom per unit.
+type mockPriceProvider map[string]sdk.Dec
+
+func (p mockPriceProvider) GetTWAP(_ sdk.Context, baseDenom, quoteDenom string, _ time.Duration) (sdk.Dec, error) {
+	price, ok := p[baseDenom]
+	if !ok || quoteDenom != nativeDenom {
+		return sdk.Dec{}, errors.New("no pool")
+	}
+	return price, nil
+}
+
+// mockSwapper swaps the coins against a pool account at the mock prices.
+type mockSwapper struct {
+	send          func(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+	pool          sdk.AccAddress
+	priceProvider mockPriceProvider
+}
+
+func (s mockSwapper) SwapExactIn(ctx sdk.Context, moduleName string, coins sdk.Coins, denomOut string) (sdk.Coin, error) {
+	out := sdk.NewCoin(denomOut, sdk.ZeroInt())
+	for _, coin := range coins {
+		price, err := s.priceProvider.GetTWAP(ctx, coin.Denom, denomOut, 0)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		out = out.AddAmount(price.MulInt(coin.Amount).TruncateInt())
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(moduleName)
+	if err := s.send(ctx, moduleAddr, s.pool, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := s.send(ctx, s.pool, moduleAddr, sdk.NewCoins(out)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return out, nil
+}
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	keeper      keeper.Keeper
+	msgServer   feeabs.MsgServer
+	queryClient feeabs.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	prices := mockPriceProvider{atomDenom: sdk.NewDec(2), osmoDenom: sdk.NewDecWithPrec(5, 1)}
+	swapper := mockSwapper{
+		send:          app.BankKeeper.SendCoins,
+		pool:          s.addrs[1],
+		priceProvider: prices,
+	}
+	s.keeper = keeper.NewKeeper(app.GetKey(feeabs.StoreKey), app.AppCodec(), app.BankKeeper, prices, swapper, s.authority)
+	s.Require().NoError(s.keeper.SetParams(ctx, feeabs.NewParams(nativeDenom, []string{atomDenom, osmoDenom}, feeabs.DefaultTWAPWindow, true)))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	feeabs.RegisterQueryServer(queryHelper, s.keeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryClient = feeabs.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	params := feeabs.NewParams(nativeDenom, []string{atomDenom}, time.Hour, false)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, feeabs.NewMsgUpdateParams(s.addrs[0].String(), params))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := feeabs.NewParams(nativeDenom, []string{nativeDenom}, time.Hour, false)
+	_, err = s.msgServer.UpdateParams(s.ctx, feeabs.NewMsgUpdateParams(s.authority, invalid))
+	s.Require().ErrorIs(err, feeabs.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, feeabs.NewMsgUpdateParams(s.authority, params))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &feeabs.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *TestSuite) TestPrice() {
+	res, err := s.queryClient.Price(s.ctx, &feeabs.QueryPriceRequest{Denom: atomDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), res.Price)
+
+	_, err = s.queryClient.Price(s.ctx, &feeabs.QueryPriceRequest{Denom: "ibc/unknown"})
+	s.Require().ErrorIs(err, feeabs.ErrDenomNotAllowed)
+
+	// an allowed denom without a price cannot pay the fees
+	s.Require().NoError(s.keeper.SetParams(s.ctx, feeabs.NewParams(nativeDenom, []string{"ibc/noprice"}, time.Hour, false)))
+	_, err = s.queryClient.Price(s.ctx, &feeabs.QueryPriceRequest{Denom: "ibc/noprice"})
+	s.Require().ErrorIs(err, feeabs.ErrPriceUnavailable)
+}
+
+func (s *TestSuite) TestConvertFee() {
+	testCases := []struct {
+		name      string
+		fee       sdk.Coins
+		expFee    sdk.Coins
+		converted bool
+	}{
+		{
+			name:   "native denom only",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 100)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 100)),
+		},
+		{
+			name:   "not allowed denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("ibc/unknown", 100)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("ibc/unknown", 100)),
+		},
+		{
+			name:      "allowed denom",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 100)),
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 200)),
+			converted: true,
+		},
+		{
+			name:      "allowed denoms and native denom are summed, truncated",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 10), sdk.NewInt64Coin(osmoDenom, 5), sdk.NewInt64Coin(nativeDenom, 1)),
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 23)),
+			converted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			fee, converted, err := s.keeper.ConvertFee(s.ctx, tc.fee)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.converted, converted)
+			s.Require().Equal(tc.expFee, fee)
+		})
+	}
+
+	// no denom can be converted without a price provider
+	k := keeper.NewKeeper(s.app.GetKey(feeabs.StoreKey), s.app.AppCodec(), s.app.BankKeeper, nil, nil, s.authority)
+	_, _, err := k.ConvertFee(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 100)))
+	s.Require().ErrorIs(err, feeabs.ErrPriceUnavailable)
+}
+
+func (s *TestSuite) TestEndBlockerSwapFees() {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 100), sdk.NewInt64Coin(nativeDenom, 10))
+	s.Require().NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	before := s.app.BankKeeper.GetBalance(s.ctx, feeCollector, nativeDenom)
+
+	s.keeper.EndBlocker(s.ctx)
+
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, feeCollector, atomDenom).IsZero())
+	s.Require().Equal(before.AddAmount(sdk.NewInt(200)), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, nativeDenom))
+	s.Require().Equal(sdk.NewInt(100), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], atomDenom).Amount)
+
+	// a failed swap keeps the fees in the fee collector
+	s.Require().NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(osmoDenom, 1000000000))))
+	s.keeper.EndBlocker(s.ctx)
+	s.Require().Equal(sdk.NewInt(1000000000), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, osmoDenom).Amount)
+
+	// the fees are kept as is if swapping is disabled
+	s.Require().NoError(s.keeper.SetParams(s.ctx, feeabs.NewParams(nativeDenom, []string{osmoDenom}, time.Hour, false)))
+	s.Require().NoError(s.keeper.SwapFees(s.ctx))
+	s.Require().Equal(sdk.NewInt(1000000000), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, osmoDenom).Amount)
+
+	// swapping requires a swapper
+	s.Require().NoError(s.keeper.SetParams(s.ctx, feeabs.NewParams(nativeDenom, []string{osmoDenom}, time.Hour, true)))
+	k := keeper.NewKeeper(s.app.GetKey(feeabs.StoreKey), s.app.AppCodec(), s.app.BankKeeper, nil, nil, s.authority)
+	s.Require().True(sdkerrors.IsOf(k.SwapFees(s.ctx), feeabs.ErrNoSwapper))
+}
@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+)
+
+var ParamsKey = []byte{0x01}
+
+// StoreKey is the store key string for feeabs
+const StoreKey = feeabs.ModuleName
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ feeabs.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feeabs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) feeabs.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the feeabs module parameters.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *feeabs.MsgUpdateParams) (*feeabs.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &feeabs.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package feeabs
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feeabs"
+
+	// StoreKey is the store key string for feeabs
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feeabs
+	RouterKey = ModuleName
+)
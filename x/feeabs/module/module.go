@@ -0,0 +1,214 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	modulev1 "cosmossdk.io/api/cosmos/feeabs/module/v1"
+	"cosmossdk.io/core/appmodule"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/depinject"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the feeabs module.
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
+
+// Name returns the feeabs module's name.
+func (AppModuleBasic) Name() string {
+	return feeabs.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the feeabs module's types for the given codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	feeabs.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the feeabs module's interface types
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	feeabs.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the feeabs
+// module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(feeabs.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the feeabs module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config sdkclient.TxEncodingConfig, bz json.RawMessage) error {
+	var data feeabs.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return sdkerrors.Wrapf(err, "failed to unmarshal %s genesis state", feeabs.ModuleName)
+	}
+
+	return feeabs.ValidateGenesis(data)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the feeabs module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux *gwruntime.ServeMux) {
+	if err := feeabs.RegisterQueryHandlerClient(context.Background(), mux, feeabs.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueryCmd returns the cli query commands for the feeabs module
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// GetTxCmd returns no root tx command for the feeabs module, its parameters
+// are only updated through governance.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// AppModule implements the sdk.AppModule interface
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         keeper,
+	}
+}
+
+// Name returns the feeabs module's name.
+func (AppModule) Name() string {
+	return feeabs.ModuleName
+}
+
+// RegisterServices registers the feeabs module's gRPC Msg and query services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	feeabs.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	feeabs.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// RegisterInvariants does nothing, there are no invariants to enforce
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the feeabs module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(feeabs.RouterKey, nil)
+}
+
+// QuerierRoute returns the route we respond to for abci queries
+func (AppModule) QuerierRoute() string { return "" }
+
+// LegacyQuerierHandler returns the feeabs module sdk.Querier.
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// InitGenesis performs genesis initialization for the feeabs module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState feeabs.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.InitGenesis(ctx, &genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the feeabs
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := am.keeper.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(gs)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// BeginBlock does nothing
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
+
+// EndBlock swaps the fees collected in the allowed denoms into the native
+// denom. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
+	return []abci.ValidatorUpdate{}
+}
+
+//
+// New App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type feeabsInputs struct {
+	depinject.In
+
+	Config     *modulev1.Module
+	Key        *store.KVStoreKey
+	Cdc        codec.Codec
+	BankKeeper feeabs.BankKeeper `key:"cosmos.bank.v1.Keeper"`
+
+	// PriceProvider and FeeSwapper are supplied by the app, e.g. by an oracle
+	// or a DEX module. Without a price provider, only the native denom can pay
+	// the fees.
+	PriceProvider feeabs.PriceProvider `optional:"true"`
+	FeeSwapper    feeabs.FeeSwapper    `optional:"true"`
+}
+
+type feeabsOutputs struct {
+	depinject.Out
+
+	FeeAbsKeeper keeper.Keeper `key:"cosmos.feeabs.v1.Keeper"`
+	Module       runtime.AppModuleWrapper
+}
+
+func provideModule(in feeabsInputs) feeabsOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authorityAddress(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.BankKeeper, in.PriceProvider, in.FeeSwapper, authority.String())
+	m := NewAppModule(in.Cdc, k)
+
+	return feeabsOutputs{FeeAbsKeeper: k, Module: runtime.WrapAppModule(m)}
+}
+
+// authorityAddress returns the address of the given bech32 address or module
+// name.
+func authorityAddress(authority string) sdk.AccAddress {
+	if addr, err := sdk.AccAddressFromBech32(authority); err == nil {
+		return addr
+	}
+	return authtypes.NewModuleAddress(authority)
+}
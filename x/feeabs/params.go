@@ -0,0 +1,72 @@
+package feeabs
+
+import (
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultTWAPWindow is the default time window of the time-weighted average
+// price used to convert the fees.
+const DefaultTWAPWindow = 10 * time.Minute
+
+// NewParams creates a new Params instance.
+func NewParams(nativeDenom string, allowedDenoms []string, twapWindow time.Duration, swapFees bool) Params {
+	return Params{
+		NativeDenom:   nativeDenom,
+		AllowedDenoms: allowedDenoms,
+		TwapWindow:    twapWindow,
+		SwapFees:      swapFees,
+	}
+}
+
+// DefaultParams returns the default feeabs parameters. No denom other than
+// the native denom is allowed to pay the fees.
+func DefaultParams() Params {
+	return NewParams(sdk.DefaultBondDenom, nil, DefaultTWAPWindow, false)
+}
+
+// Validate performs basic validation of the feeabs parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.NativeDenom); err != nil {
+		return ErrInvalidParams.Wrapf("invalid native denom: %s", err)
+	}
+	if p.TwapWindow <= 0 {
+		return ErrInvalidParams.Wrapf("twap window must be positive: %s", p.TwapWindow)
+	}
+
+	seen := make(map[string]bool, len(p.AllowedDenoms))
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return ErrInvalidParams.Wrapf("invalid allowed denom: %s", err)
+		}
+		if denom == p.NativeDenom {
+			return ErrInvalidParams.Wrapf("native denom %s cannot be an allowed denom", denom)
+		}
+		if seen[denom] {
+			return ErrInvalidParams.Wrapf("duplicate allowed denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// IsAllowedDenom returns whether the given denom is allowed to pay the fees
+// in place of the native denom.
+func (p Params) IsAllowedDenom(denom string) bool {
+	for _, d := range p.AllowedDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var _ tx.GasPriceEstimator = QueryBaseFeeGasPrice
+
+// QueryBaseFeeGasPrice returns the gas price paying the base fee of the
+// x/feemarket module. It is computed from the highest base fee the next block
+// can have, so that the tx remains valid if the base fee increases before it
+// is included in a block.
+//
+// It is a tx.GasPriceEstimator: the transactions built by a tx.Factory set
+// with it pay the base fee when they set neither fees nor gas prices.
+func QueryBaseFeeGasPrice(clientConn gogogrpc.ClientConn) (sdk.DecCoin, error) {
+	queryClient := feemarket.NewQueryClient(clientConn)
+
+	baseFeeRes, err := queryClient.BaseFee(context.Background(), &feemarket.QueryBaseFeeRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	paramsRes, err := queryClient.Params(context.Background(), &feemarket.QueryParamsRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseFee := baseFeeRes.BaseFee
+	return sdk.NewDecCoinFromDec(baseFee.Denom, paramsRes.Params.MaxNextBaseFee(baseFee.Amount)), nil
+}
+
+// EstimateFees simulates the execution of a transaction and returns the
+// adjusted gas amount and the fees paying the base fee of the x/feemarket
+// module on it. No fees are returned if the base fee is disabled.
+func EstimateFees(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (uint64, sdk.Coins, error) {
+	_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	gasPrice, err := QueryBaseFeeGasPrice(clientCtx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !gasPrice.IsPositive() {
+		return adjusted, nil, nil
+	}
+
+	// fee = ceil(gasPrice * gasLimit)
+	fee := sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(sdk.NewDec(int64(adjusted))).Ceil().RoundInt())
+	return adjusted, sdk.NewCoins(fee), nil
+}
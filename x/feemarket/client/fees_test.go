@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	gocontext "context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketclient "github.com/cosmos/cosmos-sdk/x/feemarket/client"
+)
+
+// mockConn is a mock grpc client connection returning the base fee and the
+// params of the x/feemarket module.
+type mockConn struct {
+	baseFee sdk.DecCoin
+	wantErr bool
+}
+
+func (m mockConn) Invoke(_ gocontext.Context, method string, _, reply interface{}, _ ...grpc.CallOption) error {
+	if m.wantErr {
+		return fmt.Errorf("mock err")
+	}
+
+	switch res := reply.(type) {
+	case *feemarket.QueryBaseFeeResponse:
+		res.BaseFee = m.baseFee
+	case *feemarket.QueryParamsResponse:
+		res.Params = feemarket.DefaultParams()
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	return nil
+}
+
+func (mockConn) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestQueryBaseFeeGasPrice(t *testing.T) {
+	_, err := feemarketclient.QueryBaseFeeGasPrice(mockConn{wantErr: true})
+	require.Error(t, err)
+
+	// the gas price pays the highest base fee of the next block
+	gasPrice, err := feemarketclient.QueryBaseFeeGasPrice(mockConn{baseFee: sdk.NewDecCoin("stake", sdk.NewInt(8))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("stake", sdk.NewInt(9)), gasPrice)
+
+	// no gas price is paid when the base fee is zero
+	gasPrice, err = feemarketclient.QueryBaseFeeGasPrice(mockConn{baseFee: sdk.NewDecCoin("stake", sdk.ZeroInt())})
+	require.NoError(t, err)
+	require.False(t, gasPrice.IsPositive())
+}
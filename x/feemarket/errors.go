@@ -0,0 +1,11 @@
+package feemarket
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidParams  = sdkerrors.Register(ModuleName, 2, "invalid params")
+	ErrInvalidBaseFee = sdkerrors.Register(ModuleName, 3, "invalid base fee")
+)
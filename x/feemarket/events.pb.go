@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/events.proto
+
+package feemarket
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventUpdateBaseFee is emitted at the end of every block when the base fee is
+// enabled.
+type EventUpdateBaseFee struct {
+	// base_fee is the base fee per unit of gas of the next block.
+	BaseFee types.DecCoin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// block_gas_used is the gas consumed by the block.
+	BlockGasUsed uint64 `protobuf:"varint,2,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+	// burned is the amount of base fee burned for the block.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *EventUpdateBaseFee) Reset()         { *m = EventUpdateBaseFee{} }
+func (m *EventUpdateBaseFee) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBaseFee) ProtoMessage()    {}
+func (*EventUpdateBaseFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071c5a4924997dae, []int{0}
+}
+func (m *EventUpdateBaseFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBaseFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBaseFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBaseFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBaseFee.Merge(m, src)
+}
+func (m *EventUpdateBaseFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBaseFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBaseFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBaseFee proto.InternalMessageInfo
+
+func (m *EventUpdateBaseFee) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.DecCoin{}
+}
+
+func (m *EventUpdateBaseFee) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
+func (m *EventUpdateBaseFee) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateBaseFee)(nil), "cosmos.feemarket.v1.EventUpdateBaseFee")
+}
+
+func init() { proto.RegisterFile("cosmos/feemarket/v1/events.proto", fileDescriptor_071c5a4924997dae) }
+
+var fileDescriptor_071c5a4924997dae = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe3, 0xaf, 0x55, 0x3f, 0x94, 0x22, 0x86, 0xc0, 0x50, 0x2a, 0xe4, 0x46, 0x88, 0x21,
+	0x0b, 0x36, 0x29, 0x33, 0x4b, 0xf8, 0xb7, 0x57, 0xea, 0xc2, 0x52, 0xd9, 0xce, 0x6d, 0xa8, 0x4a,
+	0xe3, 0x2a, 0xd7, 0x89, 0xe0, 0x2d, 0x78, 0x0e, 0x9e, 0xa4, 0x63, 0x47, 0xa6, 0x82, 0x92, 0x17,
+	0x41, 0x49, 0x2c, 0x58, 0x10, 0x93, 0xad, 0x7b, 0xcf, 0xf9, 0x1d, 0xdd, 0xe3, 0xfa, 0x4a, 0xe3,
+	0x4a, 0x23, 0x9f, 0x03, 0xac, 0x44, 0xb6, 0x04, 0xc3, 0x8b, 0x90, 0x43, 0x01, 0xa9, 0x41, 0xb6,
+	0xce, 0xb4, 0xd1, 0xde, 0x61, 0xab, 0x60, 0xdf, 0x0a, 0x56, 0x84, 0xc3, 0xa3, 0x44, 0x27, 0xba,
+	0xd9, 0xf3, 0xfa, 0xd7, 0x4a, 0x87, 0xd4, 0xc2, 0xa4, 0x40, 0xe0, 0x45, 0x28, 0xc1, 0x88, 0x90,
+	0x2b, 0xbd, 0x48, 0xdb, 0xfd, 0xe9, 0x8e, 0xb8, 0xde, 0x6d, 0xcd, 0x9e, 0xae, 0x63, 0x61, 0x20,
+	0x12, 0x08, 0x77, 0x00, 0xde, 0x95, 0xbb, 0x57, 0x3b, 0x66, 0x73, 0x80, 0x01, 0xf1, 0x49, 0xd0,
+	0x1f, 0x9f, 0x30, 0x1b, 0x5a, 0xcf, 0x99, 0x25, 0xb1, 0x1b, 0x50, 0xd7, 0x7a, 0x91, 0x46, 0xdd,
+	0xcd, 0x6e, 0xe4, 0x4c, 0xfe, 0x4b, 0x6b, 0x3f, 0x73, 0x0f, 0xe4, 0x93, 0x56, 0xcb, 0x59, 0x22,
+	0x70, 0x96, 0x23, 0xc4, 0x83, 0x7f, 0x3e, 0x09, 0xba, 0x93, 0xfd, 0x66, 0x7a, 0x2f, 0x70, 0x8a,
+	0x10, 0x7b, 0xca, 0xed, 0xc9, 0x3c, 0x4b, 0x21, 0x1e, 0x74, 0xfc, 0x4e, 0xd0, 0x1f, 0x1f, 0xff,
+	0x1a, 0xd1, 0xf0, 0x2f, 0x6a, 0xfe, 0xdb, 0xc7, 0x28, 0x48, 0x16, 0xe6, 0x31, 0x97, 0x4c, 0xe9,
+	0x15, 0xb7, 0x97, 0xb5, 0xcf, 0x39, 0xc6, 0x4b, 0x6e, 0x5e, 0xd6, 0x80, 0x8d, 0x01, 0x27, 0x16,
+	0x1d, 0x45, 0x9b, 0x92, 0x92, 0x6d, 0x49, 0xc9, 0x67, 0x49, 0xc9, 0x6b, 0x45, 0x9d, 0x6d, 0x45,
+	0x9d, 0xf7, 0x8a, 0x3a, 0x0f, 0x7f, 0xb3, 0x9e, 0x7f, 0xfa, 0x97, 0xbd, 0xa6, 0xab, 0xcb, 0xaf,
+	0x01, 0x00, 0x1b, 0x67, 0x25, 0xe3, 0x9a, 0x01, 0x00, 0x00,
+}
+
+func (m *EventUpdateBaseFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBaseFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBaseFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateBaseFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.BlockGasUsed))
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateBaseFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBaseFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBaseFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
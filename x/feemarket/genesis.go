@@ -0,0 +1,28 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the feemarket module.
+func NewGenesisState(params Params, baseFee sdk.Dec) *GenesisState {
+	return &GenesisState{Params: params, BaseFee: baseFee}
+}
+
+// DefaultGenesisState returns the default genesis state of the feemarket
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.ZeroDec())
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return ErrInvalidBaseFee.Wrapf("base fee must be non-negative: %s", data.BaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	params := func(f func(p *feemarket.Params)) feemarket.Params {
+		p := feemarket.DefaultParams()
+		f(&p)
+		return p
+	}
+
+	testCases := []struct {
+		name    string
+		genesis *feemarket.GenesisState
+		expErr  error
+	}{
+		{"default", feemarket.DefaultGenesisState(), nil},
+		{"valid", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.Enabled, p.MinBaseFee = true, sdk.NewDecWithPrec(1, 2) }), sdk.NewDec(1)), nil},
+		{"enabled without min base fee", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.Enabled = true }), sdk.NewDec(1)), feemarket.ErrInvalidParams},
+		{"invalid fee denom", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.FeeDenom = "" }), sdk.ZeroDec()), feemarket.ErrInvalidParams},
+		{"negative min base fee", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.MinBaseFee = sdk.NewDec(-1) }), sdk.ZeroDec()), feemarket.ErrInvalidParams},
+		{"zero change denominator", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.BaseFeeChangeDenominator = 0 }), sdk.ZeroDec()), feemarket.ErrInvalidParams},
+		{"zero elasticity multiplier", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.ElasticityMultiplier = 0 }), sdk.ZeroDec()), feemarket.ErrInvalidParams},
+		{"burn fraction above 1", feemarket.NewGenesisState(params(func(p *feemarket.Params) { p.BurnFraction = sdk.NewDec(2) }), sdk.ZeroDec()), feemarket.ErrInvalidParams},
+		{"negative base fee", feemarket.NewGenesisState(feemarket.DefaultParams(), sdk.NewDec(-1)), feemarket.ErrInvalidBaseFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := feemarket.ValidateGenesis(*tc.genesis)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := feemarket.DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 1)
+	baseFee := sdk.NewDec(1)
+
+	testCases := []struct {
+		name        string
+		gasUsed     uint64
+		maxBlockGas int64
+		expBaseFee  sdk.Dec
+	}{
+		{"at target", 50, 100, sdk.NewDec(1)},
+		{"full block", 100, 100, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", 0, 100, sdk.NewDecWithPrec(875, 3)},
+		{"half way above target", 75, 100, sdk.NewDecWithPrec(10625, 4)},
+		{"unlimited block gas", 100, -1, sdk.NewDec(1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expBaseFee, params.NextBaseFee(baseFee, tc.gasUsed, tc.maxBlockGas))
+		})
+	}
+
+	// the base fee never goes below the min base fee
+	require.Equal(t, params.MinBaseFee, params.NextBaseFee(sdk.NewDecWithPrec(1, 1), 0, 100))
+
+	// the gas prices computed from the max next base fee remain valid after a
+	// full block
+	require.Equal(t, params.NextBaseFee(baseFee, 100, 100), params.MaxNextBaseFee(baseFee))
+
+	// a full block consumes elasticity multiplier times the target gas
+	params.ElasticityMultiplier = 4
+	require.Equal(t, sdk.NewDecWithPrec(1375, 3), params.MaxNextBaseFee(baseFee))
+	require.Equal(t, params.NextBaseFee(baseFee, 100, 100), params.MaxNextBaseFee(baseFee))
+
+	// the base fee can't increase without elasticity
+	params.ElasticityMultiplier = 1
+	require.Equal(t, baseFee, params.MaxNextBaseFee(baseFee))
+	require.Equal(t, params.NextBaseFee(baseFee, 100, 100), params.MaxNextBaseFee(baseFee))
+}
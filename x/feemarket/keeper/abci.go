@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker updates the base fee from the gas consumed by the block, after
+// all the transactions of the block were executed. If the base fee can't be
+// burned, the error is logged and the base fee is left unchanged.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.UpdateBaseFee(cacheCtx, ctx.BlockGasMeter().GasConsumedToLimit()); err != nil {
+		k.Logger(ctx).Error("failed to update the base fee", "err", err)
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
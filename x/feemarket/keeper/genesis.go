@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feemarket.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+	k.SetBaseFeeAmount(ctx, data.BaseFee)
+}
+
+// ExportGenesis returns the feemarket module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feemarket.GenesisState {
+	return feemarket.NewGenesisState(k.GetParams(ctx), k.GetBaseFeeAmount(ctx))
+}
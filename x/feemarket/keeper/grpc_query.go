@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var _ feemarket.QueryServer = Keeper{}
+
+// Params returns the feemarket parameters.
+func (k Keeper) Params(goCtx context.Context, req *feemarket.QueryParamsRequest) (*feemarket.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// BaseFee returns the base fee per unit of gas of the next block.
+func (k Keeper) BaseFee(goCtx context.Context, req *feemarket.QueryBaseFeeRequest) (*feemarket.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryBaseFeeResponse{BaseFee: k.GetBaseFee(ctx)}, nil
+}
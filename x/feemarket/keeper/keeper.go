@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	bankKeeper feemarket.BankKeeper
+	authority  string
+}
+
+var _ ante.BaseFeeKeeper = Keeper{}
+
+// NewKeeper creates a new feemarket Keeper instance.
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, bk feemarket.BankKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		bankKeeper: bk,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feemarket.ModuleName))
+}
+
+// GetParams returns the feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feemarket.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params feemarket.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(ParamsKey, bz)
+	return nil
+}
+
+// GetBaseFeeAmount returns the stored base fee per unit of gas, regardless
+// of whether the base fee is enabled.
+func (k Keeper) GetBaseFeeAmount(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFeeAmount sets the base fee per unit of gas.
+func (k Keeper) SetBaseFeeAmount(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the base fee per unit of gas which the transactions
+// must pay, in the fee denom. It is zero if the base fee is disabled.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoin {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		// the params are not set yet when the genesis transactions are
+		// delivered, so the denom is not validated
+		return sdk.DecCoin{Denom: params.FeeDenom, Amount: sdk.ZeroDec()}
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, k.GetBaseFeeAmount(ctx))
+}
+
+// UpdateBaseFee burns the share of the base fee paid for the gas consumed by
+// the block, then sets the base fee of the next block from the block gas
+// utilization. It does nothing if the base fee is disabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee := k.GetBaseFeeAmount(ctx)
+	burned, err := k.burnBaseFee(ctx, params, baseFee, blockGasUsed)
+	if err != nil {
+		return err
+	}
+
+	maxBlockGas := consensusMaxBlockGas(ctx)
+	if maxBlockGas <= 0 {
+		k.Logger(ctx).Error("the base fee is enabled but the maximum block gas is unlimited, the base fee can't change", "base_fee", baseFee)
+	}
+
+	next := params.NextBaseFee(baseFee, blockGasUsed, maxBlockGas)
+	k.SetBaseFeeAmount(ctx, next)
+
+	return ctx.EventManager().EmitTypedEvent(&feemarket.EventUpdateBaseFee{
+		BaseFee:      sdk.NewDecCoinFromDec(params.FeeDenom, next),
+		BlockGasUsed: blockGasUsed,
+		Burned:       burned,
+	})
+}
+
+// consensusMaxBlockGas returns the maximum block gas of the consensus parameters, or
+// -1 if it is unlimited or unknown.
+func consensusMaxBlockGas(ctx sdk.Context) int64 {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		return cp.Block.MaxGas
+	}
+	return -1
+}
+
+// burnBaseFee burns the burn fraction of the base fee paid for the given
+// gas, out of the fee collector. The transactions pay the base fee on their
+// gas limit, so the base fee paid for the gas consumed is always collected,
+// unless it was paid in another denom through fee abstraction: the burned
+// amount is then capped by the fee collector balance.
+func (k Keeper) burnBaseFee(ctx sdk.Context, params feemarket.Params, baseFee sdk.Dec, gasUsed uint64) (sdk.Coins, error) {
+	amount := baseFee.MulInt(sdk.NewIntFromUint64(gasUsed)).Mul(params.BurnFraction).TruncateInt()
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	balance := k.bankKeeper.GetBalance(ctx, feeCollector, params.FeeDenom)
+	amount = sdk.MinInt(amount, balance.Amount)
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	burned := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feemarket.ModuleName, burned); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, feemarket.ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const feeDenom = sdk.DefaultBondDenom
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	keeper      keeper.Keeper
+	msgServer   feemarket.MsgServer
+	queryClient feemarket.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 1000}})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	feemarket.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.keeper = app.FeeMarketKeeper
+	s.msgServer = keeper.NewMsgServerImpl(app.FeeMarketKeeper)
+	s.queryClient = feemarket.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) enable(burnFraction sdk.Dec) {
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	params.BurnFraction = burnFraction
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.keeper.SetBaseFeeAmount(s.ctx, sdk.NewDec(1))
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, feemarket.NewMsgUpdateParams(s.addrs[0].String(), params))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.ElasticityMultiplier = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, feemarket.NewMsgUpdateParams(s.authority, invalid))
+	s.Require().ErrorIs(err, feemarket.ErrInvalidParams)
+
+	// the base fee can't be enabled without a maximum block gas
+	unlimited := s.ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: -1}})
+	_, err = s.msgServer.UpdateParams(unlimited, feemarket.NewMsgUpdateParams(s.authority, params))
+	s.Require().ErrorIs(err, feemarket.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, feemarket.NewMsgUpdateParams(s.authority, params))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &feemarket.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *TestSuite) TestBaseFee() {
+	// the base fee is zero while disabled
+	s.keeper.SetBaseFeeAmount(s.ctx, sdk.NewDec(1))
+	res, err := s.queryClient.BaseFee(s.ctx, &feemarket.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(feeDenom, sdk.ZeroDec()), res.BaseFee)
+
+	s.enable(sdk.OneDec())
+	res, err = s.queryClient.BaseFee(s.ctx, &feemarket.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(feeDenom, sdk.NewDec(1)), res.BaseFee)
+}
+
+func (s *TestSuite) TestUpdateBaseFee() {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))
+	s.Require().NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	supply := s.app.BankKeeper.GetSupply(s.ctx, feeDenom)
+
+	// disabled: nothing is burned and the base fee doesn't change
+	s.keeper.SetBaseFeeAmount(s.ctx, sdk.NewDec(1))
+	s.Require().NoError(s.keeper.UpdateBaseFee(s.ctx, 1000))
+	s.Require().Equal(sdk.NewDec(1), s.keeper.GetBaseFeeAmount(s.ctx))
+	s.Require().Equal(fees, s.app.BankKeeper.GetAllBalances(s.ctx, feeCollector))
+
+	// a full block burns half of the base fee on the gas used and raises the
+	// base fee by 12.5%
+	s.enable(sdk.NewDecWithPrec(5, 1))
+	s.Require().NoError(s.keeper.UpdateBaseFee(s.ctx, 1000))
+	s.Require().Equal(sdk.NewDecWithPrec(1125, 3), s.keeper.GetBaseFeeAmount(s.ctx))
+	s.Require().Equal(sdk.NewInt(500), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, feeDenom).Amount)
+	s.Require().Equal(supply.SubAmount(sdk.NewInt(500)), s.app.BankKeeper.GetSupply(s.ctx, feeDenom))
+
+	// the burned amount is capped by the fee collector balance, and an empty
+	// block lowers the base fee
+	s.enable(sdk.OneDec())
+	s.Require().NoError(s.keeper.UpdateBaseFee(s.ctx, 1000))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, feeCollector, feeDenom).IsZero())
+	s.Require().NoError(s.keeper.UpdateBaseFee(s.ctx, 0))
+	s.Require().Equal(sdk.NewDecWithPrec(984375, 6), s.keeper.GetBaseFeeAmount(s.ctx))
+
+	// the base fee and the burned amount are emitted
+	var found bool
+	for _, e := range s.ctx.EventManager().Events() {
+		if e.Type == "cosmos.feemarket.v1.EventUpdateBaseFee" {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *TestSuite) TestGenesisRoundTrip() {
+	s.enable(sdk.OneDec())
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(feemarket.ValidateGenesis(*genesis))
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.FeeMarketKeeper.InitGenesis(ctx, genesis)
+	s.Require().Equal(genesis, app.FeeMarketKeeper.ExportGenesis(ctx))
+}
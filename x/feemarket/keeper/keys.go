@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var (
+	ParamsKey  = []byte{0x01}
+	BaseFeeKey = []byte{0x02}
+)
+
+// StoreKey is the store key string for feemarket
+const StoreKey = feemarket.ModuleName
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ feemarket.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feemarket MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) feemarket.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the feemarket module parameters.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *feemarket.MsgUpdateParams) (*feemarket.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Params.Enabled && consensusMaxBlockGas(ctx) <= 0 {
+		return nil, feemarket.ErrInvalidParams.Wrap("the base fee can't be enabled while the maximum block gas is unlimited")
+	}
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &feemarket.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package feemarket
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feemarket"
+
+	// StoreKey is the store key string for feemarket
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feemarket
+	RouterKey = ModuleName
+)
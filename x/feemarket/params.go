@@ -0,0 +1,103 @@
+package feemarket
+
+import (
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator bounds the change of the base fee between
+	// two blocks to 12.5%, as in EIP-1559.
+	DefaultBaseFeeChangeDenominator = 8
+
+	// DefaultElasticityMultiplier sets the target block gas to half of the
+	// maximum block gas, as in EIP-1559.
+	DefaultElasticityMultiplier = 2
+)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	enabled bool, feeDenom string, minBaseFee sdk.Dec,
+	baseFeeChangeDenominator, elasticityMultiplier uint32, burnFraction sdk.Dec,
+) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		BurnFraction:             burnFraction,
+	}
+}
+
+// DefaultParams returns the default feemarket parameters. The base fee is
+// disabled by default.
+func DefaultParams() Params {
+	return NewParams(
+		false, sdk.DefaultBondDenom, sdk.ZeroDec(),
+		DefaultBaseFeeChangeDenominator, DefaultElasticityMultiplier, sdk.OneDec(),
+	)
+}
+
+// Validate performs basic validation of the feemarket parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return ErrInvalidParams.Wrapf("invalid fee denom: %s", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return ErrInvalidParams.Wrapf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		// a zero base fee would never increase again
+		return ErrInvalidParams.Wrap("min base fee must be positive when the base fee is enabled")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return ErrInvalidParams.Wrap("base fee change denominator must be positive")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return ErrInvalidParams.Wrap("elasticity multiplier must be positive")
+	}
+	if p.BurnFraction.IsNil() || p.BurnFraction.IsNegative() || p.BurnFraction.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("burn fraction must be between 0 and 1: %s", p.BurnFraction)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas consumed by the current block, following EIP-1559: the base fee
+// moves towards the target block gas, i.e. the maximum block gas divided by
+// the elasticity multiplier, by at most 1/BaseFeeChangeDenominator. The base
+// fee doesn't change if the maximum block gas is unlimited.
+func (p Params) NextBaseFee(baseFee sdk.Dec, blockGasUsed uint64, maxBlockGas int64) sdk.Dec {
+	next := baseFee
+	targetGas := maxBlockGas / int64(p.ElasticityMultiplier)
+	if targetGas > 0 {
+		// delta = baseFee * (gasUsed - targetGas) / targetGas / denominator
+		gasDelta := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(sdk.NewDec(targetGas))
+		delta := baseFee.Mul(gasDelta).QuoInt64(targetGas).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		next = baseFee.Add(delta)
+	}
+
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+	return next
+}
+
+// MaxNextBaseFee returns the highest base fee the next block can have given
+// the current base fee, i.e. after a full block consuming ElasticityMultiplier
+// times the target gas. Gas prices computed from it remain valid for a block
+// even if the base fee increases.
+func (p Params) MaxNextBaseFee(baseFee sdk.Dec) sdk.Dec {
+	// delta = baseFee * (elasticityMultiplier - 1) / denominator
+	delta := baseFee.MulInt64(int64(p.ElasticityMultiplier) - 1).QuoInt64(int64(p.BaseFeeChangeDenominator))
+	return baseFee.Add(delta)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	globalfeeQueryCmd := &cobra.Command{
+		Use:                        globalfee.ModuleName,
+		Short:                      "Querying commands for the globalfee module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	globalfeeQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+	)
+
+	return globalfeeQueryCmd
+}
+
+// GetCmdQueryParams returns cmd to query the globalfee parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the global fee parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the global fee parameters.
+
+Example:
+$ %s query %s params
+`, version.AppName, globalfee.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := globalfee.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &globalfee.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package globalfee
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/globalfee module sentinel errors
+var (
+	ErrInvalidParams = sdkerrors.Register(ModuleName, 2, "invalid params")
+)
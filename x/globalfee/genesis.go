@@ -0,0 +1,17 @@
+package globalfee
+
+// NewGenesisState creates a new genesis state for the globalfee module.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{Params: params}
+}
+
+// DefaultGenesisState returns the default genesis state of the globalfee
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues.
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
@@ -0,0 +1,57 @@
+package globalfee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	params := func(f func(p *globalfee.Params)) globalfee.Params {
+		p := globalfee.DefaultParams()
+		f(&p)
+		return p
+	}
+	prices := func(coins ...sdk.DecCoin) func(p *globalfee.Params) {
+		return func(p *globalfee.Params) { p.MinimumGasPrices = coins }
+	}
+
+	testCases := []struct {
+		name    string
+		genesis *globalfee.GenesisState
+		expErr  error
+	}{
+		{"default", globalfee.DefaultGenesisState(), nil},
+		{"valid", globalfee.NewGenesisState(params(prices(sdk.NewDecCoinFromDec("atom", sdk.ZeroDec()), sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))))), nil},
+		{"invalid denom", globalfee.NewGenesisState(params(prices(sdk.DecCoin{Denom: "1", Amount: sdk.OneDec()}))), globalfee.ErrInvalidParams},
+		{"negative price", globalfee.NewGenesisState(params(prices(sdk.DecCoin{Denom: "stake", Amount: sdk.NewDec(-1)}))), globalfee.ErrInvalidParams},
+		{"unsorted prices", globalfee.NewGenesisState(params(prices(sdk.NewDecCoin("stake", sdk.OneInt()), sdk.NewDecCoin("atom", sdk.OneInt())))), globalfee.ErrInvalidParams},
+		{"duplicate prices", globalfee.NewGenesisState(params(prices(sdk.NewDecCoin("stake", sdk.OneInt()), sdk.NewDecCoin("stake", sdk.OneInt())))), globalfee.ErrInvalidParams},
+		{"empty bypass msg type", globalfee.NewGenesisState(params(func(p *globalfee.Params) { p.BypassMinFeeMsgTypes = []string{""} })), globalfee.ErrInvalidParams},
+		{"duplicate bypass msg types", globalfee.NewGenesisState(params(func(p *globalfee.Params) { p.BypassMinFeeMsgTypes = []string{"/a.Msg", "/a.Msg"} })), globalfee.ErrInvalidParams},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := globalfee.ValidateGenesis(*tc.genesis)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsBypassMsgTypes(t *testing.T) {
+	params := globalfee.DefaultParams()
+	params.BypassMinFeeMsgTypes = []string{"/a.MsgA", "/a.MsgB"}
+
+	require.False(t, params.IsBypassMsgTypes(nil))
+	require.True(t, params.IsBypassMsgTypes([]string{"/a.MsgA"}))
+	require.True(t, params.IsBypassMsgTypes([]string{"/a.MsgB", "/a.MsgA"}))
+	require.False(t, params.IsBypassMsgTypes([]string{"/a.MsgA", "/a.MsgC"}))
+}
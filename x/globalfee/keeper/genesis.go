@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+// InitGenesis initializes the globalfee module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *globalfee.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the globalfee module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *globalfee.GenesisState {
+	return globalfee.NewGenesisState(k.GetParams(ctx))
+}
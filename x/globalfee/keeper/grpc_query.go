@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+var _ globalfee.QueryServer = Keeper{}
+
+// Params returns the globalfee parameters.
+func (k Keeper) Params(goCtx context.Context, req *globalfee.QueryParamsRequest) (*globalfee.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &globalfee.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
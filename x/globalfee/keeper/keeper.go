@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+// Keeper of the globalfee store
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	authority string
+}
+
+var _ ante.GlobalFeeKeeper = Keeper{}
+
+// NewKeeper creates a new globalfee Keeper instance.
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/globalfee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", globalfee.ModuleName))
+}
+
+// GetParams returns the globalfee parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params globalfee.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the globalfee parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params globalfee.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(ParamsKey, bz)
+	return nil
+}
+
+// GetMinimumGasPrices returns the global minimum gas prices.
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins {
+	return k.GetParams(ctx).MinimumGasPrices
+}
+
+// IsBypassMinFee reports whether a transaction with the given Msg type URLs
+// and gas limit is exempted from the minimum gas prices, i.e. whether it only
+// contains bypass msg types within the maximum bypass gas.
+func (k Keeper) IsBypassMinFee(ctx sdk.Context, typeURLs []string, gas uint64) bool {
+	params := k.GetParams(ctx)
+	return params.IsBypassMsgTypes(typeURLs) && gas <= params.MaxTotalBypassMinFeeMsgGasUsage
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	keeper      keeper.Keeper
+	msgServer   globalfee.MsgServer
+	queryClient globalfee.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	globalfee.RegisterQueryServer(queryHelper, app.GlobalFeeKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.keeper = app.GlobalFeeKeeper
+	s.msgServer = keeper.NewMsgServerImpl(app.GlobalFeeKeeper)
+	s.queryClient = globalfee.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	params := globalfee.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	params.BypassMinFeeMsgTypes = []string{"/ibc.core.client.v1.MsgUpdateClient"}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, globalfee.NewMsgUpdateParams(s.addrs[0].String(), params))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.BypassMinFeeMsgTypes = []string{""}
+	_, err = s.msgServer.UpdateParams(s.ctx, globalfee.NewMsgUpdateParams(s.authority, invalid))
+	s.Require().ErrorIs(err, globalfee.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, globalfee.NewMsgUpdateParams(s.authority, params))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &globalfee.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *TestSuite) TestGenesisRoundTrip() {
+	params := globalfee.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(globalfee.ValidateGenesis(*genesis))
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.GlobalFeeKeeper.InitGenesis(ctx, genesis)
+	s.Require().Equal(genesis, app.GlobalFeeKeeper.ExportGenesis(ctx))
+}
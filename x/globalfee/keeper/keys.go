@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/globalfee"
+)
+
+var ParamsKey = []byte{0x01}
+
+// StoreKey is the store key string for globalfee
+const StoreKey = globalfee.ModuleName
@@ -0,0 +1,12 @@
+package globalfee
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "globalfee"
+
+	// StoreKey is the store key string for globalfee
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for globalfee
+	RouterKey = ModuleName
+)
@@ -0,0 +1,77 @@
+package globalfee
+
+import (
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default globalfee parameters, which enforce no
+// global minimum gas price.
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices:                sdk.DecCoins{},
+		BypassMinFeeMsgTypes:            []string{},
+		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
+	}
+}
+
+// Validate performs a basic validation of the globalfee parameters.
+func (p Params) Validate() error {
+	// the gas prices are sorted and unique, but unlike sdk.DecCoins.Validate
+	// a zero price is allowed
+	for i, gp := range p.MinimumGasPrices {
+		if err := sdk.ValidateDenom(gp.Denom); err != nil {
+			return ErrInvalidParams.Wrapf("invalid minimum gas price denom: %s", err)
+		}
+		if gp.Amount.IsNil() || gp.Amount.IsNegative() {
+			return ErrInvalidParams.Wrapf("minimum gas price must be non-negative: %s", gp)
+		}
+		if i > 0 && p.MinimumGasPrices[i-1].Denom >= gp.Denom {
+			return ErrInvalidParams.Wrapf("minimum gas prices must be sorted by denom and unique: %s", p.MinimumGasPrices)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.BypassMinFeeMsgTypes))
+	for _, typeURL := range p.BypassMinFeeMsgTypes {
+		if typeURL == "" {
+			return ErrInvalidParams.Wrap("empty bypass msg type")
+		}
+		if seen[typeURL] {
+			return ErrInvalidParams.Wrapf("duplicate bypass msg type: %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// IsBypassMsgTypes reports whether the fees of a transaction containing the
+// given Msg type URLs can be bypassed, i.e. whether they are all bypass msg
+// types.
+func (p Params) IsBypassMsgTypes(typeURLs []string) bool {
+	if len(typeURLs) == 0 {
+		return false
+	}
+
+	for _, typeURL := range typeURLs {
+		var found bool
+		for _, bypass := range p.BypassMinFeeMsgTypes {
+			if typeURL == bypass {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -33,6 +33,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryVotesByVoterCmd(),
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
+		QueryVoteDelegationsByGroupCmd(),
 	)
 
 	return queryCmd
@@ -504,3 +505,44 @@ func QueryVotesByVoterCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryVoteDelegationsByGroupCmd creates a CLI command for Query/VoteDelegationsByGroup.
+func QueryVoteDelegationsByGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations-by-group [group-id]",
+		Short: "Query for vote delegations by group id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VoteDelegationsByGroup(cmd.Context(), &group.QueryVoteDelegationsByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
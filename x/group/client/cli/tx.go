@@ -45,6 +45,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
+		MsgDelegateVoteCmd(),
+		MsgUndelegateVoteCmd(),
 	)
 
 	return txCmd
@@ -384,6 +386,10 @@ $ %s tx group create-group-policy [admin] [group-id] [metadata] \
 
 Here, we can use percentage decision policy when needed, where 0 < percentage <= 1.
 Ex: '{"@type":"/cosmos.group.v1.PercentageDecisionPolicy", "percentage":"0.5", "windows": {"voting_period": "120h", "min_execution_period": "0s"}}
+
+A quorum decision policy additionally requires a minimum turnout and rejects the proposal on a veto share,
+where 0 <= quorum <= 1, 0 < threshold <= 1 and 0 < veto_threshold <= 1.
+Ex: '{"@type":"/cosmos.group.v1.QuorumDecisionPolicy", "quorum":"0.4", "threshold":"0.5", "veto_threshold":"0.334", "windows": {"voting_period": "120h", "min_execution_period": "0s"}}
 `,
 				version.AppName,
 			),
@@ -684,7 +690,8 @@ func MsgVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote [proposal-id] [voter] [vote-option] [metadata]",
 		Short: "Vote on a proposal",
-		Long: `Vote on a proposal.
+		Long: `Vote on a proposal. Voting again on the same proposal before the end of
+its voting period replaces the previous vote.
 
 Parameters:
 			proposal-id: unique ID of the proposal
@@ -826,3 +833,99 @@ Parameters:
 
 	return cmd
 }
+
+// MsgDelegateVoteCmd creates a CLI command for Msg/DelegateVote.
+func MsgDelegateVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegator] [group-id] [delegate]",
+		Short: "Delegate the voting weight of a group member to another member",
+		Long: `Delegate the voting weight of a group member to another member of the group.
+The delegate's vote is counted with the delegator's weight on all the group's
+proposals the delegator didn't vote on. An existing delegation is replaced.
+
+Parameters:
+			delegator: account address of the group member delegating their voting weight
+			group-id: unique ID of the group
+			delegate: account address of the group member voting on behalf of the delegator
+Note, the '--from' flag is ignored as it is implied from [delegator].
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgDelegateVote{
+				GroupId:   groupID,
+				Delegator: clientCtx.GetFromAddress().String(),
+				Delegate:  args[2],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUndelegateVoteCmd creates a CLI command for Msg/UndelegateVote.
+func MsgUndelegateVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote [delegator] [group-id]",
+		Short: "Remove the vote delegation of a group member",
+		Long: `Remove the vote delegation of a group member.
+
+Parameters:
+			delegator: account address of the group member removing their vote delegation
+			group-id: unique ID of the group
+Note, the '--from' flag is ignored as it is implied from [delegator].
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUndelegateVote{
+				GroupId:   groupID,
+				Delegator: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
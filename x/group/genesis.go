@@ -75,6 +75,18 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, d := range s.VoteDelegations {
+
+		// check that group with vote delegation's GroupId exists
+		if _, exists := groups[d.GroupId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("vote delegation with GroupId %d doesn't exist", d.GroupId))
+		}
+
+		if err := d.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "VoteDelegation validation failed")
+		}
+	}
 	return nil
 }
 
@@ -43,6 +43,8 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// vote_delegations is the list of vote delegations.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,9,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +136,13 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,29 +150,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xe9, 0x8f, 0x3f, 0x3f, 0x58, 0xfe, 0x68, 0x36, 0x31, 0xa9, 0xa0, 0x0d, 0x1a, 0x0f,
-	0x24, 0xc6, 0x36, 0xe0, 0xc1, 0x9b, 0x89, 0x5e, 0x88, 0x07, 0x13, 0x52, 0x12, 0x0f, 0x5e, 0x0c,
-	0xe0, 0x58, 0x1b, 0x29, 0x53, 0x3a, 0x0b, 0x91, 0xb7, 0xf0, 0x09, 0x7c, 0x1e, 0x8f, 0x1c, 0x3d,
-	0x1a, 0x78, 0x11, 0xc3, 0x6c, 0x49, 0x0d, 0x70, 0xda, 0xdd, 0xd9, 0xcf, 0x77, 0x3e, 0x93, 0x8c,
-	0x38, 0x1e, 0x20, 0x05, 0x48, 0x8e, 0x17, 0xe1, 0x24, 0x74, 0xa6, 0x4d, 0xc7, 0x83, 0x11, 0x90,
-	0x4f, 0x76, 0x18, 0xa1, 0x42, 0xb9, 0xa7, 0xbf, 0x6d, 0xfe, 0xb6, 0xa7, 0xcd, 0x6a, 0x6d, 0x93,
-	0x57, 0xb3, 0x10, 0x62, 0xfa, 0xf4, 0x33, 0x2d, 0x4a, 0x6d, 0x9d, 0xef, 0xaa, 0x9e, 0x02, 0x59,
-	0x13, 0x05, 0x06, 0x9f, 0x08, 0xc6, 0xa6, 0x51, 0x37, 0x1a, 0x19, 0x37, 0xcf, 0x85, 0x2e, 0x8c,
-	0x65, 0x4b, 0xe4, 0xf8, 0x4e, 0xe6, 0xbf, 0x7a, 0xba, 0x51, 0x6c, 0x55, 0xed, 0x0d, 0x99, 0xdd,
-	0x5e, 0x5d, 0xee, 0x46, 0x2f, 0xe8, 0xc6, 0xa4, 0xbc, 0x11, 0x65, 0xdd, 0x30, 0x80, 0xa0, 0x0f,
-	0x11, 0x99, 0x69, 0x8e, 0x1e, 0xed, 0x8e, 0xde, 0x33, 0xe4, 0x96, 0xbc, 0xe4, 0x41, 0xb2, 0x21,
-	0xf6, 0x75, 0x8b, 0x10, 0x87, 0xfe, 0x60, 0xc6, 0xa3, 0x65, 0x78, 0xb4, 0x0a, 0xd7, 0x3b, 0x5c,
-	0x5e, 0x0d, 0xd8, 0x16, 0x95, 0x3f, 0xa4, 0x0f, 0x64, 0x66, 0xd9, 0x56, 0xdf, 0x6d, 0xd3, 0x41,
-	0x1e, 0xb7, 0x9c, 0x74, 0xf2, 0x81, 0xe4, 0x89, 0x28, 0x85, 0x11, 0x86, 0x48, 0xbd, 0x21, 0xeb,
-	0x72, 0xac, 0x2b, 0xae, 0x6b, 0x2b, 0xd7, 0x95, 0x28, 0xac, 0x9f, 0x64, 0xfe, 0x67, 0xcd, 0xe1,
-	0x96, 0xa6, 0x13, 0x13, 0x6e, 0xc2, 0xca, 0x73, 0x91, 0x9d, 0xa2, 0x02, 0x32, 0xf3, 0x1c, 0x3a,
-	0xd8, 0x0a, 0x3d, 0xa0, 0x02, 0x57, 0x33, 0xb7, 0xd7, 0x5f, 0x0b, 0xcb, 0x98, 0x2f, 0x2c, 0xe3,
-	0x67, 0x61, 0x19, 0x1f, 0x4b, 0x2b, 0x35, 0x5f, 0x5a, 0xa9, 0xef, 0xa5, 0x95, 0x7a, 0x3c, 0xf3,
-	0x7c, 0xf5, 0x3a, 0xe9, 0xdb, 0x03, 0x0c, 0x9c, 0x78, 0xc5, 0xfa, 0xb8, 0xa0, 0xe7, 0x37, 0xe7,
-	0x5d, 0xef, 0xbb, 0x9f, 0xe3, 0x3d, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x5b, 0x30,
-	0xc4, 0x36, 0x02, 0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4d, 0x4f, 0xc2, 0x40,
+	0x10, 0x86, 0xa9, 0x7c, 0x08, 0xcb, 0x67, 0x36, 0x31, 0x59, 0x41, 0x2b, 0x1a, 0x0f, 0x24, 0xc6,
+	0x36, 0xe0, 0xc1, 0x9b, 0x89, 0xc6, 0x84, 0x68, 0x62, 0x42, 0x4a, 0xe2, 0xc1, 0x0b, 0xe1, 0x63,
+	0xad, 0x8d, 0x94, 0x2d, 0x9d, 0xa5, 0x91, 0x7f, 0xe1, 0xcf, 0xf2, 0xc8, 0xd1, 0xa3, 0x81, 0x9b,
+	0xbf, 0xc2, 0x74, 0xb6, 0x88, 0x02, 0xa7, 0x9d, 0x99, 0x7d, 0xde, 0x79, 0xdf, 0xc3, 0x90, 0xc3,
+	0xbe, 0x00, 0x57, 0x80, 0x69, 0xfb, 0x62, 0xe2, 0x99, 0x41, 0xdd, 0xb4, 0xf9, 0x88, 0x83, 0x03,
+	0x86, 0xe7, 0x0b, 0x29, 0x68, 0x51, 0x7d, 0x1b, 0xf8, 0x6d, 0x04, 0xf5, 0x72, 0x65, 0x9d, 0x97,
+	0x53, 0x8f, 0x47, 0xf4, 0xc9, 0x77, 0x9c, 0xe4, 0x9a, 0x4a, 0xdf, 0x96, 0x5d, 0xc9, 0x69, 0x85,
+	0x64, 0x10, 0xec, 0x00, 0x1f, 0x33, 0xad, 0xaa, 0xd5, 0x12, 0x56, 0x1a, 0x07, 0x6d, 0x3e, 0xa6,
+	0x0d, 0x92, 0xc2, 0x1a, 0xd8, 0x4e, 0x35, 0x5e, 0xcb, 0x36, 0xca, 0xc6, 0x9a, 0x99, 0xd1, 0x0c,
+	0x8b, 0xbb, 0xd1, 0xb3, 0xb0, 0x22, 0x92, 0x5e, 0x93, 0xbc, 0x5a, 0xe8, 0x72, 0xb7, 0xc7, 0x7d,
+	0x60, 0x71, 0x94, 0x1e, 0x6c, 0x97, 0x3e, 0x20, 0x64, 0xe5, 0xec, 0x55, 0x03, 0xb4, 0x46, 0x4a,
+	0x6a, 0x85, 0x27, 0x86, 0x4e, 0x7f, 0x8a, 0xd1, 0x12, 0x18, 0xad, 0x80, 0xf3, 0x16, 0x8e, 0xc3,
+	0x80, 0x4d, 0x52, 0xf8, 0x43, 0x3a, 0x1c, 0x58, 0x12, 0xdd, 0xaa, 0xdb, 0xdd, 0x94, 0x10, 0xe3,
+	0xe6, 0x57, 0x9b, 0x1c, 0x0e, 0xf4, 0x98, 0xe4, 0x3c, 0x5f, 0x78, 0x02, 0xba, 0x43, 0xb4, 0x4b,
+	0xa1, 0x5d, 0x76, 0x39, 0x0b, 0xbd, 0x2e, 0x49, 0x66, 0xd9, 0x02, 0xdb, 0x45, 0x9b, 0xfd, 0x0d,
+	0x9b, 0x56, 0x44, 0x58, 0x2b, 0x96, 0x9e, 0x91, 0x64, 0x20, 0x24, 0x07, 0x96, 0x46, 0xd1, 0xde,
+	0x86, 0xe8, 0x51, 0x48, 0x6e, 0x29, 0x86, 0xde, 0x93, 0x52, 0x58, 0x74, 0x06, 0x7c, 0xc8, 0xed,
+	0xae, 0x74, 0xc4, 0x08, 0x58, 0x06, 0x75, 0x47, 0x5b, 0x75, 0xb7, 0xbf, 0x9c, 0x55, 0x0c, 0xfe,
+	0xf5, 0x70, 0x73, 0xf5, 0x31, 0xd7, 0xb5, 0xd9, 0x5c, 0xd7, 0xbe, 0xe6, 0xba, 0xf6, 0xbe, 0xd0,
+	0x63, 0xb3, 0x85, 0x1e, 0xfb, 0x5c, 0xe8, 0xb1, 0xa7, 0x53, 0xdb, 0x91, 0x2f, 0x93, 0x9e, 0xd1,
+	0x17, 0xae, 0x19, 0x9d, 0x8b, 0x7a, 0xce, 0x61, 0xf0, 0x6a, 0xbe, 0xa9, 0xdb, 0xe9, 0xa5, 0xf0,
+	0x66, 0x2e, 0x7e, 0x06, 0x00, 0xe6, 0xad, 0x02, 0x90, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +196,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +354,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +625,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
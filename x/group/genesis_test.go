@@ -732,6 +732,50 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"vote delegation in group that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				VoteDelegations: []*VoteDelegation{
+					{
+						GroupId:   2,
+						Delegator: memberAddr.String(),
+						Delegate:  accAddr.String(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"self vote delegation",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				VoteDelegations: []*VoteDelegation{
+					{
+						GroupId:   1,
+						Delegator: memberAddr.String(),
+						Delegate:  memberAddr.String(),
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -346,3 +346,23 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 		Tally: tallyResult,
 	}, nil
 }
+
+// VoteDelegationsByGroup queries all vote delegations of a group.
+func (k Keeper) VoteDelegationsByGroup(goCtx context.Context, request *group.QueryVoteDelegationsByGroupRequest) (*group.QueryVoteDelegationsByGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	it, err := k.voteDelegationByGroupIndex.GetPaginated(ctx.KVStore(k.key), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []*group.VoteDelegation
+	pageRes, err := orm.Paginate(it, request.Pagination, &delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteDelegationsByGroupResponse{
+		VoteDelegations: delegations,
+		Pagination:      pageRes,
+	}, nil
+}
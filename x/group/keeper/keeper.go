@@ -43,6 +43,11 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Vote Delegation Table
+	VoteDelegationTablePrefix           byte = 0x50
+	VoteDelegationByGroupIndexPrefix    byte = 0x51
+	VoteDelegationByDelegateIndexPrefix byte = 0x52
 )
 
 type Keeper struct {
@@ -75,6 +80,11 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Vote Delegation Table
+	voteDelegationTable           orm.PrimaryKeyTable
+	voteDelegationByGroupIndex    orm.Index
+	voteDelegationByDelegateIndex orm.Index
+
 	router *baseapp.MsgServiceRouter
 
 	config group.Config
@@ -202,6 +212,29 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	}
 	k.voteTable = *voteTable
 
+	// Vote Delegation Table
+	voteDelegationTable, err := orm.NewPrimaryKeyTable([2]byte{VoteDelegationTablePrefix}, &group.VoteDelegation{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteDelegationByGroupIndex, err = orm.NewIndex(voteDelegationTable, VoteDelegationByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.VoteDelegation).GroupId}, nil
+	}, group.VoteDelegation{}.GroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteDelegationByDelegateIndex, err = orm.NewIndex(voteDelegationTable, VoteDelegationByDelegateIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		addr, err := sdk.AccAddressFromBech32(value.(*group.VoteDelegation).Delegate)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr.Bytes()}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteDelegationTable = *voteDelegationTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
@@ -335,6 +368,45 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// pruneVoteDelegations deletes the vote delegations of a group member who left
+// the group, both as a delegator and as a delegate.
+func (k Keeper) pruneVoteDelegations(ctx sdk.Context, groupID uint64, member string) error {
+	store := ctx.KVStore(k.key)
+	memberAddr, err := sdk.AccAddressFromBech32(member)
+	if err != nil {
+		return err
+	}
+
+	delegation := &group.VoteDelegation{GroupId: groupID, Delegator: member}
+	if k.voteDelegationTable.Contains(store, delegation) {
+		if err := k.voteDelegationTable.Delete(store, delegation); err != nil {
+			return err
+		}
+	}
+
+	// the delegations to the member are collected before they are deleted, as the store
+	// cannot be written while it is iterated
+	it, err := k.voteDelegationByDelegateIndex.Get(store, memberAddr.Bytes())
+	if err != nil {
+		return err
+	}
+	var delegations []group.VoteDelegation
+	if _, err := orm.ReadAll(it, &delegations); err != nil {
+		return err
+	}
+
+	for i := range delegations {
+		if delegations[i].GroupId != groupID {
+			continue
+		}
+		if err := k.voteDelegationTable.Delete(store, &delegations[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // PruneProposals prunes all proposals that are expired, i.e. whose
 // `voting_period + max_execution_period` is greater than the current block
 // time.
@@ -2028,7 +2028,7 @@ func (s *TestSuite) TestVote() {
 			expErr:  true,
 			postRun: func(sdkCtx sdk.Context) {},
 		},
-		"voted already": {
+		"change vote": {
 			req: &group.MsgVote{
 				ProposalId: myProposalID,
 				Voter:      addr4.String(),
@@ -2042,8 +2042,15 @@ func (s *TestSuite) TestVote() {
 				})
 				s.Require().NoError(err)
 			},
-			expErr:  true,
-			postRun: func(sdkCtx sdk.Context) {},
+			expTallyResult: group.TallyResult{
+				YesCount:        "0",
+				NoCount:         "1",
+				AbstainCount:    "0",
+				NoWithVetoCount: "0",
+			},
+			expProposalStatus: group.PROPOSAL_STATUS_SUBMITTED,
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+			postRun:           func(sdkCtx sdk.Context) {},
 		},
 	}
 	for msg, spec := range specs {
@@ -2198,6 +2205,163 @@ func (s *TestSuite) TestVote() {
 	require.NotEqual(tallyResult.String(), tallyResult1.String())
 }
 
+func (s *TestSuite) TestVoteDelegation() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	addr4 := addrs[3]
+	addr5 := addrs[4]
+
+	members := []group.MemberRequest{
+		{Address: addr2.String(), Weight: "1"},
+		{Address: addr3.String(), Weight: "2"},
+		{Address: addr4.String(), Weight: "1"},
+	}
+	policy := group.NewThresholdDecisionPolicy("4", time.Second, 0)
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addr1, members, policy)
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addr3.String()},
+	}
+	proposalRes, err := s.keeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	delegate := func(ctx context.Context, delegator, delegate sdk.AccAddress) error {
+		_, err := s.keeper.DelegateVote(ctx, &group.MsgDelegateVote{
+			GroupId:   groupID,
+			Delegator: delegator.String(),
+			Delegate:  delegate.String(),
+		})
+		return err
+	}
+	vote := func(ctx context.Context, voter sdk.AccAddress, option group.VoteOption) {
+		_, err := s.keeper.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+	tally := func(sdkCtx sdk.Context) group.TallyResult {
+		proposal, err := s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		res, err := s.keeper.Tally(sdkCtx, *proposal.Proposal, groupID)
+		s.Require().NoError(err)
+		return res
+	}
+
+	specs := map[string]struct {
+		doBefore       func(ctx context.Context)
+		expTallyResult group.TallyResult
+	}{
+		"delegated weight counted with the delegate's vote": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				vote(ctx, addr3, group.VOTE_OPTION_YES)
+			},
+			expTallyResult: group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"delegator's own vote takes precedence": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				vote(ctx, addr3, group.VOTE_OPTION_YES)
+				vote(ctx, addr2, group.VOTE_OPTION_NO)
+			},
+			expTallyResult: group.TallyResult{YesCount: "2", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"delegate changes vote": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				vote(ctx, addr3, group.VOTE_OPTION_YES)
+				vote(ctx, addr3, group.VOTE_OPTION_ABSTAIN)
+			},
+			expTallyResult: group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "3", NoWithVetoCount: "0"},
+		},
+		"delegations are not transitive": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				s.Require().NoError(delegate(ctx, addr3, addr4))
+				vote(ctx, addr4, group.VOTE_OPTION_YES)
+			},
+			expTallyResult: group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"redelegation replaces the previous delegation": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				s.Require().NoError(delegate(ctx, addr2, addr4))
+				vote(ctx, addr3, group.VOTE_OPTION_YES)
+				vote(ctx, addr4, group.VOTE_OPTION_NO)
+			},
+			expTallyResult: group.TallyResult{YesCount: "2", NoCount: "2", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"undelegated": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr3))
+				_, err := s.keeper.UndelegateVote(ctx, &group.MsgUndelegateVote{GroupId: groupID, Delegator: addr2.String()})
+				s.Require().NoError(err)
+				vote(ctx, addr3, group.VOTE_OPTION_YES)
+			},
+			expTallyResult: group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"delegate left the group": {
+			doBefore: func(ctx context.Context) {
+				s.Require().NoError(delegate(ctx, addr2, addr4))
+				vote(ctx, addr4, group.VOTE_OPTION_YES)
+				_, err := s.keeper.LeaveGroup(ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: addr4.String()})
+				s.Require().NoError(err)
+			},
+			expTallyResult: group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := sdk.WrapSDKContext(sdkCtx)
+
+			spec.doBefore(ctx)
+			s.Require().Equal(spec.expTallyResult, tally(sdkCtx))
+		})
+	}
+
+	s.Run("invalid delegations", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+
+		s.Require().Error(delegate(ctx, addr2, addr2))
+		s.Require().Error(delegate(ctx, addr2, addr5))
+		s.Require().Error(delegate(ctx, addr5, addr2))
+		_, err := s.keeper.UndelegateVote(ctx, &group.MsgUndelegateVote{GroupId: groupID, Delegator: addr2.String()})
+		s.Require().Error(err)
+	})
+
+	s.Run("query and prune vote delegations", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+
+		s.Require().NoError(delegate(ctx, addr2, addr3))
+		s.Require().NoError(delegate(ctx, addr4, addr3))
+
+		res, err := s.keeper.VoteDelegationsByGroup(ctx, &group.QueryVoteDelegationsByGroupRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		s.Require().Len(res.VoteDelegations, 2)
+
+		_, err = s.keeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+			Admin:         addr1.String(),
+			GroupId:       groupID,
+			MemberUpdates: []group.MemberRequest{{Address: addr3.String(), Weight: "0"}},
+		})
+		s.Require().NoError(err)
+
+		res, err = s.keeper.VoteDelegationsByGroup(ctx, &group.QueryVoteDelegationsByGroupRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		s.Require().Empty(res.VoteDelegations)
+	})
+}
+
 func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
 	addr1 := addrs[0]
@@ -153,6 +153,9 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
+				if err := k.pruneVoteDelegations(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return sdkerrors.Wrap(err, "prune vote delegations")
+				}
 				continue
 			}
 			// If group member already exists, handle update
@@ -646,9 +649,9 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 		SubmitTime: ctx.BlockTime(),
 	}
 
-	// The ORM will return an error if the vote already exists,
-	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(ctx.KVStore(k.key), &newVote); err != nil {
+	// A voter can change their vote as long as the proposal is open for
+	// voting, in which case the previous vote is overwritten.
+	if err := k.voteTable.Set(ctx.KVStore(k.key), &newVote); err != nil {
 		return nil, sdkerrors.Wrap(err, "store vote")
 	}
 
@@ -831,6 +834,9 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 	if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), gm); err != nil {
 		return nil, sdkerrors.Wrap(err, "group member")
 	}
+	if err := k.pruneVoteDelegations(ctx, req.GroupId, req.Address); err != nil {
+		return nil, sdkerrors.Wrap(err, "prune vote delegations")
+	}
 
 	// update group weight
 	groupInfo.TotalWeight = updatedWeight.String()
@@ -852,6 +858,75 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// DelegateVote delegates the voting weight of a group member to another member
+// of the same group, replacing any existing delegation of the member.
+func (k Keeper) DelegateVote(goCtx context.Context, req *group.MsgDelegateVote) (*group.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getGroupInfo(ctx, req.GroupId); err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	if _, err := k.getGroupMember(ctx, &group.GroupMember{
+		GroupId: req.GroupId,
+		Member:  &group.Member{Address: req.Delegator},
+	}); err != nil {
+		return nil, sdkerrors.Wrap(err, "delegator")
+	}
+	if _, err := k.getGroupMember(ctx, &group.GroupMember{
+		GroupId: req.GroupId,
+		Member:  &group.Member{Address: req.Delegate},
+	}); err != nil {
+		return nil, sdkerrors.Wrap(err, "delegate")
+	}
+
+	delegation := group.VoteDelegation{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+		Delegate:  req.Delegate,
+	}
+	if err := delegation.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := k.voteDelegationTable.Set(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "store vote delegation")
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&group.EventDelegateVote{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+		Delegate:  req.Delegate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote removes the vote delegation of a group member.
+func (k Keeper) UndelegateVote(goCtx context.Context, req *group.MsgUndelegateVote) (*group.MsgUndelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation := group.VoteDelegation{GroupId: req.GroupId, Delegator: req.Delegator}
+	if err := k.voteDelegationTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&delegation), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "vote delegation")
+	}
+	if err := k.voteDelegationTable.Delete(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete vote delegation")
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&group.EventUndelegateVote{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUndelegateVoteResponse{}, nil
+}
+
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	var groupMember group.GroupMember
 	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
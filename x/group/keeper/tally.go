@@ -10,6 +10,10 @@ import (
 
 // Tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
+//
+// The weight of a group member who didn't vote but delegated their vote is
+// counted with the option of their delegate's vote. Delegations are not
+// transitive: only the delegate's own vote is taken into account.
 func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
@@ -27,6 +31,9 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 	defer it.Close()
 
 	tallyResult := group.DefaultTallyResult()
+	// votes holds the counted votes by voter address, it is used to count
+	// delegated voting weight below.
+	votes := make(map[string]group.Vote)
 
 	for {
 		var vote group.Vote
@@ -38,26 +45,79 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}), &member)
-
-		switch {
-		case sdkerrors.ErrNotFound.Is(err):
-			// If the member left the group after voting, then we simply skip the
-			// vote.
-			continue
-		case err != nil:
-			// For any other errors, we stop and return the error.
+		member, found, err := k.getMemberForTally(ctx, groupID, vote.Voter)
+		if err != nil {
 			return group.TallyResult{}, err
 		}
+		// If the member left the group after voting, then we simply skip the
+		// vote.
+		if !found {
+			continue
+		}
 
 		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
+		votes[vote.Voter] = vote
+	}
+
+	delegationIt, err := k.voteDelegationByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	defer delegationIt.Close()
+
+	for {
+		var delegation group.VoteDelegation
+		_, err = delegationIt.LoadNext(&delegation)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+
+		// The delegator's own vote takes precedence over their delegate's.
+		if _, voted := votes[delegation.Delegator]; voted {
+			continue
+		}
+		delegateVote, found := votes[delegation.Delegate]
+		if !found {
+			continue
+		}
+
+		member, found, err := k.getMemberForTally(ctx, groupID, delegation.Delegator)
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+		if !found {
+			continue
+		}
+
+		if err := tallyResult.Add(delegateVote, member.Member.Weight); err != nil {
+			return group.TallyResult{}, sdkerrors.Wrap(err, "add delegated vote")
+		}
 	}
 
 	return tallyResult, nil
 }
+
+// getMemberForTally returns the group member with the given address, and
+// false if the address is not a member of the group anymore.
+func (k Keeper) getMemberForTally(ctx sdk.Context, groupID uint64, address string) (group.GroupMember, bool, error) {
+	var member group.GroupMember
+	err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+		GroupId: groupID,
+		Member:  &group.Member{Address: address},
+	}), &member)
+
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return member, false, nil
+	case err != nil:
+		// For any other errors, we stop and return the error.
+		return member, false, err
+	}
+
+	return member, true, nil
+}
@@ -801,6 +801,68 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgDelegateVote{}
+
+// Route Implements Msg
+func (m MsgDelegateVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgDelegateVote) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgDelegateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDelegateVote
+func (m MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgDelegateVote) ValidateBasic() error {
+	return VoteDelegation{GroupId: m.GroupId, Delegator: m.Delegator, Delegate: m.Delegate}.ValidateBasic()
+}
+
+var _ sdk.Msg = &MsgUndelegateVote{}
+
+// Route Implements Msg
+func (m MsgUndelegateVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgUndelegateVote) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgUndelegateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateVote
+func (m MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUndelegateVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegator")
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "group-id")
+	}
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
@@ -1380,6 +1380,116 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryVoteDelegationsByGroupRequest is the Query/VoteDelegationsByGroup request type.
+type QueryVoteDelegationsByGroupRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) Reset()         { *m = QueryVoteDelegationsByGroupRequest{} }
+func (m *QueryVoteDelegationsByGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsByGroupRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsByGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsByGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsByGroupRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsByGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsByGroupRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsByGroupRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegationsByGroupResponse is the Query/VoteDelegationsByGroup response type.
+type QueryVoteDelegationsByGroupResponse struct {
+	// vote_delegations are the vote delegations of the group.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Reset()         { *m = QueryVoteDelegationsByGroupResponse{} }
+func (m *QueryVoteDelegationsByGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsByGroupResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsByGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsByGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsByGroupResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsByGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsByGroupResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsByGroupResponse) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1407,91 +1517,97 @@ func init() {
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryVoteDelegationsByGroupRequest)(nil), "cosmos.group.v1.QueryVoteDelegationsByGroupRequest")
+	proto.RegisterType((*QueryVoteDelegationsByGroupResponse)(nil), "cosmos.group.v1.QueryVoteDelegationsByGroupResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0x25, 0x69, 0x92, 0x97, 0xb6, 0x11, 0x43, 0x5a, 0x12, 0x37, 0xda, 0x04, 0x03,
-	0xf9, 0x1d, 0x3b, 0xbb, 0x49, 0xd3, 0x8a, 0x9f, 0xea, 0x4a, 0x10, 0x72, 0x28, 0x4a, 0x97, 0x88,
-	0x03, 0x97, 0xc8, 0x9b, 0x75, 0x8c, 0xc5, 0xae, 0x67, 0xbb, 0x76, 0x22, 0x56, 0xd1, 0x5e, 0x90,
-	0xca, 0x01, 0x71, 0x00, 0x8a, 0x50, 0x89, 0x38, 0xf4, 0x80, 0x04, 0x7f, 0x00, 0x08, 0x89, 0x5b,
-	0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x3c, 0xf3, 0xbc, 0xeb, 0xdf, 0xeb,
-	0x15, 0x2b, 0xc8, 0xa9, 0x5d, 0xfb, 0xbd, 0x79, 0x9f, 0xf9, 0xbe, 0xe7, 0xf1, 0xd7, 0x81, 0xeb,
-	0xfb, 0xcc, 0xae, 0x31, 0x5b, 0x35, 0x1a, 0xec, 0xb0, 0xae, 0x1e, 0xe5, 0xd5, 0x7b, 0x87, 0x7a,
-	0xa3, 0xa9, 0xd4, 0x1b, 0xcc, 0x61, 0x74, 0x5c, 0xdc, 0x54, 0xf8, 0x4d, 0xe5, 0x28, 0x2f, 0x4d,
-	0x18, 0xcc, 0x60, 0xfc, 0x9e, 0xea, 0xfe, 0x4f, 0x84, 0x49, 0xd3, 0x06, 0x63, 0x46, 0x55, 0x57,
-	0xb5, 0xba, 0xa9, 0x6a, 0x96, 0xc5, 0x1c, 0xcd, 0x31, 0x99, 0x65, 0xe3, 0xdd, 0x48, 0x05, 0xa7,
-	0x59, 0xd7, 0xbd, 0x9b, 0x4b, 0x78, 0xb3, 0xac, 0xd9, 0xba, 0x28, 0xad, 0x1e, 0xe5, 0xcb, 0xba,
-	0xa3, 0xe5, 0xd5, 0xba, 0x66, 0x98, 0x16, 0x5f, 0x09, 0x63, 0xa7, 0x44, 0xec, 0x9e, 0xa8, 0x8f,
-	0x68, 0xfc, 0x87, 0x5c, 0x80, 0xab, 0x77, 0xdd, 0xe4, 0x2d, 0xb7, 0xc6, 0xb6, 0x75, 0xc0, 0x4a,
-	0xfa, 0xbd, 0x43, 0xdd, 0x76, 0xe8, 0x14, 0x8c, 0xf0, 0xba, 0x7b, 0x66, 0x65, 0x92, 0xcc, 0x92,
-	0x85, 0xc1, 0xd2, 0x30, 0xff, 0xbd, 0x5d, 0x91, 0xdf, 0x81, 0x6b, 0xe1, 0x1c, 0xbb, 0xce, 0x2c,
-	0x5b, 0xa7, 0x0a, 0x0c, 0x9a, 0xd6, 0x01, 0xe3, 0x09, 0x63, 0x05, 0x49, 0x09, 0xa9, 0xa0, 0x74,
-	0x32, 0x78, 0x9c, 0x7c, 0x17, 0xae, 0x77, 0x56, 0xda, 0x61, 0x55, 0x73, 0xbf, 0xe9, 0x67, 0x28,
-	0xc0, 0xb0, 0x56, 0xa9, 0x34, 0x74, 0xdb, 0xe6, 0x2b, 0x8e, 0x16, 0x27, 0x7f, 0xfb, 0x69, 0x75,
-	0x02, 0x17, 0xbd, 0x2d, 0xee, 0xbc, 0xe7, 0x34, 0x4c, 0xcb, 0x28, 0x79, 0x81, 0xf2, 0x2e, 0x4c,
-	0xc7, 0x2f, 0x89, 0x88, 0x1b, 0x01, 0xc4, 0xd9, 0x78, 0x44, 0x5f, 0x9e, 0x00, 0x6d, 0xc1, 0x64,
-	0x67, 0xd5, 0x3b, 0x7a, 0xad, 0xac, 0x37, 0xec, 0xee, 0x4a, 0xd1, 0xb7, 0x01, 0x3a, 0xcd, 0x98,
-	0xbc, 0xc0, 0x4b, 0xce, 0x79, 0x25, 0xdd, 0xce, 0x29, 0x62, 0x68, 0xb0, 0x73, 0xca, 0x8e, 0x66,
-	0xe8, 0xb8, 0x6c, 0xc9, 0x97, 0x29, 0x7f, 0x47, 0x60, 0x2a, 0xa6, 0x3e, 0x6e, 0x69, 0x13, 0x86,
-	0x6b, 0xe2, 0xd2, 0x24, 0x99, 0x7d, 0x66, 0x61, 0xac, 0x30, 0x1d, 0xbf, 0x2b, 0x91, 0x57, 0xf2,
-	0x82, 0xe9, 0x56, 0x0c, 0xdd, 0x7c, 0x57, 0x3a, 0x51, 0x34, 0x80, 0xf7, 0x20, 0x80, 0x67, 0x17,
-	0x9b, 0xb7, 0x2b, 0x35, 0xd3, 0xf2, 0xf4, 0x51, 0x60, 0x48, 0x73, 0x7f, 0x77, 0xed, 0xa1, 0x08,
-	0xeb, 0x9b, 0x68, 0xdf, 0x12, 0x90, 0xe2, 0xa8, 0x50, 0xb5, 0x02, 0x5c, 0xe4, 0xf2, 0x78, 0xa2,
-	0xa5, 0x4d, 0x2b, 0x46, 0xf6, 0x4f, 0xb1, 0xfb, 0x04, 0x66, 0x43, 0x63, 0x6a, 0xea, 0x76, 0x51,
-	0xfc, 0xfc, 0x0f, 0x07, 0xeb, 0x67, 0x02, 0x2f, 0xa4, 0x70, 0xa0, 0x54, 0x5b, 0x70, 0x45, 0x80,
-	0xd4, 0x31, 0x00, 0x25, 0xeb, 0xfe, 0xf4, 0x5c, 0x36, 0xfc, 0xeb, 0xf6, 0x4f, 0xbf, 0x93, 0x04,
-	0xfd, 0xce, 0xc5, 0xe0, 0x25, 0x89, 0x1a, 0x9c, 0xbf, 0xf3, 0x27, 0xea, 0x4d, 0x98, 0xe0, 0xd8,
-	0x3b, 0x0d, 0x56, 0x67, 0xb6, 0x56, 0xf5, 0x74, 0x9c, 0x81, 0xb1, 0x3a, 0x5e, 0xea, 0x8c, 0x22,
-	0x78, 0x97, 0xb6, 0x2b, 0xf2, 0xbb, 0xf8, 0x12, 0xe9, 0x24, 0xe2, 0x1e, 0x6f, 0xc0, 0x88, 0x17,
-	0x86, 0x07, 0xee, 0x54, 0x64, 0x77, 0xed, 0xa4, 0x76, 0xa8, 0xfc, 0x88, 0x80, 0x1c, 0x58, 0xd0,
-	0x9b, 0x48, 0x21, 0xc2, 0xbf, 0x78, 0x3d, 0xf4, 0xad, 0xc7, 0x3f, 0x10, 0x78, 0x31, 0x15, 0x11,
-	0x15, 0xb8, 0x09, 0xa3, 0xde, 0xb6, 0xbc, 0x06, 0xa7, 0x48, 0xd0, 0x89, 0xed, 0x5f, 0x57, 0x1b,
-	0x30, 0xc3, 0x41, 0xdf, 0x67, 0x8e, 0x5e, 0x6c, 0xe3, 0xba, 0xbf, 0x1a, 0x59, 0x1b, 0xec, 0x3e,
-	0x49, 0x47, 0x6e, 0x02, 0xe7, 0x48, 0x7d, 0x92, 0x78, 0x98, 0x7c, 0x07, 0x9f, 0xce, 0xd8, 0x9a,
-	0xa8, 0xcc, 0x22, 0x0c, 0xba, 0xc1, 0x38, 0x17, 0x57, 0x23, 0xa2, 0xb8, 0xd1, 0x25, 0x1e, 0x22,
-	0x7f, 0x4a, 0xd0, 0x27, 0xb8, 0xd7, 0xec, 0x62, 0xcf, 0x03, 0xda, 0xb7, 0xae, 0x7f, 0x4d, 0xd0,
-	0x5d, 0x44, 0x40, 0x70, 0x53, 0xcb, 0x42, 0x28, 0xaf, 0xd5, 0x09, 0xbb, 0x12, 0x31, 0xfd, 0x6b,
-	0xf1, 0x57, 0x04, 0xed, 0x09, 0x62, 0x05, 0x9a, 0xdb, 0xee, 0x1d, 0xc9, 0xd4, 0xbb, 0xbe, 0x69,
-	0xf5, 0xa5, 0x67, 0x0a, 0x82, 0x50, 0xff, 0xab, 0x50, 0x0f, 0xc3, 0x96, 0x00, 0x2d, 0xd1, 0x39,
-	0x38, 0x50, 0x4e, 0x88, 0xdf, 0x0b, 0xfb, 0xd0, 0xce, 0x83, 0x5d, 0x79, 0x05, 0x9e, 0xe7, 0x6c,
-	0xbb, 0x5a, 0xb5, 0xea, 0x9e, 0x6d, 0x87, 0x55, 0x27, 0xf3, 0xcb, 0x61, 0x17, 0x67, 0x33, 0x90,
-	0x8b, 0x9b, 0xba, 0x05, 0x43, 0x8e, 0x7b, 0x19, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x15, 0x07,
-	0x9f, 0xfc, 0x39, 0x33, 0x50, 0x12, 0x09, 0x85, 0xfb, 0xcf, 0xc2, 0x10, 0x5f, 0x96, 0x7e, 0x4e,
-	0x60, 0xb4, 0xbd, 0x75, 0x3a, 0x17, 0x59, 0x22, 0xf6, 0xf3, 0x46, 0x9a, 0xef, 0x1a, 0x27, 0x10,
-	0x65, 0xe5, 0x93, 0xdf, 0xff, 0x7e, 0x70, 0x61, 0x81, 0xce, 0xa9, 0xe1, 0xaf, 0x31, 0xf4, 0x66,
-	0xd6, 0x01, 0x53, 0x8f, 0x3d, 0x9f, 0xd6, 0xa2, 0xdf, 0x13, 0x18, 0x0f, 0xbd, 0xb0, 0xe9, 0x4a,
-	0x4a, 0xb1, 0xc8, 0x57, 0x8f, 0xb4, 0x9a, 0x31, 0x1a, 0x01, 0x37, 0x38, 0xa0, 0x42, 0x57, 0x12,
-	0x00, 0xb9, 0xbd, 0x68, 0x22, 0x27, 0x4e, 0x6d, 0x8b, 0x3e, 0x24, 0x70, 0xc9, 0xff, 0x31, 0x41,
-	0x17, 0x53, 0xaa, 0x06, 0x3f, 0x78, 0xa4, 0xa5, 0x2c, 0xa1, 0x48, 0x97, 0xe7, 0x74, 0xcb, 0x74,
-	0x31, 0x81, 0x0e, 0xbf, 0x45, 0xfc, 0x0a, 0x9e, 0x10, 0xb8, 0x1c, 0xb0, 0xec, 0x34, 0xad, 0x60,
-	0xc8, 0xf4, 0x49, 0xcb, 0x99, 0x62, 0x91, 0x6e, 0x8d, 0xd3, 0x2d, 0xd1, 0x85, 0x78, 0x3a, 0x7b,
-	0xaf, 0xdc, 0xdc, 0xe3, 0xde, 0xd0, 0x55, 0xae, 0x66, 0x5a, 0x2d, 0xfa, 0x2b, 0x81, 0x89, 0x38,
-	0xaf, 0x4c, 0xf3, 0xdd, 0xba, 0x16, 0xf1, 0xf7, 0x52, 0xa1, 0x97, 0x14, 0x24, 0x7e, 0x95, 0x13,
-	0xdf, 0xa0, 0xeb, 0x69, 0xdd, 0x36, 0x75, 0x4e, 0x2e, 0x6e, 0xf9, 0x94, 0xfd, 0x25, 0x0a, 0x2f,
-	0x04, 0xce, 0x06, 0x1f, 0xd0, 0xb9, 0xd0, 0x4b, 0x0a, 0xc2, 0xdf, 0xe2, 0xf0, 0x05, 0xba, 0x96,
-	0x01, 0x3e, 0x28, 0xfb, 0x67, 0x04, 0x46, 0xbc, 0x97, 0x2d, 0x7d, 0x39, 0xbe, 0x74, 0xc8, 0x15,
-	0x48, 0x73, 0xdd, 0xc2, 0x90, 0x4a, 0xe5, 0x54, 0x8b, 0x74, 0x3e, 0x42, 0xe5, 0x9d, 0x62, 0xea,
-	0xb1, 0xef, 0x88, 0x6b, 0xd1, 0xc7, 0x04, 0xae, 0xc5, 0xdb, 0x3e, 0xba, 0x9e, 0x5e, 0x33, 0xd6,
-	0xc7, 0x4a, 0x1b, 0xbd, 0x25, 0x21, 0xf6, 0x6b, 0x1c, 0x7b, 0x93, 0x6e, 0x24, 0x62, 0x77, 0x86,
-	0x00, 0x0f, 0x01, 0xdf, 0xf3, 0xff, 0x98, 0xc0, 0x73, 0x31, 0xee, 0x8c, 0xae, 0xc5, 0xb3, 0x24,
-	0x9b, 0x47, 0x29, 0xdf, 0x43, 0x06, 0xa2, 0xbf, 0xc5, 0xd1, 0xdf, 0xa4, 0xaf, 0x47, 0xd0, 0xdd,
-	0xf7, 0xbd, 0x4b, 0xdd, 0xd6, 0x9b, 0x7b, 0x92, 0xa0, 0xfe, 0xea, 0x31, 0xbf, 0xd8, 0xa2, 0x3f,
-	0x12, 0x18, 0x0f, 0x19, 0xb1, 0xa4, 0xa3, 0x36, 0xde, 0x38, 0x26, 0x1d, 0xb5, 0x09, 0xee, 0x2e,
-	0x65, 0x7e, 0xb9, 0x4f, 0xf1, 0x83, 0x87, 0x46, 0xe6, 0x1b, 0x02, 0x97, 0xfc, 0x3e, 0x28, 0xe9,
-	0xb8, 0x8d, 0x31, 0x70, 0x49, 0xc7, 0x6d, 0x9c, 0xad, 0x4a, 0x99, 0xe5, 0x36, 0x21, 0x2a, 0x8a,
-	0x1a, 0x3e, 0x22, 0x70, 0x25, 0xe8, 0x38, 0x68, 0x97, 0x13, 0x34, 0x60, 0x99, 0xa4, 0x95, 0x6c,
-	0xc1, 0x88, 0xb7, 0xce, 0xf1, 0x56, 0xe9, 0x72, 0xca, 0x79, 0x2b, 0xde, 0x08, 0xbe, 0x51, 0x3d,
-	0x21, 0x30, 0xe6, 0xf3, 0x01, 0x74, 0x21, 0xbe, 0x64, 0xd4, 0x9b, 0x48, 0x8b, 0x19, 0x22, 0x91,
-	0x6c, 0x93, 0x93, 0xad, 0x51, 0x25, 0xf9, 0x69, 0x0a, 0x4d, 0x21, 0xf7, 0x21, 0xc5, 0x37, 0x9e,
-	0x9c, 0xe6, 0xc8, 0xd3, 0xd3, 0x1c, 0xf9, 0xeb, 0x34, 0x47, 0xbe, 0x38, 0xcb, 0x0d, 0x3c, 0x3d,
-	0xcb, 0x0d, 0xfc, 0x71, 0x96, 0x1b, 0xf8, 0xe0, 0x25, 0xc3, 0x74, 0x3e, 0x3c, 0x2c, 0x2b, 0xfb,
-	0xac, 0xe6, 0xad, 0x29, 0xfe, 0x59, 0xb5, 0x2b, 0x1f, 0xa9, 0x1f, 0x8b, 0x02, 0xe5, 0x8b, 0xfc,
-	0xcf, 0xb0, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x11, 0xfe, 0x04, 0xa9, 0x4e, 0x16, 0x00,
-	0x00,
+	// 1327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xc7, 0x73, 0xfb, 0x24, 0x4d, 0x72, 0xd2, 0x36, 0x8f, 0x2e, 0x49, 0x70, 0x26, 0x91, 0x13,
+	0x06, 0xc8, 0x7b, 0x66, 0x62, 0xe7, 0xad, 0x02, 0x0a, 0xaa, 0x05, 0x84, 0x20, 0x15, 0xa5, 0x26,
+	0x62, 0xc1, 0xc6, 0xb2, 0xe3, 0x89, 0x19, 0x61, 0x7b, 0x5c, 0xcf, 0x24, 0xc2, 0x8a, 0xbc, 0x41,
+	0x02, 0x24, 0xc4, 0x02, 0x28, 0x42, 0x25, 0x62, 0xd1, 0x05, 0x12, 0x7c, 0x00, 0x10, 0x52, 0x77,
+	0x65, 0x55, 0xb1, 0xaa, 0x60, 0xc3, 0x0a, 0xa1, 0x84, 0x0f, 0x82, 0xe6, 0xde, 0x33, 0xf6, 0xbc,
+	0xdc, 0x19, 0x8f, 0x85, 0x45, 0xb3, 0x4a, 0x66, 0xee, 0x39, 0xf7, 0xfc, 0xee, 0xff, 0x9c, 0xb9,
+	0xf7, 0x5c, 0xc3, 0xd4, 0x81, 0x61, 0x56, 0x0c, 0x53, 0x2d, 0xd5, 0x8d, 0xa3, 0x9a, 0x7a, 0x9c,
+	0x52, 0xef, 0x1c, 0x69, 0xf5, 0x86, 0x52, 0xab, 0x1b, 0x96, 0x41, 0x47, 0xf9, 0xa0, 0xc2, 0x06,
+	0x95, 0xe3, 0x94, 0x34, 0x56, 0x32, 0x4a, 0x06, 0x1b, 0x53, 0xed, 0xff, 0xb8, 0x99, 0x34, 0x5d,
+	0x32, 0x8c, 0x52, 0x59, 0x53, 0xf3, 0x35, 0x5d, 0xcd, 0x57, 0xab, 0x86, 0x95, 0xb7, 0x74, 0xa3,
+	0x6a, 0xe2, 0x68, 0x20, 0x82, 0xd5, 0xa8, 0x69, 0xce, 0xe0, 0x12, 0x0e, 0x16, 0xf2, 0xa6, 0xc6,
+	0x43, 0xab, 0xc7, 0xa9, 0x82, 0x66, 0xe5, 0x53, 0x6a, 0x2d, 0x5f, 0xd2, 0xab, 0x6c, 0x26, 0xb4,
+	0x9d, 0xe4, 0xb6, 0x39, 0x1e, 0x1f, 0xd1, 0xd8, 0x83, 0x9c, 0x86, 0xf1, 0xdb, 0xb6, 0xf3, 0x8e,
+	0x1d, 0x63, 0xb7, 0x7a, 0x68, 0x64, 0xb5, 0x3b, 0x47, 0x9a, 0x69, 0xd1, 0x49, 0x18, 0x62, 0x71,
+	0x73, 0x7a, 0x31, 0x41, 0x66, 0xc9, 0x42, 0x7f, 0x76, 0x90, 0x3d, 0xef, 0x16, 0xe5, 0x37, 0x60,
+	0xc2, 0xef, 0x63, 0xd6, 0x8c, 0xaa, 0xa9, 0x51, 0x05, 0xfa, 0xf5, 0xea, 0xa1, 0xc1, 0x1c, 0x46,
+	0xd2, 0x92, 0xe2, 0x53, 0x41, 0x69, 0x7b, 0x30, 0x3b, 0xf9, 0x36, 0x4c, 0xb5, 0x67, 0xda, 0x33,
+	0xca, 0xfa, 0x41, 0xc3, 0xcd, 0x90, 0x86, 0xc1, 0x7c, 0xb1, 0x58, 0xd7, 0x4c, 0x93, 0xcd, 0x38,
+	0x9c, 0x49, 0xfc, 0xf6, 0xe3, 0xea, 0x18, 0x4e, 0x7a, 0x93, 0x8f, 0xbc, 0x6d, 0xd5, 0xf5, 0x6a,
+	0x29, 0xeb, 0x18, 0xca, 0xfb, 0x30, 0x2d, 0x9e, 0x12, 0x11, 0x37, 0x3c, 0x88, 0xb3, 0x62, 0x44,
+	0x97, 0x1f, 0x07, 0x6d, 0x42, 0xa2, 0x3d, 0xeb, 0x2d, 0xad, 0x52, 0xd0, 0xea, 0x66, 0x67, 0xa5,
+	0xe8, 0xeb, 0x00, 0xed, 0x64, 0x24, 0x2e, 0xb1, 0x90, 0x73, 0x4e, 0x48, 0x3b, 0x73, 0x0a, 0x2f,
+	0x1a, 0xcc, 0x9c, 0xb2, 0x97, 0x2f, 0x69, 0x38, 0x6d, 0xd6, 0xe5, 0x29, 0x7f, 0x4b, 0x60, 0x52,
+	0x10, 0x1f, 0x97, 0xb4, 0x05, 0x83, 0x15, 0xfe, 0x2a, 0x41, 0x66, 0xff, 0xb7, 0x30, 0x92, 0x9e,
+	0x16, 0xaf, 0x8a, 0xfb, 0x65, 0x1d, 0x63, 0xba, 0x23, 0xa0, 0x9b, 0xef, 0x48, 0xc7, 0x83, 0x7a,
+	0xf0, 0xee, 0x7a, 0xf0, 0xcc, 0x4c, 0xe3, 0x66, 0xb1, 0xa2, 0x57, 0x1d, 0x7d, 0x14, 0x18, 0xc8,
+	0xdb, 0xcf, 0x1d, 0x73, 0xc8, 0xcd, 0x7a, 0x26, 0xda, 0x37, 0x04, 0x24, 0x11, 0x15, 0xaa, 0x96,
+	0x86, 0xcb, 0x4c, 0x1e, 0x47, 0xb4, 0xa8, 0x6a, 0x45, 0xcb, 0xde, 0x29, 0xf6, 0x11, 0x81, 0x59,
+	0x5f, 0x99, 0xea, 0x9a, 0x99, 0xe1, 0x8f, 0xff, 0x61, 0x61, 0xfd, 0x44, 0xe0, 0x99, 0x08, 0x0e,
+	0x94, 0x6a, 0x07, 0xae, 0x71, 0x90, 0x1a, 0x1a, 0xa0, 0x64, 0x9d, 0xbf, 0x9e, 0xab, 0x25, 0xf7,
+	0xbc, 0xbd, 0xd3, 0xef, 0x34, 0x44, 0xbf, 0x0b, 0x51, 0x78, 0x61, 0xa2, 0x7a, 0xeb, 0xef, 0xe2,
+	0x89, 0xba, 0x0d, 0x63, 0x0c, 0x7b, 0xaf, 0x6e, 0xd4, 0x0c, 0x33, 0x5f, 0x76, 0x74, 0x9c, 0x81,
+	0x91, 0x1a, 0xbe, 0x6a, 0x97, 0x22, 0x38, 0xaf, 0x76, 0x8b, 0xf2, 0x5b, 0x30, 0xee, 0x73, 0xc4,
+	0x35, 0x6e, 0xc2, 0x90, 0x63, 0x86, 0x1b, 0xee, 0x64, 0x60, 0x75, 0x2d, 0xa7, 0x96, 0xa9, 0x7c,
+	0x9f, 0x80, 0xec, 0x99, 0xd0, 0xa9, 0x48, 0x2e, 0xc2, 0xbf, 0x38, 0x1e, 0x7a, 0x96, 0xe3, 0xef,
+	0x09, 0x3c, 0x1b, 0x89, 0x88, 0x0a, 0x6c, 0xc3, 0xb0, 0xb3, 0x2c, 0x27, 0xc1, 0x11, 0x12, 0xb4,
+	0x6d, 0x7b, 0x97, 0xd5, 0x3a, 0xcc, 0x30, 0xd0, 0x77, 0x0c, 0x4b, 0xcb, 0xb4, 0x70, 0xed, 0xa7,
+	0x7a, 0xdc, 0x04, 0xdb, 0x5f, 0xd2, 0xb1, 0xed, 0x90, 0xb8, 0xd4, 0x41, 0x67, 0x6e, 0x26, 0xdf,
+	0xc2, 0xaf, 0x53, 0x18, 0x13, 0x95, 0x59, 0x84, 0x7e, 0xdb, 0x18, 0xeb, 0x62, 0x3c, 0x20, 0x8a,
+	0x6d, 0x9d, 0x65, 0x26, 0xf2, 0xc7, 0x04, 0xfb, 0x04, 0xfb, 0x9d, 0x99, 0xe9, 0xba, 0x40, 0x7b,
+	0x96, 0xf5, 0xaf, 0x08, 0x4c, 0x8b, 0x41, 0x70, 0x51, 0xcb, 0x5c, 0x28, 0x27, 0xd5, 0x21, 0xab,
+	0xe2, 0x36, 0xbd, 0x4b, 0xf1, 0x97, 0x04, 0xdb, 0x13, 0xc4, 0xf2, 0x24, 0xb7, 0x95, 0x3b, 0x12,
+	0x2b, 0x77, 0x3d, 0xd3, 0xea, 0x0b, 0xa7, 0x29, 0xf0, 0x42, 0x3d, 0x51, 0xa1, 0xee, 0xf9, 0x5b,
+	0x02, 0x6c, 0x89, 0x2e, 0xc0, 0x86, 0x72, 0x4a, 0x60, 0x4a, 0x88, 0x76, 0x11, 0xda, 0x95, 0x17,
+	0xe0, 0x69, 0xc6, 0xb6, 0x9f, 0x2f, 0x97, 0xed, 0xbd, 0xed, 0xa8, 0x6c, 0xc5, 0x3e, 0x1c, 0xf6,
+	0x21, 0x11, 0xf4, 0xc5, 0x45, 0x5d, 0x87, 0x01, 0xcb, 0x7e, 0x8d, 0x9b, 0x40, 0xb0, 0x6f, 0x75,
+	0x39, 0x65, 0xfa, 0x1f, 0xfd, 0x39, 0xd3, 0x97, 0xe5, 0x0e, 0xf2, 0x27, 0xce, 0x11, 0x61, 0xd7,
+	0xc9, 0xab, 0x5a, 0x59, 0x2b, 0x31, 0xd2, 0x27, 0xd0, 0x42, 0x3d, 0x70, 0x4e, 0x82, 0x30, 0x12,
+	0x5c, 0xeb, 0x9b, 0xf0, 0x7f, 0xbb, 0x9a, 0x73, 0xc5, 0xb6, 0x09, 0xa6, 0x72, 0x46, 0x58, 0xfc,
+	0xed, 0xa9, 0xb2, 0xa3, 0xc7, 0xde, 0xa9, 0x7b, 0x96, 0xd8, 0xf4, 0xaf, 0x14, 0x06, 0x18, 0x3c,
+	0xfd, 0x8c, 0xc0, 0x70, 0xab, 0x82, 0xe8, 0x5c, 0x00, 0x49, 0x78, 0x4b, 0x94, 0xe6, 0x3b, 0xda,
+	0xf1, 0xa0, 0xb2, 0xf2, 0xe1, 0xef, 0x7f, 0xdf, 0xbd, 0xb4, 0x40, 0xe7, 0x54, 0xff, 0xa5, 0x16,
+	0xf3, 0x53, 0x3d, 0x34, 0xd4, 0x13, 0x27, 0x57, 0x4d, 0xfa, 0x1d, 0x81, 0x51, 0x5f, 0xdf, 0x43,
+	0x57, 0x22, 0x82, 0x05, 0x2e, 0x8f, 0xd2, 0x6a, 0x4c, 0x6b, 0x04, 0xdc, 0x60, 0x80, 0x0a, 0x5d,
+	0x09, 0x01, 0x64, 0x5d, 0x5a, 0x03, 0x39, 0xf1, 0xe3, 0x6f, 0xd2, 0x7b, 0x04, 0xae, 0xb8, 0xef,
+	0x64, 0x74, 0x31, 0x22, 0xaa, 0xf7, 0xde, 0x28, 0x2d, 0xc5, 0x31, 0x45, 0xba, 0x14, 0xa3, 0x5b,
+	0xa6, 0x8b, 0x21, 0x74, 0x78, 0xa5, 0x73, 0x2b, 0x78, 0x4a, 0xe0, 0xaa, 0xe7, 0xe6, 0x43, 0xa3,
+	0x02, 0xfa, 0x7a, 0x67, 0x69, 0x39, 0x96, 0x2d, 0xd2, 0xad, 0x31, 0xba, 0x25, 0xba, 0x20, 0xa6,
+	0x33, 0x73, 0x85, 0x46, 0x8e, 0xb5, 0xd8, 0xb6, 0x72, 0x15, 0xbd, 0xda, 0xa4, 0x0f, 0x08, 0x8c,
+	0x89, 0xae, 0x1c, 0x34, 0xd5, 0x29, 0x6b, 0x81, 0x6b, 0x92, 0x94, 0xee, 0xc6, 0x05, 0x89, 0x5f,
+	0x64, 0xc4, 0x9b, 0x74, 0x3d, 0x2a, 0xdb, 0xba, 0xc6, 0xc8, 0xf9, 0x90, 0x4b, 0xd9, 0x9f, 0x83,
+	0xf0, 0x5c, 0xe0, 0x78, 0xf0, 0x1e, 0x9d, 0xd3, 0xdd, 0xb8, 0x20, 0xfc, 0x75, 0x06, 0x9f, 0xa6,
+	0x6b, 0x31, 0xe0, 0xbd, 0xb2, 0x7f, 0x4a, 0x60, 0xc8, 0xe9, 0x59, 0xe8, 0xf3, 0xe2, 0xd0, 0xbe,
+	0xe6, 0x4a, 0x9a, 0xeb, 0x64, 0x86, 0x54, 0x2a, 0xa3, 0x5a, 0xa4, 0xf3, 0x01, 0x2a, 0xe7, 0x30,
+	0x50, 0x4f, 0x5c, 0x27, 0x45, 0x93, 0x3e, 0x24, 0x30, 0x21, 0xee, 0x9e, 0xe9, 0x7a, 0x74, 0x4c,
+	0xe1, 0x75, 0x40, 0xda, 0xe8, 0xce, 0x09, 0xb1, 0x5f, 0x62, 0xd8, 0x5b, 0x74, 0x23, 0x14, 0xbb,
+	0x5d, 0x04, 0xb8, 0x09, 0xb8, 0xbe, 0xff, 0x87, 0x04, 0x9e, 0x12, 0x34, 0xb9, 0x74, 0x4d, 0xcc,
+	0x12, 0xde, 0x83, 0x4b, 0xa9, 0x2e, 0x3c, 0x10, 0xfd, 0x35, 0x86, 0xfe, 0x0a, 0xbd, 0x11, 0x40,
+	0x67, 0x07, 0x4d, 0xa1, 0x91, 0x6b, 0xe9, 0x6d, 0xbf, 0xa8, 0x7b, 0xf5, 0x57, 0x4f, 0xd8, 0xcb,
+	0x26, 0xfd, 0x81, 0xc0, 0xa8, 0xaf, 0x9f, 0x0d, 0xdb, 0x6a, 0xc5, 0xfd, 0xb7, 0xb4, 0x1a, 0xd3,
+	0xba, 0x63, 0xfd, 0xda, 0x44, 0xa6, 0x1b, 0xdc, 0x57, 0x32, 0x5f, 0x13, 0xb8, 0xe2, 0x6e, 0x27,
+	0xc3, 0xb6, 0x5b, 0x41, 0x1f, 0x1c, 0xb6, 0xdd, 0x8a, 0xba, 0xd3, 0x88, 0x5a, 0x6e, 0x11, 0xa2,
+	0xa2, 0xa8, 0xe1, 0x7d, 0x02, 0xd7, 0xbc, 0x8d, 0x1b, 0xed, 0xb0, 0x83, 0x7a, 0x3a, 0x4f, 0x69,
+	0x25, 0x9e, 0x31, 0xe2, 0xad, 0x33, 0xbc, 0x55, 0xba, 0x1c, 0xb1, 0xdf, 0xf2, 0x13, 0xc1, 0x55,
+	0xaa, 0xa7, 0x04, 0x46, 0x5c, 0xed, 0x14, 0x5d, 0x10, 0x87, 0x0c, 0xb6, 0x78, 0xd2, 0x62, 0x0c,
+	0x4b, 0x24, 0xdb, 0x62, 0x64, 0x6b, 0x54, 0x09, 0xff, 0x9a, 0x7c, 0x55, 0xc8, 0xda, 0x39, 0xfa,
+	0x0b, 0x81, 0x09, 0x71, 0xff, 0x14, 0xb6, 0x17, 0x44, 0xf6, 0x7d, 0xd2, 0x46, 0x77, 0x4e, 0x48,
+	0x7f, 0x83, 0xd1, 0x6f, 0xd3, 0x4d, 0xf1, 0x07, 0xe5, 0xea, 0xdc, 0x44, 0xe7, 0x42, 0xe6, 0xe5,
+	0x47, 0x67, 0x49, 0xf2, 0xf8, 0x2c, 0x49, 0xfe, 0x3a, 0x4b, 0x92, 0xcf, 0xcf, 0x93, 0x7d, 0x8f,
+	0xcf, 0x93, 0x7d, 0x7f, 0x9c, 0x27, 0xfb, 0xde, 0x7d, 0xae, 0xa4, 0x5b, 0xef, 0x1d, 0x15, 0x94,
+	0x03, 0xa3, 0xe2, 0x4c, 0xcd, 0xff, 0xac, 0x9a, 0xc5, 0xf7, 0xd5, 0x0f, 0x78, 0x9c, 0xc2, 0x65,
+	0xf6, 0x93, 0xfc, 0xfa, 0x3f, 0x03, 0x00, 0x8e, 0x44, 0x7d, 0xbe, 0x5a, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1536,6 +1652,8 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of a group.
+	VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error)
 }
 
 type queryClient struct {
@@ -1663,6 +1781,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error) {
+	out := new(QueryVoteDelegationsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/VoteDelegationsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1695,6 +1822,8 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of a group.
+	VoteDelegationsByGroup(context.Context, *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1740,6 +1869,9 @@ func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegationsByGroup(ctx context.Context, req *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegationsByGroup not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1979,6 +2111,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegationsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/VoteDelegationsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, req.(*QueryVoteDelegationsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2035,6 +2185,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegationsByGroup",
+			Handler:    _Query_VoteDelegationsByGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3084,6 +3238,95 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVoteDelegationsByGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3508,6 +3751,41 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVoteDelegationsByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6196,6 +6474,231 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteDelegationsByGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsByGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -227,6 +227,193 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, si
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &QuorumDecisionPolicy{}
+
+// NewQuorumDecisionPolicy creates a new quorum DecisionPolicy
+func NewQuorumDecisionPolicy(quorum, threshold, vetoThreshold string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
+	return &QuorumDecisionPolicy{quorum, threshold, vetoThreshold, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+func (p QuorumDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if _, _, _, err := p.parse(); err != nil {
+		return err
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+// parse parses and checks the quorum, threshold and veto threshold of the policy.
+// The quorum must be >= 0 and <= 1, the threshold and veto threshold must be > 0 and <= 1.
+func (p QuorumDecisionPolicy) parse() (quorum, threshold, vetoThreshold math.Dec, err error) {
+	one := math.NewDecFromInt64(1)
+
+	quorum, err = math.NewNonNegativeDecFromString(p.Quorum)
+	if err != nil {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(err, "quorum")
+	}
+	if quorum.Cmp(one) == 1 {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(errors.ErrInvalid, "quorum must be >= 0 and <= 1")
+	}
+
+	threshold, err = math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(err, "threshold")
+	}
+	if threshold.Cmp(one) == 1 {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(errors.ErrInvalid, "threshold must be > 0 and <= 1")
+	}
+
+	vetoThreshold, err = math.NewPositiveDecFromString(p.VetoThreshold)
+	if err != nil {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(err, "veto threshold")
+	}
+	if vetoThreshold.Cmp(one) == 1 {
+		return quorum, threshold, vetoThreshold, sdkerrors.Wrap(errors.ErrInvalid, "veto threshold must be > 0 and <= 1")
+	}
+
+	return quorum, threshold, vetoThreshold, nil
+}
+
+func (p *QuorumDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the quorum is reached, the share of
+// veto votes does not exceed the veto threshold and the share of yes votes
+// equals or exceeds the threshold.
+//
+// The result is final as soon as the outcome can't change anymore, whatever
+// the remaining members vote. Otherwise, Allow reports whether the current
+// tally passes, which is used once the voting period has ended.
+func (p QuorumDecisionPolicy) Allow(tally TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	quorum, threshold, vetoThreshold, err := p.parse()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	noCount, err := math.NewNonNegativeDecFromString(tally.NoCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "no count")
+	}
+	vetoCount, err := math.NewNonNegativeDecFromString(tally.NoWithVetoCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "no with veto count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	nonAbstainCount, err := math.Add(yesCount, noCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	nonAbstainCount, err = nonAbstainCount.Add(vetoCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	check := func(cast, yes, veto, nonAbstain math.Dec) (bool, error) {
+		quorumReached, err := ratioAtLeast(cast, totalPowerDec, quorum)
+		if err != nil || !quorumReached {
+			return false, err
+		}
+		if !cast.IsZero() {
+			vetoRatio, err := veto.Quo(cast)
+			if err != nil {
+				return false, err
+			}
+			if vetoRatio.Cmp(vetoThreshold) > 0 {
+				return false, nil
+			}
+		}
+		return ratioAtLeast(yes, nonAbstain, threshold)
+	}
+
+	maxNonAbstain, err := nonAbstainCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// Worst case: all undecided members vote no with veto. The quorum is only
+	// checked against the current votes, as more votes can only help reaching it.
+	quorumReached, err := ratioAtLeast(totalCounts, totalPowerDec, quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if quorumReached {
+		maxVeto, err := vetoCount.Add(undecided)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		passes, err := check(totalPowerDec, yesCount, maxVeto, maxNonAbstain)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if passes {
+			return DecisionPolicyResult{Allow: true, Final: true}, nil
+		}
+	}
+
+	// Best case: all undecided members vote yes.
+	maxYes, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	canPass, err := check(totalPowerDec, maxYes, vetoCount, maxNonAbstain)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if !canPass {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	passes, err := check(totalCounts, yesCount, vetoCount, nonAbstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	return DecisionPolicyResult{Allow: passes, Final: false}, nil
+}
+
+// ratioAtLeast returns true if x / y >= ratio. A zero y only satisfies a zero ratio.
+func ratioAtLeast(x, y, ratio math.Dec) (bool, error) {
+	if y.IsZero() {
+		return ratio.IsZero(), nil
+	}
+	r, err := x.Quo(y)
+	if err != nil {
+		return false, err
+	}
+	return r.Cmp(ratio) >= 0, nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -422,6 +609,32 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (d VoteDelegation) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(d.Delegator)
+
+	return []interface{}{d.GroupId, addr.Bytes()}
+}
+
+var _ orm.Validateable = VoteDelegation{}
+
+func (d VoteDelegation) ValidateBasic() error {
+	if d.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "vote delegation's group id")
+	}
+	delegator, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegator")
+	}
+	delegate, err := sdk.AccAddressFromBech32(d.Delegate)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegate")
+	}
+	if delegator.Equals(delegate) {
+		return sdkerrors.Wrap(errors.ErrInvalid, "delegator and delegate must be different")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
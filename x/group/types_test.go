@@ -429,3 +429,143 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestQuorumDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	testCases := []struct {
+		name   string
+		policy group.QuorumDecisionPolicy
+		expErr bool
+	}{
+		{
+			"all good",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0.5", VetoThreshold: "0.334", Windows: windows},
+			false,
+		},
+		{
+			"zero quorum",
+			group.QuorumDecisionPolicy{Quorum: "0", Threshold: "0.5", VetoThreshold: "1", Windows: windows},
+			false,
+		},
+		{
+			"quorum > 1",
+			group.QuorumDecisionPolicy{Quorum: "1.1", Threshold: "0.5", VetoThreshold: "0.334", Windows: windows},
+			true,
+		},
+		{
+			"negative quorum",
+			group.QuorumDecisionPolicy{Quorum: "-0.1", Threshold: "0.5", VetoThreshold: "0.334", Windows: windows},
+			true,
+		},
+		{
+			"zero threshold",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0", VetoThreshold: "0.334", Windows: windows},
+			true,
+		},
+		{
+			"threshold > 1",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "2", VetoThreshold: "0.334", Windows: windows},
+			true,
+		},
+		{
+			"veto threshold > 1",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0.5", VetoThreshold: "1.5", Windows: windows},
+			true,
+		},
+		{
+			"missing windows",
+			group.QuorumDecisionPolicy{Quorum: "0.4", Threshold: "0.5", VetoThreshold: "0.334"},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyAllow(t *testing.T) {
+	policy := &group.QuorumDecisionPolicy{
+		Quorum:        "0.5",
+		Threshold:     "0.5",
+		VetoThreshold: "0.334",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:       time.Second * 100,
+			MinExecutionPeriod: time.Second * 10,
+		},
+	}
+	testCases := []struct {
+		name           string
+		tally          group.TallyResult
+		votingDuration time.Duration
+		result         group.DecisionPolicyResult
+		expErr         bool
+	}{
+		{
+			"outcome can't change, passes",
+			group.TallyResult{YesCount: "7", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"current tally passes but undecided votes can still veto",
+			group.TallyResult{YesCount: "6", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: false},
+			false,
+		},
+		{
+			"quorum counts abstain votes",
+			group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "4", NoWithVetoCount: "0"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: false},
+			false,
+		},
+		{
+			"quorum not reached yet",
+			group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"vetoed",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "4"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"threshold can't be reached anymore",
+			group.TallyResult{YesCount: "0", NoCount: "6", AbstainCount: "0", NoWithVetoCount: "0"},
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"time since submission < min execution period",
+			group.TallyResult{YesCount: "10", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			time.Second * 5,
+			group.DecisionPolicyResult{},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(tc.tally, "10", tc.votingDuration)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, policyResult)
+			}
+		})
+	}
+}
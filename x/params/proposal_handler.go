@@ -24,6 +24,7 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	var subspaces []string
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -37,6 +38,17 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
 		}
+
+		subspaces = append(subspaces, c.Subspace)
+	}
+
+	// parameters that depend on each other are only validated once all the
+	// changes are applied, so that a proposal can update them together
+	for _, name := range subspaces {
+		ss, _ := k.GetSubspace(name)
+		if err := ss.ValidateParamSet(ctx); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "subspace: %s, err: %s", name, err.Error())
+		}
 	}
 
 	return nil
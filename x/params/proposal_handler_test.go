@@ -9,11 +9,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -78,6 +80,51 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			},
 			false,
 		},
+		{
+			"downtime levels out of order",
+			testProposal(proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeyWarningSignedPerWindow), `"0.100000000000000000"`)),
+			func() {},
+			true,
+		},
+		{
+			"downtime levels updated together",
+			testProposal(
+				proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeyWarningSignedPerWindow), `"0.900000000000000000"`),
+				proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeyMinorSlashSignedPerWindow), `"0.700000000000000000"`),
+				proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeySlashFractionMinorDowntime), `"0.001000000000000000"`),
+			),
+			func() {
+				params := suite.app.SlashingKeeper.GetParams(suite.ctx)
+				suite.Require().Equal(sdk.NewDecWithPrec(9, 1), params.WarningSignedPerWindow)
+				suite.Require().Equal(sdk.NewDecWithPrec(7, 1), params.MinorSlashSignedPerWindow)
+			},
+			false,
+		},
+		{
+			"minor downtime slash fraction above the downtime one",
+			testProposal(proposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeySlashFractionMinorDowntime), `"0.500000000000000000"`)),
+			func() {},
+			true,
+		},
+		{
+			"auto-compounding enabled without max gas",
+			testProposal(proposal.NewParamChange(distrtypes.ModuleName, string(distrtypes.ParamStoreKeyAutoCompoundMaxGas), `"0"`)),
+			func() {},
+			true,
+		},
+		{
+			"auto-compounding disabled without max gas",
+			testProposal(
+				proposal.NewParamChange(distrtypes.ModuleName, string(distrtypes.ParamStoreKeyAutoCompoundEpoch), `"0"`),
+				proposal.NewParamChange(distrtypes.ModuleName, string(distrtypes.ParamStoreKeyAutoCompoundMaxGas), `"0"`),
+			),
+			func() {
+				params := suite.app.DistrKeeper.GetParams(suite.ctx)
+				suite.Require().Zero(params.AutoCompoundEpoch)
+				suite.Require().Zero(params.AutoCompoundMaxGas)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -24,3 +24,12 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ParamSetValidator defines an optional interface for a ParamSet whose
+// parameters constrain each other. When a KeyTable is registered from such a
+// ParamSet, the Subspace can validate the whole set after a parameter change
+// updated single parameters.
+type ParamSetValidator interface {
+	ParamSet
+	ValidateParamSet() error
+}
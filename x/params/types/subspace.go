@@ -59,6 +59,7 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	for k, v := range table.m {
 		s.table.m[k] = v
 	}
+	s.table.validator.ty = table.validator.ty
 
 	// Allocate additional capacity for Subspace.name
 	// So we don't have to allocate extra space each time appending to the key
@@ -98,6 +99,26 @@ func (s Subspace) Validate(ctx sdk.Context, key []byte, value interface{}) error
 	return nil
 }
 
+// ValidateParamSet validates the parameters stored in the Subspace as a whole
+// if its KeyTable was registered from a ParamSetValidator. It is a no-op
+// otherwise.
+func (s Subspace) ValidateParamSet(ctx sdk.Context) error {
+	if s.table.validator.ty == nil {
+		return nil
+	}
+
+	ps := reflect.New(s.table.validator.ty).Interface().(ParamSetValidator)
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	if err := ps.ValidateParamSet(); err != nil {
+		return fmt.Errorf("invalid parameter set: %s", err)
+	}
+
+	return nil
+}
+
 // Get queries for a parameter by key from the Subspace's KVStore and sets the
 // value to the provided pointer. If the value does not exist, it will panic.
 func (s Subspace) Get(ctx sdk.Context, key []byte, ptr interface{}) {
@@ -14,11 +14,21 @@ type attribute struct {
 // KeyTable subspaces appropriate type for each parameter key
 type KeyTable struct {
 	m map[string]attribute
+
+	// validator holds the type of the ParamSet the KeyTable was registered
+	// from, if it implements ParamSetValidator. Like m, it is shared between
+	// the copies of a Subspace.
+	validator *validatorType
+}
+
+type validatorType struct {
+	ty reflect.Type
 }
 
 func NewKeyTable(pairs ...ParamSetPair) KeyTable {
 	keyTable := KeyTable{
-		m: make(map[string]attribute),
+		m:         make(map[string]attribute),
+		validator: &validatorType{},
 	}
 
 	for _, psp := range pairs {
@@ -61,10 +71,21 @@ func (t KeyTable) RegisterType(psp ParamSetPair) KeyTable {
 }
 
 // RegisterParamSet registers multiple ParamSetPairs from a ParamSet in a KeyTable.
+// If the ParamSet implements ParamSetValidator, it is used to validate the
+// whole set, see Subspace.ValidateParamSet.
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, psp := range ps.ParamSetPairs() {
 		t = t.RegisterType(psp)
 	}
+
+	if _, ok := ps.(ParamSetValidator); ok {
+		rty := reflect.TypeOf(ps)
+		if rty.Kind() != reflect.Ptr {
+			panic("cannot register a ParamSetValidator that is not a pointer")
+		}
+		t.validator.ty = rty.Elem()
+	}
+
 	return t
 }
 
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetDowntimeRecord stores a downtime record of a validator
+func (k Keeper) SetDowntimeRecord(ctx sdk.Context, address sdk.ConsAddress, record types.DowntimeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.DowntimeRecordKey(address, record.Height), bz)
+}
+
+// GetValidatorDowntimeHistory returns the downtime records of a validator,
+// ordered by height
+func (k Keeper) GetValidatorDowntimeHistory(ctx sdk.Context, address sdk.ConsAddress) []types.DowntimeRecord {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeRecordPrefixKey(address))
+	defer iter.Close()
+
+	records := make([]types.DowntimeRecord, 0)
+	for ; iter.Valid(); iter.Next() {
+		var record types.DowntimeRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// IterateDowntimeRecords iterates over the downtime records of all validators
+func (k Keeper) IterateDowntimeRecords(ctx sdk.Context, handler func(record types.DowntimeRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeRecordKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.DowntimeRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// downtimeLevel returns the graduated downtime level below jailing for a
+// validator that missed the given number of blocks in the signed blocks window.
+func (k Keeper) downtimeLevel(ctx sdk.Context, missedBlocks int64) types.DowntimeLevel {
+	window := k.SignedBlocksWindow(ctx)
+
+	if minSigned := k.MinorSlashSignedPerWindow(ctx); minSigned > 0 && missedBlocks > window-minSigned {
+		return types.DowntimeLevelSlash
+	}
+	if minSigned := k.WarningSignedPerWindow(ctx); minSigned > 0 && missedBlocks > window-minSigned {
+		return types.DowntimeLevelWarning
+	}
+	return types.DowntimeLevelUnspecified
+}
+
+// handleDowntimeLevel applies the graduated downtime penalties below jailing.
+// A validator is warned or slashed by the minor downtime fraction once it
+// reaches a level. The level is only reset once the validator is back under
+// all the thresholds, so that a validator oscillating around a threshold is
+// not penalized again each time it crosses it.
+func (k Keeper) handleDowntimeLevel(ctx sdk.Context, consAddr sdk.ConsAddress, signInfo *types.ValidatorSigningInfo, power int64, punish bool) {
+	level := k.downtimeLevel(ctx, signInfo.MissedBlocksCounter)
+	if level <= signInfo.DowntimeLevel {
+		if level == types.DowntimeLevelUnspecified {
+			signInfo.DowntimeLevel = level
+		}
+		return
+	}
+	if !punish {
+		return
+	}
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsJailed() {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	record := types.DowntimeRecord{
+		Address:             consAddr.String(),
+		Height:              height,
+		Time:                ctx.BlockHeader().Time,
+		Level:               level,
+		MissedBlocksCounter: signInfo.MissedBlocksCounter,
+		SlashFraction:       sdk.ZeroDec(),
+		BurnedCoins:         sdk.ZeroInt(),
+	}
+
+	switch level {
+	case types.DowntimeLevelWarning:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDowntimeWarning,
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			),
+		)
+
+		k.Logger(ctx).Info(
+			"validator reached the downtime warning threshold",
+			"height", height,
+			"validator", consAddr.String(),
+			"missed", signInfo.MissedBlocksCounter,
+		)
+
+	case types.DowntimeLevelSlash:
+		// see HandleValidatorSignature for the distribution height
+		distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+		slashFraction := k.SlashFractionMinorDowntime(ctx)
+
+		coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlash,
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueLowUptime),
+				sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+			),
+		)
+
+		record.SlashFraction = slashFraction
+		record.BurnedCoins = coinsBurned
+
+		k.Logger(ctx).Info(
+			"slashing validator due to low uptime",
+			"height", height,
+			"validator", consAddr.String(),
+			"missed", signInfo.MissedBlocksCounter,
+			"slashed", slashFraction.String(),
+		)
+	}
+
+	signInfo.DowntimeLevel = level
+	k.SetDowntimeRecord(ctx, consAddr, record)
+}
@@ -38,6 +38,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, record := range data.DowntimeHistory {
+		address, err := sdk.ConsAddressFromBech32(record.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetDowntimeRecord(ctx, address, record)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -65,5 +73,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeHistory := make([]types.DowntimeRecord, 0)
+	keeper.IterateDowntimeRecords(ctx, func(record types.DowntimeRecord) (stop bool) {
+		downtimeHistory = append(downtimeHistory, record)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeHistory)
 }
@@ -105,6 +105,46 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCDowntimeHistory() {
+	queryClient := suite.queryClient
+
+	historyResp, err := queryClient.DowntimeHistory(gocontext.Background(), &types.QueryDowntimeHistoryRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(historyResp)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	records := []types.DowntimeRecord{
+		{
+			Address: consAddr.String(), Height: 5, Time: time.Unix(5, 0).UTC(), Level: types.DowntimeLevelWarning,
+			MissedBlocksCounter: 3, SlashFraction: sdk.ZeroDec(), BurnedCoins: sdk.ZeroInt(),
+		},
+		{
+			Address: consAddr.String(), Height: 7, Time: time.Unix(7, 0).UTC(), Level: types.DowntimeLevelSlash,
+			MissedBlocksCounter: 5, SlashFraction: sdk.NewDecWithPrec(1, 3), BurnedCoins: sdk.NewInt(100),
+		},
+	}
+	for _, record := range records {
+		suite.app.SlashingKeeper.SetDowntimeRecord(suite.ctx, consAddr, record)
+	}
+
+	historyResp, err = queryClient.DowntimeHistory(gocontext.Background(),
+		&types.QueryDowntimeHistoryRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(records, historyResp.Records)
+
+	historyResp, err = queryClient.DowntimeHistory(gocontext.Background(),
+		&types.QueryDowntimeHistoryRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(records[:1], historyResp.Records)
+	suite.Equal(uint64(2), historyResp.Pagination.Total)
+
+	// other validators have no history
+	historyResp, err = queryClient.DowntimeHistory(gocontext.Background(),
+		&types.QueryDowntimeHistoryRequest{ConsAddress: sdk.ConsAddress(suite.addrDels[1]).String()})
+	suite.NoError(err)
+	suite.Empty(historyResp.Records)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
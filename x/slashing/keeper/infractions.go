@@ -99,9 +99,20 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
+			k.SetDowntimeRecord(ctx, consAddr, types.DowntimeRecord{
+				Address:             consAddr.String(),
+				Height:              height,
+				Time:                ctx.BlockHeader().Time,
+				Level:               types.DowntimeLevelJail,
+				MissedBlocksCounter: signInfo.MissedBlocksCounter,
+				SlashFraction:       k.SlashFractionDowntime(ctx),
+				BurnedCoins:         coinsBurned,
+			})
+
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
+			signInfo.DowntimeLevel = types.DowntimeLevelUnspecified
 			k.clearValidatorMissedBlockBitArray(ctx, consAddr)
 
 			logger.Info(
@@ -120,6 +131,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 			)
 		}
+	} else {
+		// apply the graduated downtime penalties below jailing, once we are
+		// past the minimum height
+		k.handleDowntimeLevel(ctx, consAddr, &signInfo, power, height > minHeight)
 	}
 
 	// Set the updated signing info
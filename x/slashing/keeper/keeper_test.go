@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -283,3 +284,160 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator missing more and more blocks
+// Ensure that it is warned, slashed by the minor downtime fraction and then
+// jailed, and that each penalty is applied once and recorded
+func TestHandleGraduatedDowntime(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 100
+	params.WarningSignedPerWindow = sdk.NewDecWithPrec(9, 1)
+	params.MinorSlashSignedPerWindow = sdk.NewDecWithPrec(8, 1)
+	params.SlashFractionMinorDowntime = sdk.NewDecWithPrec(1, 3)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// 100 first blocks OK
+	height := int64(0)
+	for ; height < params.SignedBlocksWindow; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	missBlocks := func(n int64) {
+		for end := height + n; height < end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+	}
+
+	// 11 blocks missed: warned but not slashed
+	missBlocks(11)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.DowntimeLevelWarning, info.DowntimeLevel)
+	require.Equal(t, amt, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.Len(t, app.SlashingKeeper.GetValidatorDowntimeHistory(ctx, consAddr), 1)
+
+	// 20 blocks missed: still only warned once
+	missBlocks(9)
+	require.Len(t, app.SlashingKeeper.GetValidatorDowntimeHistory(ctx, consAddr), 1)
+
+	// 21 blocks missed: slashed by the minor fraction but not jailed
+	missBlocks(1)
+	minorSlashed := amt.Sub(sdk.NewDecFromInt(amt).Mul(params.SlashFractionMinorDowntime).TruncateInt())
+	require.Equal(t, minorSlashed, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.False(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	// 30 blocks missed: not slashed again
+	missBlocks(9)
+	require.Equal(t, minorSlashed, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+
+	// 51 blocks missed: jailed and slashed by the downtime fraction
+	missBlocks(21)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.DowntimeLevelUnspecified, info.DowntimeLevel)
+
+	history := app.SlashingKeeper.GetValidatorDowntimeHistory(ctx, consAddr)
+	require.Len(t, history, 3)
+	require.Equal(t, types.DowntimeLevelWarning, history[0].Level)
+	require.Equal(t, int64(11), history[0].MissedBlocksCounter)
+	require.True(t, history[0].SlashFraction.IsZero())
+	require.Equal(t, types.DowntimeLevelSlash, history[1].Level)
+	require.Equal(t, params.SlashFractionMinorDowntime, history[1].SlashFraction)
+	require.Equal(t, amt.Sub(minorSlashed), history[1].BurnedCoins)
+	require.Equal(t, types.DowntimeLevelJail, history[2].Level)
+	require.Equal(t, params.SlashFractionDowntime, history[2].SlashFraction)
+	require.Equal(t, int64(51), history[2].MissedBlocksCounter)
+}
+
+// Test a validator oscillating around the minor slash threshold
+// Ensure that it is only slashed once, and that it is warned again once it
+// recovered under the warning threshold and misses blocks again
+func TestDowntimeLevelHysteresis(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 100
+	params.WarningSignedPerWindow = sdk.NewDecWithPrec(9, 1)
+	params.MinorSlashSignedPerWindow = sdk.NewDecWithPrec(8, 1)
+	params.SlashFractionMinorDowntime = sdk.NewDecWithPrec(1, 3)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	handleBlocks := func(n int64, signed bool) {
+		for end := height + n; height < end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, signed)
+		}
+	}
+	signingInfo := func() types.ValidatorSigningInfo {
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		return info
+	}
+
+	// 100 first blocks OK, then 21 blocks missed: warned and slashed
+	handleBlocks(params.SignedBlocksWindow, true)
+	handleBlocks(21, false)
+	minorSlashed := amt.Sub(sdk.NewDecFromInt(amt).Mul(params.SlashFractionMinorDowntime).TruncateInt())
+	require.Equal(t, minorSlashed, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.Equal(t, types.DowntimeLevelSlash, signingInfo().DowntimeLevel)
+
+	// sign until the first missed block leaves the window: 20 blocks missed,
+	// under the minor slash threshold, the level is kept
+	handleBlocks(80, true)
+	require.Equal(t, int64(20), signingInfo().MissedBlocksCounter)
+	require.Equal(t, types.DowntimeLevelSlash, signingInfo().DowntimeLevel)
+
+	// miss blocks again until 21 blocks are missed: not slashed again
+	handleBlocks(21, false)
+	require.Equal(t, int64(21), signingInfo().MissedBlocksCounter)
+	require.Equal(t, minorSlashed, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.Len(t, app.SlashingKeeper.GetValidatorDowntimeHistory(ctx, consAddr), 2)
+
+	// sign a full window: back under the warning threshold, the level is reset
+	handleBlocks(params.SignedBlocksWindow, true)
+	require.Equal(t, int64(0), signingInfo().MissedBlocksCounter)
+	require.Equal(t, types.DowntimeLevelUnspecified, signingInfo().DowntimeLevel)
+
+	// 11 blocks missed: warned again
+	handleBlocks(11, false)
+	require.Equal(t, types.DowntimeLevelWarning, signingInfo().DowntimeLevel)
+	require.Equal(t, minorSlashed, app.StakingKeeper.Validator(ctx, addr).GetTokens())
+
+	history := app.SlashingKeeper.GetValidatorDowntimeHistory(ctx, consAddr)
+	require.Len(t, history, 3)
+	require.Equal(t, types.DowntimeLevelWarning, history[0].Level)
+	require.Equal(t, types.DowntimeLevelSlash, history[1].Level)
+	require.Equal(t, types.DowntimeLevelWarning, history[2].Level)
+}
@@ -42,6 +42,30 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// WarningSignedPerWindow - minimum blocks signed per window to not get a
+// downtime warning, zero if disabled
+func (k Keeper) WarningSignedPerWindow(ctx sdk.Context) int64 {
+	var warningSignedPerWindow sdk.Dec
+	k.paramspace.Get(ctx, types.KeyWarningSignedPerWindow, &warningSignedPerWindow)
+
+	return warningSignedPerWindow.MulInt64(k.SignedBlocksWindow(ctx)).RoundInt64()
+}
+
+// MinorSlashSignedPerWindow - minimum blocks signed per window to not get
+// slashed for low uptime, zero if disabled
+func (k Keeper) MinorSlashSignedPerWindow(ctx sdk.Context) int64 {
+	var minorSlashSignedPerWindow sdk.Dec
+	k.paramspace.Get(ctx, types.KeyMinorSlashSignedPerWindow, &minorSlashSignedPerWindow)
+
+	return minorSlashSignedPerWindow.MulInt64(k.SignedBlocksWindow(ctx)).RoundInt64()
+}
+
+// SlashFractionMinorDowntime - fraction of power slashed for low uptime
+func (k Keeper) SlashFractionMinorDowntime(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionMinorDowntime, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
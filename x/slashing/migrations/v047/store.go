@@ -0,0 +1,23 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore migrates the x/slashing params from consensus version 2 to 3 by
+// adding the graduated downtime params, WarningSignedPerWindow,
+// MinorSlashSignedPerWindow and SlashFractionMinorDowntime. Their defaults
+// leave the warning and minor slash levels disabled, so that validators keep
+// the downtime penalties they had before the upgrade.
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyWarningSignedPerWindow, types.DefaultWarningSignedPerWindow)
+	paramstore.Set(ctx, types.KeyMinorSlashSignedPerWindow, types.DefaultMinorSlashSignedPerWindow)
+	paramstore.Set(ctx, types.KeySlashFractionMinorDowntime, types.DefaultSlashFractionMinorDowntime)
+
+	return nil
+}
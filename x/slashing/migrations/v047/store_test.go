@@ -0,0 +1,40 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, "slashing")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyWarningSignedPerWindow))
+	require.False(t, paramstore.Has(ctx, types.KeyMinorSlashSignedPerWindow))
+	require.False(t, paramstore.Has(ctx, types.KeySlashFractionMinorDowntime))
+
+	// Run migrations.
+	err := v047slashing.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set and graduated penalties are disabled.
+	var warning, minorSlash, fraction sdk.Dec
+	paramstore.Get(ctx, types.KeyWarningSignedPerWindow, &warning)
+	paramstore.Get(ctx, types.KeyMinorSlashSignedPerWindow, &minorSlash)
+	paramstore.Get(ctx, types.KeySlashFractionMinorDowntime, &fraction)
+	require.True(t, warning.IsZero())
+	require.True(t, minorSlash.IsZero())
+	require.True(t, fraction.IsZero())
+}
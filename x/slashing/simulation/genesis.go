@@ -21,6 +21,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	WarningSignedPerWindow     = "warning_signed_per_window"
+	MinorSlashSignedPerWindow  = "minor_slash_signed_per_window"
+	SlashFractionMinorDowntime = "slash_fraction_minor_downtime"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +52,24 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenMinorSlashSignedPerWindow randomized MinorSlashSignedPerWindow, which must
+// not be lower than minSignedPerWindow
+func GenMinorSlashSignedPerWindow(r *rand.Rand, minSignedPerWindow sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.OneDec(), minSignedPerWindow.Add(sdk.NewDecWithPrec(int64(r.Intn(3)), 1)))
+}
+
+// GenWarningSignedPerWindow randomized WarningSignedPerWindow, which must not
+// be lower than minorSlashSignedPerWindow
+func GenWarningSignedPerWindow(r *rand.Rand, minorSlashSignedPerWindow sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.OneDec(), minorSlashSignedPerWindow.Add(sdk.NewDecWithPrec(int64(r.Intn(3)), 1)))
+}
+
+// GenSlashFractionMinorDowntime randomized SlashFractionMinorDowntime, which
+// must not be larger than slashFractionDowntime
+func GenSlashFractionMinorDowntime(r *rand.Rand, slashFractionDowntime sdk.Dec) sdk.Dec {
+	return slashFractionDowntime.QuoInt64(int64(r.Intn(10) + 1))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +102,33 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var minorSlashSignedPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinorSlashSignedPerWindow, &minorSlashSignedPerWindow, simState.Rand,
+		func(r *rand.Rand) { minorSlashSignedPerWindow = GenMinorSlashSignedPerWindow(r, minSignedPerWindow) },
+	)
+
+	var warningSignedPerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WarningSignedPerWindow, &warningSignedPerWindow, simState.Rand,
+		func(r *rand.Rand) { warningSignedPerWindow = GenWarningSignedPerWindow(r, minorSlashSignedPerWindow) },
+	)
+
+	var slashFractionMinorDowntime sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionMinorDowntime, &slashFractionMinorDowntime, simState.Rand,
+		func(r *rand.Rand) {
+			slashFractionMinorDowntime = GenSlashFractionMinorDowntime(r, slashFractionDowntime)
+		},
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		warningSignedPerWindow, minorSlashSignedPerWindow, slashFractionMinorDowntime,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.DowntimeRecord{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
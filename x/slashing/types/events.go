@@ -5,6 +5,8 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeDowntimeWarning = "downtime_warning"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -15,5 +17,6 @@ const (
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueLowUptime        = "low_uptime"
 	AttributeValueCategory         = ModuleName
 )
@@ -10,11 +10,13 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	downtimeHistory []DowntimeRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:          params,
+		SigningInfos:    signingInfos,
+		MissedBlocks:    missedBlocks,
+		DowntimeHistory: downtimeHistory,
 	}
 }
 
@@ -29,9 +31,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:          DefaultParams(),
+		SigningInfos:    []SigningInfo{},
+		MissedBlocks:    []ValidatorMissedBlocks{},
+		DowntimeHistory: []DowntimeRecord{},
 	}
 }
 
@@ -62,5 +65,15 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := data.Params.ValidateDowntimeLevels(); err != nil {
+		return err
+	}
+
+	for _, record := range data.DowntimeHistory {
+		if _, err := sdk.ConsAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid downtime record address %s: %w", record.Address, err)
+		}
+	}
+
 	return nil
 }
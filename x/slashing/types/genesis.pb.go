@@ -34,6 +34,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// downtime_history represents the downtime penalties applied to validators.
+	DowntimeHistory []DowntimeRecord `protobuf:"bytes,4,rep,name=downtime_history,json=downtimeHistory,proto3" json:"downtime_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimeHistory() []DowntimeRecord {
+	if m != nil {
+		return m.DowntimeHistory
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -268,34 +277,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0x76, 0x14, 0x70, 0x37, 0x81, 0xac, 0x32, 0xc2, 0x0e, 0xd9, 0x54, 0xf1, 0x67,
+	0x97, 0x26, 0x5a, 0x39, 0x22, 0x0e, 0x44, 0x48, 0x83, 0x03, 0x1a, 0x4a, 0x25, 0x04, 0x5c, 0x22,
+	0x27, 0xf6, 0x5c, 0x6b, 0x8d, 0x5d, 0xe5, 0x35, 0x65, 0xfb, 0x16, 0x7c, 0x00, 0x3e, 0x02, 0x47,
+	0x3e, 0xc4, 0x8e, 0x13, 0x5c, 0x38, 0x21, 0xd4, 0x7e, 0x11, 0x84, 0xed, 0xb0, 0x08, 0x1a, 0x55,
+	0xda, 0xa9, 0xb5, 0xfd, 0x3c, 0x3f, 0xbf, 0x7e, 0xde, 0xbc, 0xe8, 0x41, 0xae, 0xa0, 0x50, 0x10,
+	0xc1, 0x94, 0xc0, 0x44, 0x48, 0x1e, 0xcd, 0x0f, 0x32, 0xa6, 0xc9, 0x41, 0xc4, 0x99, 0x64, 0x20,
+	0x20, 0x9c, 0x95, 0x4a, 0x2b, 0x7c, 0xd7, 0xca, 0xc2, 0x4a, 0x16, 0x3a, 0xd9, 0x4e, 0x9f, 0x2b,
+	0xae, 0x8c, 0x26, 0xfa, 0xf3, 0xcf, 0xca, 0x77, 0x1e, 0x36, 0x51, 0xff, 0xfa, 0xad, 0xee, 0x9e,
+	0xd5, 0xa5, 0x16, 0xe0, 0xee, 0x30, 0x8b, 0xc1, 0xf7, 0x36, 0xda, 0x3c, 0xb4, 0x35, 0x8c, 0x35,
+	0xd1, 0x0c, 0x3f, 0x45, 0xdd, 0x19, 0x29, 0x49, 0x01, 0xbe, 0xb7, 0xe7, 0xed, 0xf7, 0x46, 0xbb,
+	0x61, 0x43, 0x4d, 0xe1, 0x6b, 0x23, 0x8b, 0x37, 0xce, 0x7f, 0xee, 0xb6, 0x12, 0x67, 0xc2, 0x47,
+	0x68, 0x0b, 0x04, 0x97, 0x42, 0xf2, 0x54, 0xc8, 0x63, 0x05, 0x7e, 0x7b, 0xaf, 0xb3, 0xdf, 0x1b,
+	0xdd, 0x6f, 0xa4, 0x8c, 0xad, 0xfa, 0xa5, 0x3c, 0x56, 0x0e, 0xb5, 0x09, 0x97, 0x5b, 0x80, 0xdf,
+	0xa1, 0xad, 0x42, 0x00, 0x30, 0x9a, 0x66, 0x53, 0x95, 0x9f, 0x80, 0xdf, 0x31, 0xc0, 0xb0, 0x11,
+	0xf8, 0x86, 0x4c, 0x05, 0x25, 0x5a, 0x95, 0xaf, 0x8c, 0x2d, 0x36, 0xae, 0x0a, 0x5d, 0xd4, 0xf6,
+	0xf0, 0x5b, 0x74, 0x9b, 0xaa, 0x8f, 0x52, 0x8b, 0x82, 0xa5, 0x13, 0x01, 0x5a, 0x95, 0x67, 0xfe,
+	0x86, 0xa1, 0x3f, 0x6a, 0xa4, 0x3f, 0x77, 0x86, 0x84, 0xe5, 0xaa, 0xa4, 0x0e, 0x7b, 0xab, 0xc2,
+	0xbc, 0xb0, 0x94, 0xc1, 0x17, 0x0f, 0xf5, 0x6a, 0x0f, 0xc3, 0x23, 0x74, 0x9d, 0x50, 0x5a, 0x32,
+	0xb0, 0xa9, 0xde, 0x8c, 0xfd, 0x6f, 0x5f, 0x87, 0x7d, 0x77, 0xc7, 0x33, 0x7b, 0x32, 0xd6, 0xa5,
+	0x90, 0x3c, 0xa9, 0x84, 0x58, 0xa0, 0xed, 0x79, 0xf5, 0x94, 0xb4, 0x9e, 0xa9, 0xdf, 0x36, 0x8d,
+	0x19, 0xae, 0x4f, 0xe0, 0xff, 0x6c, 0xfb, 0xf3, 0x15, 0x67, 0x83, 0xcf, 0x1e, 0xba, 0xb3, 0x32,
+	0xb6, 0x2b, 0x15, 0x7e, 0xf4, 0x6f, 0xc7, 0xd6, 0x7d, 0x02, 0xb5, 0x1b, 0x57, 0xf5, 0x69, 0xf0,
+	0x04, 0xf5, 0x6a, 0x12, 0xdc, 0x47, 0xd7, 0x84, 0xa4, 0xec, 0xd4, 0x54, 0xd4, 0x49, 0xec, 0x02,
+	0x6f, 0xa3, 0xae, 0x35, 0x99, 0x78, 0x6e, 0x24, 0x6e, 0x15, 0x1f, 0x9e, 0x2f, 0x02, 0xef, 0x62,
+	0x11, 0x78, 0xbf, 0x16, 0x81, 0xf7, 0x69, 0x19, 0xb4, 0x2e, 0x96, 0x41, 0xeb, 0xc7, 0x32, 0x68,
+	0xbd, 0x1f, 0x72, 0xa1, 0x27, 0x1f, 0xb2, 0x30, 0x57, 0x85, 0x9b, 0x09, 0xf7, 0x33, 0x04, 0x7a,
+	0x12, 0x9d, 0x5e, 0x4e, 0x95, 0x3e, 0x9b, 0x31, 0xc8, 0xba, 0x66, 0x60, 0x1e, 0xff, 0x1e, 0x00,
+	0x4c, 0x55, 0x8a, 0xcc, 0xcb, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +329,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeHistory) > 0 {
+		for iNdEx := len(m.DowntimeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -512,6 +537,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DowntimeHistory) > 0 {
+		for _, e := range m.DowntimeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -700,6 +731,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeHistory = append(m.DowntimeHistory, DowntimeRecord{})
+			if err := m.DowntimeHistory[len(m.DowntimeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
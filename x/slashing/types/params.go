@@ -18,6 +18,11 @@ var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	// Graduated downtime penalties are disabled by default.
+	DefaultWarningSignedPerWindow     = sdk.ZeroDec()
+	DefaultMinorSlashSignedPerWindow  = sdk.ZeroDec()
+	DefaultSlashFractionMinorDowntime = sdk.ZeroDec()
 )
 
 // Parameter store keys
@@ -27,8 +32,14 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyWarningSignedPerWindow     = []byte("WarningSignedPerWindow")
+	KeyMinorSlashSignedPerWindow  = []byte("MinorSlashSignedPerWindow")
+	KeySlashFractionMinorDowntime = []byte("SlashFractionMinorDowntime")
 )
 
+var _ paramtypes.ParamSetValidator = (*Params)(nil)
+
 // ParamKeyTable for slashing module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -38,13 +49,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	warningSignedPerWindow, minorSlashSignedPerWindow, slashFractionMinorDowntime sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:         signedBlocksWindow,
+		MinSignedPerWindow:         minSignedPerWindow,
+		DowntimeJailDuration:       downtimeJailDuration,
+		SlashFractionDoubleSign:    slashFractionDoubleSign,
+		SlashFractionDowntime:      slashFractionDowntime,
+		WarningSignedPerWindow:     warningSignedPerWindow,
+		MinorSlashSignedPerWindow:  minorSlashSignedPerWindow,
+		SlashFractionMinorDowntime: slashFractionMinorDowntime,
 	}
 }
 
@@ -56,6 +71,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyWarningSignedPerWindow, &p.WarningSignedPerWindow, validateWarningSignedPerWindow),
+		paramtypes.NewParamSetPair(KeyMinorSlashSignedPerWindow, &p.MinorSlashSignedPerWindow, validateMinorSlashSignedPerWindow),
+		paramtypes.NewParamSetPair(KeySlashFractionMinorDowntime, &p.SlashFractionMinorDowntime, validateSlashFractionMinorDowntime),
 	}
 }
 
@@ -64,9 +82,42 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultWarningSignedPerWindow, DefaultMinorSlashSignedPerWindow, DefaultSlashFractionMinorDowntime,
 	)
 }
 
+// ValidateDowntimeLevels checks that the graduated downtime penalties are
+// ordered: a validator is warned before it is slashed by the minor downtime
+// fraction, which in turn happens before it is jailed.
+func (p Params) ValidateDowntimeLevels() error {
+	if !p.MinorSlashSignedPerWindow.IsZero() && p.MinorSlashSignedPerWindow.LT(p.MinSignedPerWindow) {
+		return fmt.Errorf(
+			"minor slash signed per window %s must not be lower than min signed per window %s",
+			p.MinorSlashSignedPerWindow, p.MinSignedPerWindow,
+		)
+	}
+	if !p.WarningSignedPerWindow.IsZero() && p.WarningSignedPerWindow.LT(sdk.MaxDec(p.MinorSlashSignedPerWindow, p.MinSignedPerWindow)) {
+		return fmt.Errorf(
+			"warning signed per window %s must not be lower than the minor slash or min signed per window",
+			p.WarningSignedPerWindow,
+		)
+	}
+	if p.SlashFractionMinorDowntime.GT(p.SlashFractionDowntime) {
+		return fmt.Errorf(
+			"minor downtime slash fraction %s must not be larger than downtime slash fraction %s",
+			p.SlashFractionMinorDowntime, p.SlashFractionDowntime,
+		)
+	}
+
+	return nil
+}
+
+// ValidateParamSet implements paramtypes.ParamSetValidator, so that parameter
+// change proposals cannot reorder the graduated downtime penalties.
+func (p Params) ValidateParamSet() error {
+	return p.ValidateDowntimeLevels()
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -140,3 +191,51 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateWarningSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("warning signed per window cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("warning signed per window too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinorSlashSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("minor slash signed per window cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minor slash signed per window too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionMinorDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("minor downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minor downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -308,6 +308,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimeHistoryRequest is the request type for the Query/DowntimeHistory
+// RPC method
+type QueryDowntimeHistoryRequest struct {
+	// cons_address is the address to query the downtime history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeHistoryRequest) Reset()         { *m = QueryDowntimeHistoryRequest{} }
+func (m *QueryDowntimeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeHistoryRequest) ProtoMessage()    {}
+func (*QueryDowntimeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeHistoryRequest.Merge(m, src)
+}
+func (m *QueryDowntimeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryDowntimeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeHistoryResponse is the response type for the
+// Query/DowntimeHistory RPC method
+type QueryDowntimeHistoryResponse struct {
+	// records are the downtime penalties of the validator, oldest first
+	Records    []DowntimeRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeHistoryResponse) Reset()         { *m = QueryDowntimeHistoryResponse{} }
+func (m *QueryDowntimeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeHistoryResponse) ProtoMessage()    {}
+func (*QueryDowntimeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeHistoryResponse.Merge(m, src)
+}
+func (m *QueryDowntimeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeHistoryResponse) GetRecords() []DowntimeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryDowntimeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +425,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimeHistoryRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeHistoryRequest")
+	proto.RegisterType((*QueryDowntimeHistoryResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeHistoryResponse")
 }
 
 func init() {
@@ -322,42 +434,48 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x41,
+	0x14, 0xc6, 0x33, 0xb5, 0xad, 0x38, 0x2d, 0x2a, 0x63, 0xa1, 0x6d, 0x2c, 0x5b, 0x5d, 0x21, 0x29,
+	0x6a, 0x76, 0x4d, 0xb4, 0x28, 0x4a, 0x41, 0x83, 0x34, 0x7a, 0xd3, 0x54, 0x7a, 0x10, 0x24, 0x4c,
+	0xb2, 0xd3, 0xcd, 0x60, 0x32, 0xb3, 0xdd, 0xd9, 0x44, 0x83, 0x78, 0xf1, 0xec, 0x41, 0xf0, 0x3f,
+	0x10, 0x3c, 0x0a, 0x0a, 0x7a, 0xf7, 0xd8, 0x63, 0xd1, 0x8b, 0x27, 0x91, 0xc4, 0x3f, 0x44, 0x32,
+	0x33, 0x9b, 0x6c, 0x9a, 0x6c, 0x4d, 0x4a, 0x4f, 0xd9, 0xcc, 0xbc, 0xef, 0x7d, 0xbf, 0xf9, 0x76,
+	0x5e, 0x02, 0x2f, 0x55, 0xb8, 0xa8, 0x73, 0x61, 0x8b, 0x1a, 0x16, 0x55, 0xca, 0x5c, 0xbb, 0x99,
+	0x2d, 0x93, 0x00, 0x67, 0xed, 0xdd, 0x06, 0xf1, 0x5b, 0x96, 0xe7, 0xf3, 0x80, 0xa3, 0x45, 0x55,
+	0x64, 0x85, 0x45, 0x96, 0x2e, 0x4a, 0x5e, 0xd6, 0xea, 0x32, 0x16, 0x44, 0x29, 0x7a, 0x7a, 0x0f,
+	0xbb, 0x94, 0xe1, 0x80, 0x72, 0xa6, 0x9a, 0x24, 0x17, 0x5c, 0xee, 0x72, 0xf9, 0x68, 0x77, 0x9f,
+	0xf4, 0xea, 0x8a, 0xcb, 0xb9, 0x5b, 0x23, 0x36, 0xf6, 0xa8, 0x8d, 0x19, 0xe3, 0x81, 0x94, 0x08,
+	0xbd, 0x9b, 0x8a, 0xa3, 0xeb, 0x91, 0xa8, 0xba, 0x65, 0x55, 0x57, 0x52, 0xed, 0x35, 0xad, 0xfc,
+	0x62, 0x2e, 0x40, 0xf4, 0xb8, 0x0b, 0xf6, 0x08, 0xfb, 0xb8, 0x2e, 0x8a, 0x64, 0xb7, 0x41, 0x44,
+	0x60, 0x3e, 0x81, 0xe7, 0x06, 0x56, 0x85, 0xc7, 0x99, 0x20, 0x68, 0x03, 0xce, 0x7a, 0x72, 0x65,
+	0x09, 0x5c, 0x00, 0x6b, 0x73, 0xb9, 0x55, 0x2b, 0xe6, 0xe4, 0x96, 0x12, 0xe6, 0xa7, 0xf7, 0x7e,
+	0xaf, 0x26, 0x8a, 0x5a, 0x64, 0x6e, 0xc3, 0x45, 0xd9, 0x75, 0x8b, 0xba, 0x8c, 0x32, 0xf7, 0x21,
+	0xdb, 0xe1, 0xda, 0x10, 0xdd, 0x81, 0xf3, 0x15, 0xce, 0x44, 0x09, 0x3b, 0x8e, 0x4f, 0x84, 0xea,
+	0x7f, 0x2a, 0xbf, 0xf4, 0xe3, 0x6b, 0x66, 0x41, 0x5b, 0xdc, 0x53, 0x3b, 0x5b, 0x81, 0x4f, 0x99,
+	0x5b, 0x9c, 0xeb, 0x56, 0xeb, 0x25, 0xb3, 0x05, 0x97, 0x86, 0xfb, 0x6a, 0xe4, 0x67, 0xf0, 0x6c,
+	0x13, 0xd7, 0x4a, 0x42, 0x6d, 0x95, 0x28, 0xdb, 0xe1, 0x1a, 0x3e, 0x13, 0x0b, 0xbf, 0x8d, 0x6b,
+	0xd4, 0xc1, 0x01, 0xf7, 0x23, 0x0d, 0xf5, 0x51, 0x4e, 0x37, 0x71, 0x2d, 0xb2, 0x6a, 0x96, 0x87,
+	0xad, 0xc3, 0x10, 0xd1, 0x26, 0x84, 0xfd, 0xb7, 0xac, 0x4d, 0x53, 0xa1, 0x69, 0xf7, 0x4a, 0x58,
+	0xea, 0x12, 0xf5, 0x33, 0x73, 0x89, 0xd6, 0x16, 0x23, 0x4a, 0xf3, 0x13, 0x80, 0xcb, 0x23, 0x4c,
+	0xf4, 0x01, 0x0b, 0x70, 0x5a, 0x1f, 0xea, 0xc4, 0x51, 0x0f, 0x25, 0x1b, 0xa0, 0xc2, 0x00, 0xee,
+	0x94, 0xc4, 0x4d, 0xff, 0x17, 0x57, 0x51, 0x0c, 0xf0, 0x7e, 0x00, 0xf0, 0xbc, 0xe4, 0xbd, 0xcf,
+	0x5f, 0xb0, 0x80, 0xd6, 0xc9, 0x03, 0x2a, 0x02, 0xee, 0xb7, 0x8e, 0xe3, 0x5d, 0xa3, 0xcd, 0x11,
+	0x94, 0x47, 0x09, 0xf5, 0x33, 0x80, 0x2b, 0xa3, 0x21, 0x7b, 0xb9, 0x9e, 0xf4, 0x49, 0x85, 0xfb,
+	0x8e, 0xd0, 0xd1, 0xa6, 0x63, 0xa3, 0x0d, 0x5b, 0x14, 0x65, 0xbd, 0x0e, 0x35, 0x54, 0x1f, 0x5b,
+	0xae, 0xb9, 0x6f, 0x33, 0x70, 0x46, 0x22, 0xa3, 0xb7, 0x00, 0xce, 0xaa, 0x09, 0x43, 0x57, 0x62,
+	0xa9, 0x86, 0xc7, 0x3a, 0x79, 0x75, 0xbc, 0x62, 0xe5, 0x6d, 0xa6, 0xdf, 0xfc, 0xfc, 0xfb, 0x7e,
+	0xea, 0x22, 0x5a, 0xb5, 0xe3, 0x7e, 0x66, 0xd4, 0x5c, 0xa3, 0x2f, 0x00, 0xce, 0x45, 0x6e, 0x15,
+	0xba, 0x76, 0xb8, 0xcd, 0xf0, 0xf8, 0x27, 0xb3, 0x13, 0x28, 0x34, 0xdd, 0x86, 0xa4, 0xbb, 0x89,
+	0xd6, 0x63, 0xe9, 0xa2, 0x33, 0x2f, 0xec, 0x57, 0xd1, 0x3b, 0xf7, 0x1a, 0x7d, 0x04, 0x70, 0x3e,
+	0xd2, 0x56, 0xa0, 0xf1, 0x11, 0x7a, 0x71, 0xe6, 0x26, 0x91, 0x68, 0x6c, 0x4b, 0x62, 0xaf, 0xa1,
+	0xd4, 0x78, 0xd8, 0xe8, 0x3b, 0x80, 0x67, 0x0e, 0x5c, 0x51, 0x74, 0xe3, 0x70, 0xdf, 0xd1, 0x63,
+	0x97, 0x5c, 0x9f, 0x50, 0xa5, 0x81, 0xef, 0x4a, 0xe0, 0xdb, 0xe8, 0x56, 0x2c, 0xb0, 0xa3, 0x95,
+	0xa5, 0xaa, 0x92, 0x1e, 0x88, 0x3a, 0x5f, 0xd8, 0x6b, 0x1b, 0x60, 0xbf, 0x6d, 0x80, 0x3f, 0x6d,
+	0x03, 0xbc, 0xeb, 0x18, 0x89, 0xfd, 0x8e, 0x91, 0xf8, 0xd5, 0x31, 0x12, 0x4f, 0x33, 0x2e, 0x0d,
+	0xaa, 0x8d, 0xb2, 0x55, 0xe1, 0xf5, 0xb0, 0xbb, 0xfa, 0xc8, 0x08, 0xe7, 0xb9, 0xfd, 0xb2, 0x6f,
+	0x15, 0xb4, 0x3c, 0x22, 0xca, 0xb3, 0xf2, 0x2f, 0xeb, 0xfa, 0xbf, 0x01, 0x00, 0x53, 0xe8, 0xd2,
+	0x7d, 0x95, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +496,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeHistory queries the downtime penalties applied to a validator
+	DowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +535,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error) {
+	out := new(QueryDowntimeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +552,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeHistory queries the downtime penalties applied to a validator
+	DowntimeHistory(context.Context, *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +569,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeHistory(ctx context.Context, req *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +631,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeHistory(ctx, req.(*QueryDowntimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +665,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeHistory",
+			Handler:    _Query_DowntimeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -721,6 +877,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -808,6 +1055,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +1601,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DowntimeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,47 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DowntimeLevel defines the graduated penalties for low uptime.
+type DowntimeLevel int32
+
+const (
+	// DOWNTIME_LEVEL_UNSPECIFIED defines a validator signing enough blocks.
+	DowntimeLevelUnspecified DowntimeLevel = 0
+	// DOWNTIME_LEVEL_WARNING defines a validator signing less than
+	// WarningSignedPerWindow blocks, for which a warning is emitted.
+	DowntimeLevelWarning DowntimeLevel = 1
+	// DOWNTIME_LEVEL_SLASH defines a validator signing less than
+	// MinorSlashSignedPerWindow blocks, which is slashed by
+	// SlashFractionMinorDowntime.
+	DowntimeLevelSlash DowntimeLevel = 2
+	// DOWNTIME_LEVEL_JAIL defines a validator signing less than
+	// MinSignedPerWindow blocks, which is slashed by SlashFractionDowntime and
+	// jailed.
+	DowntimeLevelJail DowntimeLevel = 3
+)
+
+var DowntimeLevel_name = map[int32]string{
+	0: "DOWNTIME_LEVEL_UNSPECIFIED",
+	1: "DOWNTIME_LEVEL_WARNING",
+	2: "DOWNTIME_LEVEL_SLASH",
+	3: "DOWNTIME_LEVEL_JAIL",
+}
+
+var DowntimeLevel_value = map[string]int32{
+	"DOWNTIME_LEVEL_UNSPECIFIED": 0,
+	"DOWNTIME_LEVEL_WARNING":     1,
+	"DOWNTIME_LEVEL_SLASH":       2,
+	"DOWNTIME_LEVEL_JAIL":        3,
+}
+
+func (x DowntimeLevel) String() string {
+	return proto.EnumName(DowntimeLevel_name, int32(x))
+}
+
+func (DowntimeLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -48,6 +89,10 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// The highest downtime level the validator reached in the current window. It
+	// is lowered again once the validator signs enough blocks, so each level is
+	// only applied once per downtime.
+	DowntimeLevel DowntimeLevel `protobuf:"varint,7,opt,name=downtime_level,json=downtimeLevel,proto3,enum=cosmos.slashing.v1beta1.DowntimeLevel" json:"downtime_level,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +169,99 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeLevel() DowntimeLevel {
+	if m != nil {
+		return m.DowntimeLevel
+	}
+	return DowntimeLevelUnspecified
+}
+
+// DowntimeRecord records a downtime penalty applied to a validator.
+type DowntimeRecord struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height the penalty was applied at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the penalty was applied at.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// level is the downtime level the validator reached.
+	Level DowntimeLevel `protobuf:"varint,4,opt,name=level,proto3,enum=cosmos.slashing.v1beta1.DowntimeLevel" json:"level,omitempty"`
+	// missed_blocks_counter is the number of blocks missed in the window.
+	MissedBlocksCounter int64 `protobuf:"varint,5,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// slash_fraction is the fraction of stake slashed, zero for warnings.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// burned_coins is the amount of tokens burned by the slash.
+	BurnedCoins github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=burned_coins,json=burnedCoins,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned_coins"`
+}
+
+func (m *DowntimeRecord) Reset()         { *m = DowntimeRecord{} }
+func (m *DowntimeRecord) String() string { return proto.CompactTextString(m) }
+func (*DowntimeRecord) ProtoMessage()    {}
+func (*DowntimeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeRecord.Merge(m, src)
+}
+func (m *DowntimeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeRecord proto.InternalMessageInfo
+
+func (m *DowntimeRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DowntimeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeRecord) GetLevel() DowntimeLevel {
+	if m != nil {
+		return m.Level
+	}
+	return DowntimeLevelUnspecified
+}
+
+func (m *DowntimeRecord) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,13 +269,21 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// warning_signed_per_window is the fraction of the window a validator must
+	// sign to not get a downtime warning. Zero disables warnings.
+	WarningSignedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=warning_signed_per_window,json=warningSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_signed_per_window"`
+	// minor_slash_signed_per_window is the fraction of the window a validator
+	// must sign to not be slashed by slash_fraction_minor_downtime. Zero disables
+	// minor slashes.
+	MinorSlashSignedPerWindow  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=minor_slash_signed_per_window,json=minorSlashSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minor_slash_signed_per_window"`
+	SlashFractionMinorDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_fraction_minor_downtime,json=slashFractionMinorDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_minor_downtime"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,7 +327,9 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.DowntimeLevel", DowntimeLevel_name, DowntimeLevel_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeRecord)(nil), "cosmos.slashing.v1beta1.DowntimeRecord")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -190,43 +338,64 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xf7, 0x62, 0x30, 0x64, 0xf8, 0x23, 0x3a, 0x31, 0x66, 0x59, 0x35, 0xeb, 0x2d, 0x07, 0x64,
+	0x55, 0x62, 0xdd, 0xd0, 0x1e, 0xaa, 0x8a, 0x0b, 0xc6, 0x4e, 0xe2, 0xd4, 0x10, 0xb4, 0x0e, 0x41,
+	0xed, 0x65, 0xb5, 0xde, 0x1d, 0x2f, 0x53, 0x76, 0x67, 0xdc, 0x9d, 0x71, 0x48, 0xbf, 0x40, 0x55,
+	0x71, 0xca, 0x31, 0x17, 0x4b, 0x91, 0x7a, 0xe9, 0x07, 0xc8, 0x07, 0xe8, 0x31, 0xc7, 0x28, 0xa7,
+	0xaa, 0x87, 0xb4, 0x82, 0x4b, 0xa5, 0x7e, 0x89, 0x6a, 0x66, 0x76, 0x49, 0xd6, 0x94, 0x48, 0xf8,
+	0x04, 0xf3, 0xde, 0xfb, 0xfd, 0xde, 0xfc, 0x7e, 0xef, 0xcd, 0xca, 0x60, 0xc3, 0xa7, 0x2c, 0xa6,
+	0xac, 0xce, 0x22, 0x8f, 0x1d, 0x63, 0x12, 0xd6, 0x9f, 0xde, 0xed, 0x21, 0xee, 0xdd, 0xbd, 0x0c,
+	0xd8, 0x83, 0x84, 0x72, 0x0a, 0x57, 0x55, 0x9d, 0x7d, 0x19, 0x4e, 0xeb, 0x8c, 0x72, 0x48, 0x43,
+	0x2a, 0x6b, 0xea, 0xe2, 0x3f, 0x55, 0x6e, 0x98, 0x21, 0xa5, 0x61, 0x84, 0xea, 0xf2, 0xd4, 0x1b,
+	0xf6, 0xeb, 0xc1, 0x30, 0xf1, 0x38, 0xa6, 0x24, 0xcd, 0x57, 0xc7, 0xf3, 0x1c, 0xc7, 0x88, 0x71,
+	0x2f, 0x1e, 0xa4, 0x05, 0x6b, 0xaa, 0x9f, 0xab, 0x98, 0xd3, 0xe6, 0xf2, 0xb0, 0xfe, 0x73, 0x11,
+	0x94, 0x9f, 0x78, 0x11, 0x0e, 0x3c, 0x4e, 0x93, 0x2e, 0x0e, 0x09, 0x26, 0x61, 0x9b, 0xf4, 0x29,
+	0xdc, 0x02, 0xb3, 0x5e, 0x10, 0x24, 0x88, 0x31, 0x5d, 0xb3, 0xb4, 0xda, 0xad, 0x86, 0xfe, 0xf6,
+	0xd5, 0x66, 0x39, 0xc5, 0xee, 0xa8, 0x4c, 0x97, 0x27, 0x98, 0x84, 0x4e, 0x56, 0x08, 0x3f, 0x03,
+	0x0b, 0x8c, 0x7b, 0x09, 0x77, 0x8f, 0x11, 0x0e, 0x8f, 0xb9, 0x3e, 0x65, 0x69, 0xb5, 0xa2, 0x33,
+	0x2f, 0x63, 0x0f, 0x64, 0x48, 0x94, 0x60, 0x12, 0xa0, 0x67, 0x2e, 0xed, 0xf7, 0x19, 0xe2, 0x7a,
+	0x51, 0x95, 0xc8, 0xd8, 0x23, 0x19, 0x82, 0xf7, 0xc1, 0xc2, 0x0f, 0x1e, 0x8e, 0x50, 0xe0, 0x0e,
+	0x09, 0xc7, 0x91, 0x3e, 0x6d, 0x69, 0xb5, 0xf9, 0x2d, 0xc3, 0x56, 0x2a, 0xed, 0x4c, 0xa5, 0xfd,
+	0x38, 0x53, 0xd9, 0x98, 0x7b, 0xfd, 0xae, 0x5a, 0x78, 0xfe, 0x57, 0x55, 0x73, 0xe6, 0x15, 0xf2,
+	0x50, 0x00, 0xa1, 0x09, 0x00, 0xa7, 0x71, 0x8f, 0x71, 0x4a, 0x50, 0xa0, 0xcf, 0x58, 0x5a, 0x6d,
+	0xce, 0xf9, 0x20, 0x02, 0xb7, 0xc0, 0x4a, 0x8c, 0x19, 0x43, 0x81, 0xdb, 0x8b, 0xa8, 0x7f, 0xc2,
+	0x5c, 0x9f, 0x0e, 0x09, 0x47, 0x89, 0x5e, 0x92, 0x97, 0xba, 0xad, 0x92, 0x0d, 0x99, 0xdb, 0x55,
+	0x29, 0xb8, 0x07, 0x96, 0x02, 0x7a, 0x4a, 0x84, 0xc3, 0x6e, 0x84, 0x9e, 0xa2, 0x48, 0x9f, 0xb5,
+	0xb4, 0xda, 0xd2, 0xd6, 0x86, 0x7d, 0xcd, 0x4c, 0xed, 0x66, 0x5a, 0xde, 0x11, 0xd5, 0xce, 0x62,
+	0xf0, 0xe1, 0xf1, 0x9b, 0xb9, 0x17, 0x2f, 0xab, 0x85, 0x7f, 0x5e, 0x56, 0xb5, 0xf5, 0xdf, 0x8b,
+	0x60, 0x29, 0x2b, 0x75, 0x90, 0x4f, 0x93, 0x60, 0xa2, 0x11, 0x54, 0x40, 0x29, 0x67, 0x7e, 0x7a,
+	0x82, 0x5f, 0x83, 0x69, 0xc1, 0xac, 0x17, 0x6f, 0x60, 0xa6, 0x44, 0xc0, 0x6d, 0x30, 0xa3, 0x84,
+	0x4e, 0xdf, 0x48, 0xa8, 0x02, 0x5d, 0xef, 0xf1, 0xcc, 0xf5, 0x1e, 0x1f, 0x82, 0x25, 0x49, 0xee,
+	0xf6, 0x13, 0xcf, 0x17, 0x7b, 0x2e, 0x07, 0xb2, 0xd0, 0xb0, 0xc5, 0xcd, 0xfe, 0x7c, 0x57, 0xdd,
+	0x08, 0x31, 0x3f, 0x1e, 0xf6, 0x6c, 0x9f, 0xc6, 0xe9, 0x32, 0xa7, 0x7f, 0x36, 0x59, 0x70, 0x52,
+	0xe7, 0x3f, 0x0d, 0x10, 0xb3, 0x9b, 0xc8, 0x77, 0x16, 0x25, 0xcb, 0xbd, 0x94, 0x04, 0xba, 0x60,
+	0xa1, 0x37, 0x4c, 0x08, 0x0a, 0x5c, 0x9f, 0x62, 0xc2, 0xe4, 0xe0, 0x6e, 0x35, 0xb6, 0x6f, 0x40,
+	0xda, 0x26, 0xfc, 0xed, 0xab, 0x4d, 0x90, 0x1a, 0xd0, 0x26, 0xdc, 0x99, 0x57, 0x8c, 0xbb, 0x82,
+	0x70, 0x7d, 0x54, 0x02, 0xa5, 0x03, 0x2f, 0xf1, 0x62, 0x06, 0xbf, 0x00, 0x65, 0x86, 0x43, 0xf2,
+	0x5e, 0xf6, 0x29, 0x26, 0x01, 0x3d, 0x95, 0x73, 0x2c, 0x3a, 0x50, 0xe5, 0x94, 0xea, 0x23, 0x99,
+	0x81, 0x9e, 0x30, 0x8a, 0xb8, 0x29, 0x6a, 0x80, 0x92, 0x0c, 0x32, 0x35, 0x91, 0x76, 0x18, 0x63,
+	0xd2, 0x95, 0x5c, 0x07, 0x28, 0x49, 0x5b, 0x7c, 0x07, 0x2a, 0x97, 0xbb, 0x2b, 0xde, 0x89, 0x9b,
+	0x7d, 0x47, 0xd2, 0xad, 0x58, 0xbb, 0xb2, 0x15, 0xcd, 0xb4, 0x40, 0x2d, 0xc5, 0x0b, 0xb1, 0x14,
+	0xe5, 0x8c, 0xe2, 0xa1, 0x87, 0xa3, 0x2c, 0x0f, 0x4f, 0x80, 0x91, 0x1f, 0x99, 0x1b, 0xd0, 0x61,
+	0x2f, 0x42, 0x52, 0x8f, 0x3e, 0x3d, 0x91, 0x84, 0xd5, 0xdc, 0xf8, 0x9a, 0x92, 0x4f, 0x48, 0x82,
+	0x7d, 0xb0, 0x7a, 0xa5, 0x99, 0xba, 0x93, 0x3e, 0x33, 0x51, 0xa7, 0x95, 0xb1, 0x4e, 0x8a, 0x0c,
+	0x62, 0xb0, 0x76, 0xea, 0x25, 0xe2, 0x8b, 0xf8, 0x3f, 0x63, 0x99, 0x6c, 0x25, 0x2b, 0x29, 0xe1,
+	0xf8, 0x68, 0x06, 0xe0, 0x4e, 0x8c, 0x09, 0x4d, 0x5c, 0x25, 0xec, 0x6a, 0xbb, 0xd9, 0x89, 0xda,
+	0xad, 0x49, 0xd2, 0xae, 0xe0, 0x1c, 0xef, 0xf8, 0x23, 0xb8, 0x33, 0x66, 0xa2, 0xba, 0xc0, 0xa5,
+	0x95, 0x73, 0x13, 0x75, 0x34, 0x72, 0x56, 0xee, 0x09, 0xca, 0xcc, 0xcf, 0xcf, 0xff, 0xd5, 0xc0,
+	0x62, 0xee, 0x23, 0x01, 0xb7, 0x81, 0xd1, 0x7c, 0x74, 0xb4, 0xff, 0xb8, 0xbd, 0xd7, 0x72, 0x3b,
+	0xad, 0x27, 0xad, 0x8e, 0x7b, 0xb8, 0xdf, 0x3d, 0x68, 0xed, 0xb6, 0xef, 0xb5, 0x5b, 0xcd, 0xe5,
+	0x82, 0xf1, 0xe9, 0xd9, 0xc8, 0xd2, 0x73, 0x90, 0x43, 0xc2, 0x06, 0xc8, 0xc7, 0x7d, 0x8c, 0x02,
+	0xf8, 0x15, 0xa8, 0x8c, 0xa1, 0x8f, 0x76, 0x9c, 0xfd, 0xf6, 0xfe, 0xfd, 0x65, 0xcd, 0xd0, 0xcf,
+	0x46, 0x56, 0x39, 0x87, 0x3c, 0x52, 0xce, 0x8b, 0xa7, 0x39, 0x86, 0xea, 0x76, 0x76, 0xba, 0x0f,
+	0x96, 0xa7, 0x8c, 0xca, 0xd9, 0xc8, 0x82, 0x39, 0x8c, 0x74, 0x0e, 0xda, 0xe0, 0xf6, 0x18, 0xe2,
+	0xe1, 0x4e, 0xbb, 0xb3, 0x5c, 0x34, 0x56, 0xce, 0x46, 0xd6, 0x27, 0x39, 0x80, 0x78, 0x14, 0xc6,
+	0xf4, 0x2f, 0xbf, 0x9a, 0x85, 0xc6, 0xb7, 0xbf, 0x9d, 0x9b, 0xda, 0xeb, 0x73, 0x53, 0x7b, 0x73,
+	0x6e, 0x6a, 0x7f, 0x9f, 0x9b, 0xda, 0xf3, 0x0b, 0xb3, 0xf0, 0xe6, 0xc2, 0x2c, 0xfc, 0x71, 0x61,
+	0x16, 0xbe, 0xdf, 0xfc, 0xa8, 0x9f, 0xcf, 0xde, 0xff, 0x84, 0x90, 0xd6, 0xf6, 0x4a, 0xf2, 0x49,
+	0x7e, 0xf9, 0xdf, 0x00, 0xac, 0xb5, 0x87, 0xb6, 0x62, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +435,51 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeLevel != that1.DowntimeLevel {
+		return false
+	}
+	return true
+}
+func (this *DowntimeRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeRecord)
+	if !ok {
+		that2, ok := that.(DowntimeRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.BurnedCoins.Equal(that1.BurnedCoins) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +516,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.WarningSignedPerWindow.Equal(that1.WarningSignedPerWindow) {
+		return false
+	}
+	if !this.MinorSlashSignedPerWindow.Equal(that1.MinorSlashSignedPerWindow) {
+		return false
+	}
+	if !this.SlashFractionMinorDowntime.Equal(that1.SlashFractionMinorDowntime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +547,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeLevel != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeLevel))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -367,6 +595,79 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedCoins.Size()
+		i -= size
+		if _, err := m.BurnedCoins.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Level != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -387,6 +688,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SlashFractionMinorDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionMinorDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinorSlashSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinorSlashSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WarningSignedPerWindow.Size()
+		i -= size
+		if _, err := m.WarningSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +738,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +799,37 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeLevel != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeLevel))
+	}
+	return n
+}
+
+func (m *DowntimeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Level != 0 {
+		n += 1 + sovSlashing(uint64(m.Level))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.BurnedCoins.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -488,6 +850,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.WarningSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MinorSlashSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionMinorDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,6 +1036,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeLevel", wireType)
+			}
+			m.DowntimeLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeLevel |= DowntimeLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -689,7 +1076,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DowntimeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,17 +1099,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowntimeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowntimeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.SignedBlocksWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -732,22 +1119,261 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= DowntimeLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedCoins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedCoins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -869,6 +1495,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorSlashSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinorSlashSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMinorDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMinorDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
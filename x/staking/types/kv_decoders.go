@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterKVDecoders registers the decoders of the KV pairs of the staking store, for the state
+// streaming services. The indexes, whose values are empty or addresses, are not decoded.
+func RegisterKVDecoders(registry *sdk.KVDecoderRegistry, cdc codec.BinaryCodec) {
+	registry.Register(StoreKey, LastValidatorPowerKey, func(key, value []byte) (proto.Message, error) {
+		var power gogotypes.Int64Value
+		if err := cdc.Unmarshal(value, &power); err != nil {
+			return nil, err
+		}
+		addr := sdk.ValAddress(AddressFromLastValidatorPowerKey(key))
+		return &LastValidatorPower{Address: addr.String(), Power: power.Value}, nil
+	})
+
+	for prefix, newMsg := range map[string]func() codec.ProtoMarshaler{
+		string(LastTotalPowerKey):      func() codec.ProtoMarshaler { return &sdk.IntProto{} },
+		string(ValidatorsKey):          func() codec.ProtoMarshaler { return &Validator{} },
+		string(DelegationKey):          func() codec.ProtoMarshaler { return &Delegation{} },
+		string(UnbondingDelegationKey): func() codec.ProtoMarshaler { return &UnbondingDelegation{} },
+		string(RedelegationKey):        func() codec.ProtoMarshaler { return &Redelegation{} },
+		string(UnbondingQueueKey):      func() codec.ProtoMarshaler { return &DVPairs{} },
+		string(RedelegationQueueKey):   func() codec.ProtoMarshaler { return &DVVTriplets{} },
+		string(ValidatorQueueKey):      func() codec.ProtoMarshaler { return &ValAddresses{} },
+		string(HistoricalInfoKey):      func() codec.ProtoMarshaler { return &HistoricalInfo{} },
+	} {
+		registry.Register(StoreKey, []byte(prefix), sdk.NewProtoKVDecoder(cdc, newMsg))
+	}
+}
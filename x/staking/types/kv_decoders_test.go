@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestKVDecoders(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	registry := sdk.NewKVDecoderRegistry(cdc)
+	types.RegisterKVDecoders(registry, cdc)
+
+	valAddr := sdk.ValAddress(keysAddr1)
+	delAddr := sdk.AccAddress(keysAddr2)
+	val := newValidator(t, valAddr, keysPK1)
+	del := types.NewDelegation(delAddr, valAddr, sdk.OneDec())
+	ubd := types.NewUnbondingDelegation(delAddr, valAddr, 10, time.Unix(0, 0).UTC(), sdk.OneInt())
+	totalPower := sdk.IntProto{Int: sdk.NewInt(100)}
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		value    []byte
+		expected interface{}
+	}{
+		{"last validator power", types.GetLastValidatorPowerKey(valAddr), cdc.MustMarshal(&gogotypes.Int64Value{Value: 10}), &types.LastValidatorPower{Address: valAddr.String(), Power: 10}},
+		{"last total power", types.LastTotalPowerKey, cdc.MustMarshal(&totalPower), &totalPower},
+		{"validator", types.GetValidatorKey(valAddr), types.MustMarshalValidator(cdc, &val), &val},
+		{"delegation", types.GetDelegationKey(delAddr, valAddr), types.MustMarshalDelegation(cdc, del), &del},
+		{"unbonding delegation", types.GetUBDKey(delAddr, valAddr), types.MustMarshalUBD(cdc, ubd), &ubd},
+		{"unbonding delegation index", types.GetUBDByValIndexKey(delAddr, valAddr), []byte{}, nil},
+		{"validator by consensus address index", types.GetValidatorByConsAddrKey(sdk.ConsAddress(keysAddr1)), valAddr, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := registry.Decode(types.StoreKey, tc.key, tc.value)
+			require.NoError(t, err)
+			if tc.expected == nil {
+				require.Nil(t, msg)
+			} else {
+				require.Equal(t, tc.expected, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DryRunApp defines the application interface required to rehearse an upgrade
+// offline.
+type DryRunApp interface {
+	// DryRunUpgrade runs the upgrade handler registered for the given plan
+	// name, and the migrations it triggers, on top of the latest committed
+	// state without persisting any change.
+	DryRunUpgrade(planName string) (types.DryRunResult, error)
+}
+
+// DryRunAppCreator creates an application whose state is loaded at the given
+// height, or at the latest height if height is -1.
+type DryRunAppCreator func(logger log.Logger, db dbm.DB, height int64, appOpts servertypes.AppOptions) (DryRunApp, error)
+
+// UpgradeDryRunCmd returns a command rehearsing an upgrade handler and its
+// store migrations against the application state of a stopped node.
+func UpgradeDryRunCmd(appCreator DryRunAppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run [plan-name]",
+		Short: "Rehearse an upgrade handler and its store migrations without persisting them",
+		Long: `Load the application state at the given height, or the latest height, of the
+node at --home and run the upgrade handler registered for the given plan name,
+including the module migrations it runs, against a cached copy of that state.
+The node must be stopped and the binary must be the upgraded one.
+
+The time and gas spent by each module migration, and any error, are reported.
+All the state changes are discarded. The command exits with an error if the
+upgrade handler failed.
+`,
+		Example: fmt.Sprintf(`$ %[1]s upgrade-dry-run v2 --height 1000
+$ %[1]s upgrade-dry-run v2 -o json`, "<appd>"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			output, _ := cmd.Flags().GetString(tmcli.OutputFlag)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, err := appCreator(serverCtx.Logger, db, height, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			res, err := app.DryRunUpgrade(args[0])
+			if err != nil {
+				return err
+			}
+
+			if output == "json" {
+				bz, err := json.MarshalIndent(res, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else {
+				cmd.Printf("upgrade %s at height %d: %s, %d gas\n", res.PlanName, res.Height, res.Duration, res.GasUsed)
+				for _, m := range res.Migrations {
+					status := "ok"
+					if m.Error != "" {
+						status = "FAILED"
+					}
+					migration := fmt.Sprintf("v%d -> v%d", m.FromVersion, m.ToVersion)
+					if m.InitGenesis {
+						migration = fmt.Sprintf("init genesis v%d", m.ToVersion)
+					}
+					cmd.Printf("%-6s %-20s %-18s %12s %12d gas\n", status, m.Module, migration, m.Duration, m.GasUsed)
+					if m.Error != "" {
+						cmd.Println(m.Error)
+					}
+				}
+			}
+
+			if res.Failed() {
+				return fmt.Errorf("upgrade %s failed: %s", res.PlanName, res.Error)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Rehearse the upgrade on top of the state at a particular height (-1 means latest height)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
@@ -3,10 +3,12 @@ package keeper
 import (
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -356,6 +358,43 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	k.setDone(ctx, plan.Name)
 }
 
+// DryRunUpgrade runs the handler associated with the Plan against a cached copy
+// of the state, recording the migrations it runs, and discards all the writes.
+// Unlike ApplyUpgrade, it neither bumps the protocol version nor marks the plan
+// as done, and an error or panic in the handler is reported in the result.
+func (k Keeper) DryRunUpgrade(ctx sdk.Context, plan types.Plan) (res types.DryRunResult, err error) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		return res, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no upgrade handler registered for %s", plan.Name)
+	}
+
+	recorder := &module.MigrationRecorder{}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = module.WithMigrationRecorder(cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter()), recorder)
+
+	res.PlanName = plan.Name
+	res.Height = ctx.BlockHeight()
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			res.Error = fmt.Sprintf("upgrade handler panicked: %v", r)
+		}
+		res.Duration = time.Since(start)
+		res.GasUsed = cacheCtx.GasMeter().GasConsumed()
+		res.Migrations = recorder.Results
+	}()
+
+	updatedVM, handlerErr := handler(cacheCtx, plan, k.GetModuleVersionMap(cacheCtx))
+	if handlerErr != nil {
+		res.Error = handlerErr.Error()
+		return res, nil
+	}
+	res.VersionMap = updatedVM
+
+	return res, nil
+}
+
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
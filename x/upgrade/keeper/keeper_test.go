@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -238,6 +239,62 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().Equal(vmBefore["bank"]+1, vm["bank"])
 }
 
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	plan := types.Plan{Name: "dry-run", Height: s.ctx.BlockHeight()}
+
+	_, err := s.app.UpgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	s.Require().ErrorContains(err, "no upgrade handler registered for dry-run")
+
+	oldProtocolVersion := s.app.BaseApp.AppVersion()
+	vmBefore := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// simulate adding the crisis module, and write to the x/upgrade store
+		delete(fromVM, "crisis")
+		s.app.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{"dry-run": 1})
+		return s.app.ModuleManager.RunMigrations(ctx, s.app.Configurator(), fromVM)
+	})
+
+	res, err := s.app.UpgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().False(res.Failed())
+	s.Require().Equal(plan.Name, res.PlanName)
+	s.Require().Equal(s.ctx.BlockHeight(), res.Height)
+	s.Require().Len(res.Migrations, len(s.app.ModuleManager.Modules))
+	s.Require().Equal(vmBefore["bank"], res.VersionMap["bank"])
+
+	var crisisRes module.MigrationResult
+	for _, m := range res.Migrations {
+		if m.Module == "crisis" {
+			crisisRes = m
+		}
+	}
+	s.Require().True(crisisRes.InitGenesis)
+	s.Require().Empty(crisisRes.Error)
+	s.Require().NotZero(crisisRes.GasUsed)
+	s.Require().NotZero(res.GasUsed)
+
+	// the state and the protocol version are left untouched
+	s.Require().Equal(vmBefore, s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+	s.Require().Equal(oldProtocolVersion, s.app.BaseApp.AppVersion())
+	s.Require().Zero(s.app.UpgradeKeeper.GetDoneHeight(s.ctx, plan.Name))
+
+	// errors and panics of the handler are reported in the result
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(sdk.Context, types.Plan, module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+	res, err = s.app.UpgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().True(res.Failed())
+	s.Require().Equal("migration failed", res.Error)
+
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(sdk.Context, types.Plan, module.VersionMap) (module.VersionMap, error) {
+		panic("boom")
+	})
+	res, err = s.app.UpgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().Equal("upgrade handler panicked: boom", res.Error)
+}
+
 func (s *KeeperTestSuite) TestLastCompletedUpgrade() {
 	keeper := s.app.UpgradeKeeper
 	require := s.Require()
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// DryRunResult is the result of rehearsing an upgrade handler, and the module
+// migrations it runs, against a cached copy of the state.
+type DryRunResult struct {
+	PlanName   string                   `json:"plan_name" yaml:"plan_name"`
+	Height     int64                    `json:"height" yaml:"height"`
+	Duration   time.Duration            `json:"duration" yaml:"duration"`
+	GasUsed    uint64                   `json:"gas_used" yaml:"gas_used"`
+	Migrations []module.MigrationResult `json:"migrations" yaml:"migrations"`
+	VersionMap module.VersionMap        `json:"version_map,omitempty" yaml:"version_map,omitempty"`
+	Error      string                   `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Failed returns true if the upgrade handler returned an error or panicked.
+func (r DryRunResult) Failed() bool {
+	return r.Error != ""
+}